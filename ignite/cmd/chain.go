@@ -88,6 +88,7 @@ chain.
 
 	// Add flags required for the configMigrationPreRunHandler
 	c.PersistentFlags().AddFlagSet(flagSetConfig())
+	c.PersistentFlags().AddFlagSet(flagSetProfile())
 	c.PersistentFlags().AddFlagSet(flagSetYes())
 
 	c.AddCommand(
@@ -99,6 +100,9 @@ chain.
 		NewChainDebug(),
 		NewChainLint(),
 		NewChainModules(),
+		NewChainSnapshot(),
+		NewChainConfig(),
+		NewChainGenesis(),
 	)
 
 	return c
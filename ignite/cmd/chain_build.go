@@ -22,6 +22,11 @@ const (
 	flagBuildTags         = "build.tags"
 	flagReleasePrefix     = "release.prefix"
 	flagReleaseTargets    = "release.targets"
+	flagReleaseSignKey    = "release.sign-key"
+	flagReproducible      = "reproducible"
+	flagImage             = "image"
+	flagImageInit         = "image.init"
+	flagImageCACerts      = "image.ca-certificates"
 )
 
 // NewChainBuild returns a new build command to build a blockchain app.
@@ -80,6 +85,41 @@ tags. If the optional --release.targets is not specified, a binary is created
 for your current environment.
 
 	ignite chain build --release -t linux:amd64 -t darwin:amd64 -t darwin:arm64
+
+Release builds can be made reproducible, so anyone building the same commit gets
+the same tarballs. Binaries are built without local paths and the tarballs
+metadata are fixed, with timestamps set to the SOURCE_DATE_EPOCH environment
+variable or to the time of the last commit. A CycloneDX SBOM listing the Go
+dependencies of the chain is added to the release:
+
+	ignite chain build --release --reproducible
+
+The checksum file of a release can be signed with an ed25519 private key, either
+an unencrypted minisign secret key or a PEM key. The signature and the public
+key are saved in the release directory in the minisign format:
+
+	ignite chain build --release --release.sign-key minisign.key
+	minisign -Vm release/release_checksum -p release/minisign.pub
+
+To build a container image of the chain without a Docker daemon, use the --image
+flag with the image name. The image is saved as an OCI image layout tarball in
+the "release/" directory. It contains a static binary running "{app}d start"
+with a non-root user on a minimal base. Images are built for the Linux release
+targets, and the tarball contains a multi-platform image for many targets:
+
+	ignite chain build --image mars:v1.0.0 -t linux:amd64 -t linux:arm64
+	docker load -i release/mars_v1.0.0.tar
+
+Add a home initialized like with "ignite chain init" to the image to start a
+node without any setup:
+
+	ignite chain build --image mars:v1.0.0 --image.init
+
+The images don't contain CA certificates by default. Add the CA certificate bundle
+of your system to the image when the chain connects to TLS services, note that the
+image then depends on the system it is built on:
+
+	ignite chain build --image mars:v1.0.0 --image.ca-certificates
 `,
 		Args: cobra.NoArgs,
 		RunE: chainBuildHandler,
@@ -92,11 +132,18 @@ for your current environment.
 	c.Flags().AddFlagSet(flagSetDebug())
 	c.Flags().AddFlagSet(flagSetVerbose())
 	c.Flags().Bool(flagRelease, false, "build for a release")
-	c.Flags().StringSliceP(flagReleaseTargets, "t", []string{}, "release targets. Available only with --release flag")
+	c.Flags().StringSliceP(flagReleaseTargets, "t", []string{}, "release targets. Available only with --release and --image flags")
 	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
 	c.Flags().String(flagReleasePrefix, "", "tarball prefix for each release target. Available only with --release flag")
+	c.Flags().Bool(flagReproducible, false, "build a reproducible release with a SBOM. Available only with --release and --image flags")
+	c.Flags().String(flagReleaseSignKey, "", "ed25519 private key file to sign the release checksums. Available only with --release flag")
+	c.Flags().String(flagImage, "", "build an OCI image tarball with the given name:tag")
+	c.Flags().Bool(flagImageInit, false, "add an initialized chain home to the image. Available only with --image flag")
+	c.Flags().Bool(flagImageCACerts, false, "add the CA certificates of the system to the image. Available only with --image flag")
 	c.Flags().StringP(flagOutput, "o", "", "binary output path")
 
+	c.MarkFlagsMutuallyExclusive(flagRelease, flagImage)
+
 	return c
 }
 
@@ -105,6 +152,11 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		isRelease, _      = cmd.Flags().GetBool(flagRelease)
 		releaseTargets, _ = cmd.Flags().GetStringSlice(flagReleaseTargets)
 		releasePrefix, _  = cmd.Flags().GetString(flagReleasePrefix)
+		reproducible, _   = cmd.Flags().GetBool(flagReproducible)
+		signKey, _        = cmd.Flags().GetString(flagReleaseSignKey)
+		image, _          = cmd.Flags().GetString(flagImage)
+		imageInit, _      = cmd.Flags().GetBool(flagImageInit)
+		imageCACerts, _   = cmd.Flags().GetBool(flagImageCACerts)
 		buildTags, _      = cmd.Flags().GetStringSlice(flagBuildTags)
 		output, _         = cmd.Flags().GetString(flagOutput)
 		session           = cliui.New(
@@ -143,7 +195,15 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 
 	ctx := cmd.Context()
 	if isRelease {
-		releasePath, err := c.BuildRelease(ctx, cacheStorage, buildTags, output, releasePrefix, releaseTargets...)
+		var releaseOptions []chain.ReleaseOption
+		if reproducible {
+			releaseOptions = append(releaseOptions, chain.ReleaseReproducible())
+		}
+		if signKey != "" {
+			releaseOptions = append(releaseOptions, chain.ReleaseSigningKey(signKey))
+		}
+
+		releasePath, err := c.BuildRelease(ctx, cacheStorage, buildTags, output, releasePrefix, releaseTargets, releaseOptions...)
 		if err != nil {
 			return err
 		}
@@ -151,6 +211,26 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		return session.Printf("🗃  Release created: %s\n", colors.Info(releasePath))
 	}
 
+	if image != "" {
+		var imageOptions []chain.ImageOption
+		if reproducible {
+			imageOptions = append(imageOptions, chain.ImageReproducible())
+		}
+		if imageInit {
+			imageOptions = append(imageOptions, chain.ImageInitHome())
+		}
+		if imageCACerts {
+			imageOptions = append(imageOptions, chain.ImageHostCACertificates())
+		}
+
+		imagePath, err := c.BuildImage(ctx, cacheStorage, buildTags, output, image, releaseTargets, imageOptions...)
+		if err != nil {
+			return err
+		}
+
+		return session.Printf("🐳 Image created: %s\n", colors.Info(imagePath))
+	}
+
 	binaryName, err := c.Build(ctx, cacheStorage, buildTags, output, flagGetSkipProto(cmd), flagGetDebug(cmd))
 	if err != nil {
 		return err
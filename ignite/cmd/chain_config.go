@@ -0,0 +1,36 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewChainConfig returns the config command.
+func NewChainConfig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "config [command]",
+		Short: "Export the schema of the chain config and validate it",
+		Long: `The config command helps you write the config file of the chain.
+
+The JSON Schema of the config file lets editors validate and complete the config
+while you write it. For example, with the YAML language server of VS Code, add
+a comment at the top of the config file:
+
+	ignite chain config schema -o config.schema.json
+	# yaml-language-server: $schema=config.schema.json
+
+Unknown fields of the config file are always reported with their position. The
+validate command also checks the config values, like the coins, the account
+addresses or the validator accounts:
+
+	ignite chain config validate
+`,
+		Args: cobra.NoArgs,
+	}
+
+	c.AddCommand(
+		NewChainConfigSchema(),
+		NewChainConfigValidate(),
+	)
+
+	return c
+}
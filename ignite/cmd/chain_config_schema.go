@@ -0,0 +1,40 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+)
+
+// NewChainConfigSchema returns the command to export the JSON Schema of the chain config.
+func NewChainConfigSchema() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the chain config",
+		Args:  cobra.NoArgs,
+		RunE:  chainConfigSchemaHandler,
+	}
+
+	c.Flags().StringP(flagOutput, "o", "", "path of the schema file (default: stdout)")
+
+	return c
+}
+
+func chainConfigSchemaHandler(cmd *cobra.Command, _ []string) error {
+	output, _ := cmd.Flags().GetString(flagOutput)
+
+	schema, err := json.MarshalIndent(chainconfig.Schema(), "", "  ")
+	if err != nil {
+		return err
+	}
+	schema = append(schema, '\n')
+
+	if output == "" {
+		_, err = cmd.OutOrStdout().Write(schema)
+		return err
+	}
+	return os.WriteFile(output, schema, 0o644)
+}
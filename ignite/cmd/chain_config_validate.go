@@ -0,0 +1,65 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// NewChainConfigValidate returns the command to validate the chain config.
+func NewChainConfigValidate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the chain config",
+		Long: `Validate the config file of the chain, with the selected profile when there is
+one. The config must not have unknown fields and:
+
+* the coins of the accounts, the faucet and the validators must be valid.
+* the account addresses must use the address prefix of the chain.
+* the account names must be unique.
+* the first validator, which is bonded when the chain is initialized, must have
+  an account with the validator name.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainConfigValidateHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func chainConfigValidateHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	var chainOption []chain.Option
+	config, _ := cmd.Flags().GetString(flagConfig)
+	if config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := c.Config(chainconfig.WithKnownFields())
+	if err != nil {
+		return err
+	}
+
+	prefix, err := c.Bech32Prefix()
+	if err != nil {
+		return err
+	}
+
+	if err := chainconfig.Validate(cfg, prefix); err != nil {
+		return err
+	}
+
+	return session.Printf("%s %s is valid\n", icons.OK, c.ConfigPath())
+}
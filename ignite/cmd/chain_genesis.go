@@ -0,0 +1,57 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// NewChainGenesis returns the genesis command.
+func NewChainGenesis() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "genesis [command]",
+		Short: "Inspect, compare and add accounts to the genesis of the chain",
+		Long: `The genesis commands show what the genesis of the chain contains and how it
+changes from the default genesis of the app. A genesis is one of:
+
+* default: the default genesis of the app, created by "{app}d init".
+* config: the default genesis with the "genesis" values of config.yml applied.
+* home: the genesis of the chain home, created by "ignite chain init".
+* exported: the genesis exported when "ignite chain serve" stops.
+* the path of a genesis file.
+
+The default and config genesis require the chain binary to be installed with
+"ignite chain build".
+`,
+		Args: cobra.NoArgs,
+	}
+
+	c.AddCommand(
+		NewChainGenesisDiff(),
+		NewChainGenesisInspect(),
+		NewChainGenesisAddAccounts(),
+	)
+
+	return c
+}
+
+func flagSetGenesis(c *cobra.Command) {
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagModule, "", "name of the module to show")
+}
+
+func newChainWithGenesis(cmd *cobra.Command, session *cliui.Session) (*chain.Chain, error) {
+	chainOption := []chain.Option{
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+
+	config, _ := cmd.Flags().GetString(flagConfig)
+	if config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	return chain.NewWithHomeFlags(cmd, chainOption...)
+}
@@ -0,0 +1,86 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const flagAccountsFile = "file"
+
+// NewChainGenesisAddAccounts returns the command to add the accounts of a file to the genesis of the chain.
+func NewChainGenesisAddAccounts() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add-accounts",
+		Short: "Add the accounts of a CSV or JSON file to the genesis",
+		Long: `Add accounts and their balances to the genesis of the chain home, and add their
+coins to the supply. The accounts are added at once, which is much faster than
+running "{app}d genesis add-genesis-account" for each of them.
+
+The accounts file is either a CSV file of addresses and coins, with an optional
+header, or a JSON list of addresses and coins:
+
+	address,coins
+	cosmos1...,"1000stake,50token"
+
+	[{"address": "cosmos1...", "coins": ["1000stake", "50token"]}]
+
+The addresses must use the address prefix of the chain and must not be in the
+genesis already. The file of the "accounts_file" key of config.yml is used when
+the --file flag is not set, "ignite chain init" also adds its accounts:
+
+	ignite chain genesis add-accounts --file balances.csv
+`,
+		Args: cobra.NoArgs,
+		RunE: chainGenesisAddAccountsHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagAccountsFile, "", "CSV or JSON file of the accounts (default: accounts_file of the config)")
+
+	return c
+}
+
+func chainGenesisAddAccountsHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	c, err := newChainWithGenesis(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	path, _ := cmd.Flags().GetString(flagAccountsFile)
+	if path == "" {
+		if path, err = c.AccountsFile(); err != nil {
+			return err
+		}
+	}
+	if path == "" {
+		return errors.New("no accounts file: use the --file flag or the accounts_file key of the config")
+	}
+
+	summary, err := c.AddGenesisAccounts(cmd.Context(), path)
+	if err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	if err := session.Printf("%s Added %d accounts to the genesis\n\n", icons.OK, summary.Accounts); err != nil {
+		return err
+	}
+
+	entries := make([][]string, 0, len(summary.Supply))
+	for _, coin := range summary.Supply {
+		entries = append(entries, []string{
+			coin.Denom,
+			colors.Modified(summary.Coins.AmountOf(coin.Denom).String()),
+			coin.Amount.String(),
+		})
+	}
+	return session.PrintTable([]string{"Denom", "Added", "Supply"}, entries...)
+}
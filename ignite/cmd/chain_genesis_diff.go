@@ -0,0 +1,178 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosgenesis"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// maxGenesisValueLength is the maximum length of the values printed in the genesis diffs.
+const maxGenesisValueLength = 120
+
+// NewChainGenesisDiff returns the command to compare two genesis of the chain.
+func NewChainGenesisDiff() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "diff [a] [b]",
+		Short: "Show the differences between two genesis",
+		Long: `Show the differences from the genesis a to the genesis b, grouped by module.
+The items of the lists, like the balances or the coins, are matched by their
+address, denom or name. The balances and the supply per denom are summarized
+when the bank module changed.
+
+By default, the command shows the changes made by the "genesis" values of
+config.yml to the default genesis of the app:
+
+	ignite chain genesis diff
+	ignite chain genesis diff config exported --module bank
+`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: chainGenesisDiffHandler,
+	}
+
+	flagSetGenesis(c)
+
+	return c
+}
+
+func chainGenesisDiffHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	sources := []string{chain.GenesisDefault, chain.GenesisConfig}
+	copy(sources, args)
+	module, _ := cmd.Flags().GetString(flagModule)
+
+	c, err := newChainWithGenesis(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	a, err := c.Genesis(cmd.Context(), sources[0])
+	if err != nil {
+		return err
+	}
+	b, err := c.Genesis(cmd.Context(), sources[1])
+	if err != nil {
+		return err
+	}
+
+	changes := cosmosgenesis.Diff(a, b)
+	if module != "" {
+		changes = cosmosgenesis.FilterModule(changes, module)
+	}
+	session.StopSpinner()
+
+	if len(changes) == 0 {
+		return session.Printf("no differences between the %s and %s genesis\n", sources[0], sources[1])
+	}
+
+	bankChanged := false
+	for i, change := range changes {
+		if i == 0 || change.Module != changes[i-1].Module {
+			header := change.Module
+			if header == "" {
+				header = "genesis"
+			}
+			session.Println(colors.Info(header))
+		}
+		session.Printf("  %s\n", formatGenesisChange(change))
+		bankChanged = bankChanged || change.Module == cosmosgenesis.BankModule
+	}
+
+	if !bankChanged {
+		return nil
+	}
+
+	summaryA, errA := a.BankSummary()
+	summaryB, errB := b.BankSummary()
+	if errA != nil || errB != nil {
+		return nil
+	}
+	session.Println()
+	return printBankSummaryDiff(session, sources[0], sources[1], summaryA, summaryB)
+}
+
+// formatGenesisChange returns the colored change with its path relative to its module.
+func formatGenesisChange(change cosmosgenesis.Change) string {
+	path := change.Path
+	if path == "" {
+		path = change.Module
+	}
+
+	switch change.Kind {
+	case cosmosgenesis.Added:
+		return colors.Success(fmt.Sprintf("+ %s: %s", path, formatGenesisValue(change.New)))
+	case cosmosgenesis.Removed:
+		return colors.Error(fmt.Sprintf("- %s: %s", path, formatGenesisValue(change.Old)))
+	default:
+		return colors.Modified(fmt.Sprintf(
+			"~ %s: %s → %s",
+			path,
+			formatGenesisValue(change.Old),
+			formatGenesisValue(change.New),
+		))
+	}
+}
+
+func formatGenesisValue(v interface{}) string {
+	s := cosmosgenesis.FormatValue(v)
+	if len(s) > maxGenesisValueLength {
+		return s[:maxGenesisValueLength-3] + "..."
+	}
+	return s
+}
+
+// printBankSummaryDiff prints the balances and the supply per denom of both genesis.
+func printBankSummaryDiff(session *cliui.Session, nameA, nameB string, a, b []cosmosgenesis.DenomSummary) error {
+	summaries := make(map[string][2]*cosmosgenesis.DenomSummary)
+	var denoms []string
+	for i, list := range [][]cosmosgenesis.DenomSummary{a, b} {
+		for j := range list {
+			s, ok := summaries[list[j].Denom]
+			if !ok {
+				denoms = append(denoms, list[j].Denom)
+			}
+			s[i] = &list[j]
+			summaries[list[j].Denom] = s
+		}
+	}
+
+	entries := make([][]string, 0, len(denoms))
+	for _, denom := range denoms {
+		s := summaries[denom]
+		entries = append(entries, []string{
+			denom,
+			formatDenomBalances(s[0]),
+			formatDenomBalances(s[1]),
+			formatDenomSupply(s[0]),
+			formatDenomSupply(s[1]),
+		})
+	}
+
+	return session.PrintTable([]string{
+		"Denom",
+		fmt.Sprintf("Balances (%s)", nameA),
+		fmt.Sprintf("Balances (%s)", nameB),
+		fmt.Sprintf("Supply (%s)", nameA),
+		fmt.Sprintf("Supply (%s)", nameB),
+	}, entries...)
+}
+
+func formatDenomBalances(s *cosmosgenesis.DenomSummary) string {
+	if s == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%s (%d accounts)", s.Balances, s.Holders)
+}
+
+func formatDenomSupply(s *cosmosgenesis.DenomSummary) string {
+	if s == nil || s.Supply == nil {
+		return "-"
+	}
+	return s.Supply.String()
+}
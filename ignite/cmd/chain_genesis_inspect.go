@@ -0,0 +1,109 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosgenesis"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// NewChainGenesisInspect returns the command to inspect a genesis of the chain.
+func NewChainGenesisInspect() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "inspect [genesis]",
+		Short: "Show the modules of a genesis",
+		Long: `Show the genesis fields and the size of the module states of a genesis, the
+genesis of the chain home by default. Use the --module flag to show the state of
+a module, the bank module state also shows the balances and the supply per denom:
+
+	ignite chain genesis inspect --module bank
+	ignite chain genesis inspect exported --module staking
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: chainGenesisInspectHandler,
+	}
+
+	flagSetGenesis(c)
+
+	return c
+}
+
+func chainGenesisInspectHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	source := chain.GenesisHome
+	if len(args) > 0 {
+		source = args[0]
+	}
+	module, _ := cmd.Flags().GetString(flagModule)
+
+	c, err := newChainWithGenesis(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	genesis, err := c.Genesis(cmd.Context(), source)
+	if err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	if module == "" {
+		return printGenesisModules(session, genesis)
+	}
+
+	state, ok := genesis.Module(module)
+	if !ok {
+		return errors.Errorf("module %s not found in the genesis", module)
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := session.Println(string(data)); err != nil {
+		return err
+	}
+
+	if module != cosmosgenesis.BankModule {
+		return nil
+	}
+	summary, err := genesis.BankSummary()
+	if err != nil {
+		return err
+	}
+
+	entries := make([][]string, 0, len(summary))
+	for _, s := range summary {
+		entries = append(entries, []string{s.Denom, strconv.Itoa(s.Holders), s.Balances.String(), formatDenomSupply(&s)})
+	}
+	session.Println()
+	return session.PrintTable([]string{"Denom", "Accounts", "Balances", "Supply"}, entries...)
+}
+
+// printGenesisModules prints the genesis fields and the size of the modules state.
+func printGenesisModules(session *cliui.Session, genesis cosmosgenesis.Genesis) error {
+	var fields [][]string
+	for _, key := range []string{"chain_id", "genesis_time", "initial_height", "app_version"} {
+		if value, ok := genesis[key]; ok {
+			fields = append(fields, []string{key, cosmosgenesis.FormatValue(value)})
+		}
+	}
+	if err := session.PrintTable([]string{"Field", "Value"}, fields...); err != nil {
+		return err
+	}
+	session.Println()
+
+	modules := genesis.Modules()
+	entries := make([][]string, 0, len(modules))
+	for _, name := range modules {
+		state, _ := genesis.Module(name)
+		entries = append(entries, []string{name, strconv.Itoa(len(cosmosgenesis.FormatValue(state))) + " bytes"})
+	}
+	return session.PrintTable([]string{"Module", "Size"}, entries...)
+}
@@ -21,10 +21,13 @@ import (
 const (
 	flagVerbose         = "verbose"
 	flagConfig          = "config"
+	flagProfile         = "profile"
 	flagForceReset      = "force-reset"
 	flagGenerateClients = "generate-clients"
 	flagQuitOnFail      = "quit-on-fail"
 	flagResetOnce       = "reset-once"
+	flagFromSnapshot    = "from-snapshot"
+	flagHotReload       = "hot-reload"
 	flagOutputFile      = "output-file"
 )
 
@@ -45,6 +48,14 @@ and restarts the node.
 Whenever possible Ignite will try to keep the current state of the chain by
 exporting and importing the genesis file.
 
+Importing the genesis file creates a new chain from the exported state, which is
+slow for large states and loses the block history. When only the Go code changed,
+and neither the proto files nor the consensus versions of the modules did, the
+rebuilt binary can instead be restarted on the existing data directory. If the new binary computes a different app hash than
+the one stored by the node, Ignite falls back to importing the genesis file:
+
+	ignite chain serve --hot-reload
+
 To force Ignite to start from a clean slate even if a genesis file exists, use
 the following flag:
 
@@ -55,6 +66,11 @@ the following flag:
 
 	ignite chain serve --force-reset
 
+To start the chain from a state saved with "ignite chain snapshot save", use the
+following flag:
+
+	ignite chain serve --from-snapshot with-posts
+
 With Ignite it's possible to start more than one blockchain from the same source
 code using different config files. This is handy if you're building
 inter-blockchain functionality and, for example, want to try sending packets
@@ -62,6 +78,13 @@ from one blockchain to another. To start a node using a specific config file:
 
 	ignite chain serve --config mars.yml
 
+Environment specific settings can be defined as profiles in the "profiles"
+section of the config file instead of duplicating the config in many files. A
+profile overlays the base config: maps like "genesis" are merged, accounts are
+appended and other values are replaced. To start a node using a profile:
+
+	ignite chain serve --profile ci
+
 The serve command is meant to be used ONLY FOR DEVELOPMENT PURPOSES. Under the
 hood, it runs "appd start", where "appd" is the name of your chain's binary. For
 production, you may want to run "appd start" manually.
@@ -79,11 +102,17 @@ production, you may want to run "appd start" manually.
 	c.Flags().AddFlagSet(flagSetVerbose())
 	c.Flags().BoolP(flagForceReset, "f", false, "force reset of the app state on start and every source change")
 	c.Flags().BoolP(flagResetOnce, "r", false, "reset the app state once on init")
+	c.Flags().String(flagFromSnapshot, "", "restore the app state from a snapshot on init")
+	c.Flags().Bool(flagHotReload, false, "keep the app state on source changes when the proto files and the module consensus versions didn't change")
 	c.Flags().Bool(flagGenerateClients, false, "generate code for the configured clients on reset or source code change")
 	c.Flags().Bool(flagQuitOnFail, false, "quit program if the app fails to start")
 	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
 	c.Flags().StringP(flagOutputFile, "o", "", "output file logging the chain output (no UI, no stdin, listens for SIGTERM, implies --yes) (default: stdout)")
 
+	c.MarkFlagsMutuallyExclusive(flagFromSnapshot, flagResetOnce)
+	c.MarkFlagsMutuallyExclusive(flagFromSnapshot, flagForceReset)
+	c.MarkFlagsMutuallyExclusive(flagHotReload, flagForceReset)
+
 	return c
 }
 
@@ -206,6 +235,16 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 		serveOptions = append(serveOptions, chain.ServeResetOnce())
 	}
 
+	hotReload, _ := cmd.Flags().GetBool(flagHotReload)
+	if hotReload {
+		serveOptions = append(serveOptions, chain.ServeHotReload())
+	}
+
+	fromSnapshot, _ := cmd.Flags().GetString(flagFromSnapshot)
+	if fromSnapshot != "" {
+		serveOptions = append(serveOptions, chain.ServeFromSnapshot(fromSnapshot))
+	}
+
 	quitOnFail, _ := cmd.Flags().GetBool(flagQuitOnFail)
 	if quitOnFail {
 		serveOptions = append(serveOptions, chain.QuitOnFail())
@@ -0,0 +1,60 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// NewChainSnapshot returns the snapshot command.
+func NewChainSnapshot() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "snapshot [command]",
+		Short: "Save and restore named states of the chain",
+		Long: `The snapshot command saves the home of the chain, including its data, config and
+keyring, under a name and restores it later. Snapshots let you switch between
+states of the chain in seconds, for example an empty chain, the chain after a
+migration or a chain with a large amount of data:
+
+	ignite chain snapshot save with-posts
+	ignite chain snapshot load with-posts
+
+A snapshot can also be restored when the chain is served:
+
+	ignite chain serve --from-snapshot with-posts
+
+Snapshots are saved in the Ignite directory of the chain. Stop the chain before
+saving or loading a snapshot.
+`,
+		Args: cobra.NoArgs,
+	}
+
+	c.AddCommand(
+		NewChainSnapshotSave(),
+		NewChainSnapshotLoad(),
+		NewChainSnapshotList(),
+		NewChainSnapshotDelete(),
+	)
+
+	return c
+}
+
+func flagSetSnapshot(c *cobra.Command) {
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+}
+
+func newChainWithSnapshots(cmd *cobra.Command, session *cliui.Session) (*chain.Chain, error) {
+	chainOption := []chain.Option{
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+
+	config, _ := cmd.Flags().GetString(flagConfig)
+	if config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	return chain.NewWithHomeFlags(cmd, chainOption...)
+}
@@ -0,0 +1,39 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+)
+
+// NewChainSnapshotDelete returns the command to delete a snapshot of the chain.
+func NewChainSnapshotDelete() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a snapshot of the chain",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainSnapshotDeleteHandler,
+	}
+
+	flagSetSnapshot(c)
+
+	return c
+}
+
+func chainSnapshotDeleteHandler(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	c, err := newChainWithSnapshots(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DeleteSnapshot(name); err != nil {
+		return err
+	}
+
+	return session.Printf("🗑  Snapshot %s deleted.\n", name)
+}
@@ -0,0 +1,49 @@
+package ignitecmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+)
+
+// NewChainSnapshotList returns the command to list the snapshots of the chain.
+func NewChainSnapshotList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the snapshots of the chain",
+		Args:  cobra.NoArgs,
+		RunE:  chainSnapshotListHandler,
+	}
+
+	flagSetSnapshot(c)
+
+	return c
+}
+
+func chainSnapshotListHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	c, err := newChainWithSnapshots(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := c.Snapshots()
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) == 0 {
+		return session.Println("no snapshots found")
+	}
+
+	entries := make([][]string, 0, len(snapshots))
+	for _, s := range snapshots {
+		entries = append(entries, []string{s.Name, s.ChainID, s.CreatedAt.Local().Format(time.DateTime)})
+	}
+
+	return session.PrintTable([]string{"Name", "Chain ID", "Created"}, entries...)
+}
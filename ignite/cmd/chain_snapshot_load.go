@@ -0,0 +1,61 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// NewChainSnapshotLoad returns the command to restore the state of the chain from a snapshot.
+func NewChainSnapshotLoad() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "load NAME",
+		Short: "Restore the state of the chain from a snapshot",
+		Long: `The load command replaces the home of the chain with the one saved in the
+snapshot. The current state of the chain is lost unless it has been saved in a
+snapshot. The chain must be stopped and have the chain ID of the snapshot.`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainSnapshotLoadHandler,
+	}
+
+	flagSetSnapshot(c)
+
+	return c
+}
+
+func chainSnapshotLoadHandler(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText("Loading snapshot..."),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	c, err := newChainWithSnapshots(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	question := fmt.Sprintf("The chain home %s will be replaced by the %s snapshot, would you like to continue", home, name)
+	if err := session.AskConfirm(question); err != nil {
+		if errors.Is(err, cliui.ErrAbort) {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := c.LoadSnapshot(name); err != nil {
+		return err
+	}
+
+	return session.Printf("📸 Snapshot %s loaded in %s.\n", name, home)
+}
@@ -0,0 +1,57 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// NewChainSnapshotSave returns the command to save the state of the chain as a snapshot.
+func NewChainSnapshotSave() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "save NAME",
+		Short: "Save the state of the chain as a named snapshot",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainSnapshotSaveHandler,
+	}
+
+	flagSetSnapshot(c)
+
+	return c
+}
+
+func chainSnapshotSaveHandler(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText("Saving snapshot..."),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	c, err := newChainWithSnapshots(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.SaveSnapshot(name, false)
+	if errors.Is(err, chain.ErrSnapshotExists) {
+		question := fmt.Sprintf("Snapshot %s already exists, would you like to overwrite it", name)
+		if err := session.AskConfirm(question); err != nil {
+			if errors.Is(err, cliui.ErrAbort) {
+				return nil
+			}
+			return err
+		}
+		_, err = c.SaveSnapshot(name, true)
+	}
+	if err != nil {
+		return err
+	}
+
+	return session.Printf("📸 Snapshot %s saved.\n", name)
+}
@@ -165,6 +165,12 @@ func flagSetConfig() *flag.FlagSet {
 	return fs
 }
 
+func flagSetProfile() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagProfile, "", "name of the config profile to overlay on the config file")
+	return fs
+}
+
 func getConfig(cmd *cobra.Command) (config string) {
 	config, _ = cmd.Flags().GetString(flagConfig)
 	return
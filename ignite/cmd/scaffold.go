@@ -1,9 +1,13 @@
 package ignitecmd
 
 import (
+	"os"
+	"strings"
+
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosver"
@@ -22,13 +26,18 @@ const (
 	flagModule       = "module"
 	flagNoMessage    = "no-message"
 	flagNoSimulation = "no-simulation"
+	flagEvents       = "events"
 	flagResponse     = "response"
 	flagDescription  = "desc"
 	flagProtoDir     = "proto-dir"
+	flagDryRun       = "dry-run"
+	flagPatch        = "patch"
 
 	msgCommitPrefix = "Your project changes have not been committed.\nTo enable reverting to your current state, commit your saved changes."
 	msgCommitPrompt = "Do you want to proceed without committing your saved changes"
 
+	msgPreviewGeneratedFiles = "The files generated after scaffolding (.pb.go, OpenAPI, go.mod and formatting changes) are not part of the preview."
+
 	statusScaffolding      = "Scaffolding..."
 	multipleCoinDisclaimer = `**Disclaimer**  
 The 'coins' and 'dec.coins' argument types require special attention when used in CLI commands. 
@@ -96,6 +105,13 @@ If you're building an application with custom IBC logic, you might need to
 scaffold IBC packets. An IBC packet represents the data sent from one blockchain
 to another. You can only scaffold IBC packets in IBC-enabled modules scaffolded
 with an "--ibc" flag. Note that the default module is not IBC-enabled.
+
+Any scaffolding command can be previewed with the "--dry-run" flag, which prints
+a diff of the changes without modifying the app, or with the "--patch" flag,
+which writes the diff to a patch file that can be applied with "git apply". The
+preview only includes the scaffolded source files. The files generated after
+scaffolding, like the ".pb.go" and OpenAPI files, and the changes made by
+"go mod tidy", "gofmt" and "goimports" are not included.
 `,
 		Aliases: []string{"s"},
 		Args:    cobra.ExactArgs(1),
@@ -109,20 +125,28 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 		NewScaffoldMap(),
 		NewScaffoldSingle(),
 		NewScaffoldType(),
+		NewScaffoldField(),
 		NewScaffoldParams(),
 		NewScaffoldConfigs(),
+		NewScaffoldHooks(),
+		NewScaffoldEndBlocker(),
+		NewScaffoldEvent(),
+		NewScaffoldMigration(),
+		NewScaffoldUpgrade(),
 		NewScaffoldMessage(),
 		NewScaffoldQuery(),
 		NewScaffoldPacket(),
 		NewScaffoldVue(),
 		NewScaffoldReact(),
 		NewScaffoldChainRegistry(),
+		NewScaffoldRemove(),
 	)
 
 	// same flag as for chain serve but different behavior
 	// the verbose flag on scaffold sets the IGNT_DEBUG env var
 	// while on serve it bypass the session logger for the app default
 	c.PersistentFlags().AddFlagSet(flagSetVerbose())
+	c.PersistentFlags().AddFlagSet(flagSetDryRun())
 
 	return c
 }
@@ -133,8 +157,12 @@ func migrationPreRunHandler(cmd *cobra.Command, args []string) error {
 		env.SetDebug()
 	}
 
-	if err := gitChangesConfirmPreRunHandler(cmd, args); err != nil {
-		return err
+	// a dry run doesn't modify the app, so there is nothing to confirm or migrate
+	dryRun := flagGetDryRun(cmd)
+	if !dryRun {
+		if err := gitChangesConfirmPreRunHandler(cmd, args); err != nil {
+			return err
+		}
 	}
 
 	session := cliui.New(cliui.WithoutUserInteraction(getYes(cmd)))
@@ -154,6 +182,10 @@ func migrationPreRunHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if dryRun {
+		return nil
+	}
+
 	if err := toolsMigrationPreRunHandler(cmd, session, appPath); err != nil {
 		return err
 	}
@@ -170,6 +202,7 @@ func scaffoldType(
 	cmd *cobra.Command,
 	args []string,
 	kind scaffolder.AddTypeKind,
+	options ...scaffolder.AddTypeOption,
 ) error {
 	var (
 		typeName          = args[0]
@@ -178,11 +211,10 @@ func scaffoldType(
 		withoutMessage    = flagGetNoMessage(cmd)
 		withoutSimulation = flagGetNoSimulation(cmd)
 		signer            = flagGetSigner(cmd)
+		withEvents        = flagGetEvents(cmd)
 		appPath           = flagGetPath(cmd)
 	)
 
-	var options []scaffolder.AddTypeOption
-
 	if len(fields) > 0 {
 		options = append(options, scaffolder.TypeWithFields(fields...))
 	}
@@ -199,6 +231,9 @@ func scaffoldType(
 			options = append(options, scaffolder.TypeWithoutSimulation())
 		}
 	}
+	if withEvents {
+		options = append(options, scaffolder.TypeWithEvents())
+	}
 
 	session := cliui.New(
 		cliui.StartSpinnerWithText(statusScaffolding),
@@ -238,21 +273,10 @@ func scaffoldType(
 		return err
 	}
 
-	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
-	if err != nil {
-		return err
-	}
-
-	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
 		return err
 	}
 
-	modificationsStr, err := sm.String()
-	if err != nil {
-		return err
-	}
-
-	session.Println(modificationsStr)
 	session.Printf("\n🎉 %s added. \n\n", typeName)
 
 	return nil
@@ -301,6 +325,121 @@ func flagSetScaffoldType() *flag.FlagSet {
 	return f
 }
 
+func flagSetDryRun() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.Bool(flagDryRun, false, "print a diff of the scaffolded source files without modifying the app (generated files are excluded)")
+	f.String(flagPatch, "", "write the changes to a patch file that can be applied with \"git apply\" without modifying the app (generated files are excluded)")
+	return f
+}
+
+func flagGetDryRun(cmd *cobra.Command) bool {
+	dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+	return dryRun || flagGetPatch(cmd) != ""
+}
+
+func flagGetPatch(cmd *cobra.Command) string {
+	patch, _ := cmd.Flags().GetString(flagPatch)
+	return patch
+}
+
+// applyScaffoldModifications applies the modifications staged by the scaffolder to the app and
+// runs the post scaffolding steps. With the dry-run or patch flags the modifications are only
+// previewed and false is returned, since the app was not modified.
+func applyScaffoldModifications(
+	cmd *cobra.Command,
+	session *cliui.Session,
+	sc scaffolder.Scaffolder,
+	cacheStorage cache.Storage,
+) (bool, error) {
+	if flagGetDryRun(cmd) {
+		return false, previewScaffoldModifications(cmd, session, sc)
+	}
+
+	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
+	if err != nil {
+		return false, err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
+		return false, err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return false, err
+	}
+
+	session.Println(modificationsStr)
+
+	return true, nil
+}
+
+// previewScaffoldModifications prints the diff of the modifications staged by the scaffolder,
+// or writes it to the patch file, and discards them.
+func previewScaffoldModifications(cmd *cobra.Command, session *cliui.Session, sc scaffolder.Scaffolder) error {
+	sm, patch, err := sc.DiffModifications()
+	if err != nil {
+		return err
+	}
+	return printScaffoldPreview(cmd, session, sm, patch)
+}
+
+// previewScaffoldDir prints the diff of the files scaffolded in a temporary directory against
+// the target path, or writes it to the patch file.
+func previewScaffoldDir(cmd *cobra.Command, session *cliui.Session, tmpPath, targetPath string) error {
+	sm, patch, err := xgenny.DiffDir(tmpPath, targetPath)
+	if err != nil {
+		return err
+	}
+	return printScaffoldPreview(cmd, session, sm, patch)
+}
+
+// printScaffoldPreview prints the diff of a dry run, or writes it to the patch file.
+// The files generated after scaffolding are not part of the diff.
+func printScaffoldPreview(cmd *cobra.Command, session *cliui.Session, sm xgenny.SourceModification, patch string) error {
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	if patchPath := flagGetPatch(cmd); patchPath != "" {
+		if err := os.WriteFile(patchPath, []byte(patch), 0o644); err != nil {
+			return err
+		}
+
+		session.Println(modificationsStr)
+		session.Printf("\n📝 Patch written to %[1]s, apply it with \"git apply %[1]s\".\n", patchPath)
+		session.Printf("%s\n\n", colors.Info(msgPreviewGeneratedFiles))
+		return nil
+	}
+
+	session.StopSpinner()
+	session.Println(colorizeDiff(patch))
+	session.Println(modificationsStr)
+	session.Printf("\n%s\n", colors.Info("Dry run, no changes were made to the app."))
+	session.Printf("%s\n\n", colors.Info(msgPreviewGeneratedFiles))
+
+	return nil
+}
+
+// colorizeDiff colors the added, removed and hunk header lines of a unified diff.
+func colorizeDiff(patch string) string {
+	lines := strings.Split(strings.TrimSuffix(patch, "\n"), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"), strings.HasPrefix(line, "diff --git"):
+			lines[i] = colors.Name(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = colors.Success(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = colors.Error(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = colors.Info(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 func flagGetModule(cmd *cobra.Command) string {
 	module, _ := cmd.Flags().GetString(flagModule)
 	return module
@@ -316,6 +455,11 @@ func flagGetNoMessage(cmd *cobra.Command) bool {
 	return noMessage
 }
 
+func flagGetEvents(cmd *cobra.Command) bool {
+	events, _ := cmd.Flags().GetBool(flagEvents)
+	return events
+}
+
 func flagGetSigner(cmd *cobra.Command) string {
 	signer, _ := cmd.Flags().GetString(flagSigner)
 	return signer
@@ -1,12 +1,16 @@
 package ignitecmd
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/v29/ignite/config/chain/defaults"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/ignite/cli/v29/ignite/pkg/env"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
 	"github.com/ignite/cli/v29/ignite/pkg/xgit"
 	"github.com/ignite/cli/v29/ignite/services/scaffolder"
@@ -141,6 +145,45 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 		skipProto = true
 	}
 
+	if flagGetDryRun(cmd) {
+		// the chain is scaffolded in a temporary directory to preview its files.
+		target := appPath
+		if target == "" {
+			pathInfo, err := gomodulepath.Parse(name)
+			if err != nil {
+				return err
+			}
+			target = pathInfo.Root
+		}
+		target, err := filepath.Abs(target)
+		if err != nil {
+			return err
+		}
+
+		tmpDir, err := os.MkdirTemp("", "ignite-scaffold-chain")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+
+		if _, _, err := scaffolder.Init(
+			cmd.Context(),
+			filepath.Join(tmpDir, filepath.Base(target)),
+			name,
+			addressPrefix,
+			coinType,
+			defaultDenom,
+			protoDir,
+			noDefaultModule,
+			minimal,
+			params,
+			moduleConfigs,
+		); err != nil {
+			return err
+		}
+		return previewScaffoldDir(cmd, session, tmpDir, filepath.Dir(target))
+	}
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
@@ -1,6 +1,8 @@
 package ignitecmd
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
@@ -58,7 +60,21 @@ func scaffoldChainRegistryFiles(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	if err = sc.CreateChainRegistryFiles(c, cfg); err != nil {
+	if flagGetDryRun(cmd) {
+		// the files are created in a temporary directory to preview them.
+		tmpDir, err := os.MkdirTemp("", "ignite-chain-registry")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+
+		if err := sc.CreateChainRegistryFiles(c, cfg, tmpDir); err != nil {
+			return err
+		}
+		return previewScaffoldDir(cmd, session, tmpDir, ".")
+	}
+
+	if err = sc.CreateChainRegistryFiles(c, cfg, "."); err != nil {
 		return err
 	}
 
@@ -6,7 +6,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
-	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
 	"github.com/ignite/cli/v29/ignite/services/scaffolder"
 )
 
@@ -75,21 +74,9 @@ func scaffoldConfigsHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
-	if err != nil {
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
 		return err
 	}
-
-	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
-		return err
-	}
-
-	modificationsStr, err := sm.String()
-	if err != nil {
-		return err
-	}
-
-	session.Println(modificationsStr)
 	session.Printf("\n🎉 New configs added to the module:\n\n- %s\n\n", strings.Join(configs, "\n- "))
 
 	return nil
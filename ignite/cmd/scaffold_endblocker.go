@@ -0,0 +1,97 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+	"github.com/ignite/cli/v29/ignite/templates/endblocker"
+)
+
+const (
+	flagQueueBy     = "queue-by"
+	flagMaxPerBlock = "max-per-block"
+)
+
+// NewScaffoldEndBlocker returns the command to scaffold a queue processed by the end blocker of a module.
+func NewScaffoldEndBlocker() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "endblocker NAME",
+		Short: "Queue of scheduled items processed at the end of the block",
+		Long: `Scaffold a queue of items processed by the end blocker of a module.
+
+Modules often need to process items when they are due at a given block height or
+block time, for example to expire an auction or to release locked funds. The
+command scaffolds a queue stored with collections, keeper methods to enqueue and
+dequeue the items, and the processing of the due items in the "EndBlock" method
+of the module:
+
+	ignite scaffold endblocker expiry --module auction --queue-by time
+
+Items are scheduled by their id with the keeper:
+
+	err := k.EnqueueExpiry(ctx, sdkCtx.BlockTime().Add(time.Hour), auction.Id)
+
+The items due are processed in the order they are due, at most "--max-per-block"
+items per block; the remaining ones are processed in the next blocks. An event
+is emitted for each processed item. Define the processing logic in the
+"processExpiry" method of the keeper.
+`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldEndBlockerHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+
+	c.Flags().String(flagModule, "", "module to add the queue into (default: app's main module)")
+	c.Flags().String(flagQueueBy, endblocker.QueueByHeight, "order of the queue, by block height or time (height|time)")
+	c.Flags().Uint64(flagMaxPerBlock, 100, "maximum number of items processed per block")
+
+	return c
+}
+
+func scaffoldEndBlockerHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name           = args[0]
+		appPath        = flagGetPath(cmd)
+		moduleName     = flagGetModule(cmd)
+		queueBy, _     = cmd.Flags().GetString(flagQueueBy)
+		maxPerBlock, _ = cmd.Flags().GetUint64(flagMaxPerBlock)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.AddEndBlocker(moduleName, name, queueBy, maxPerBlock); err != nil {
+		return err
+	}
+
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
+		return err
+	}
+	session.Printf("\n🎉 Created %s queue processed by the end blocker.\n\n", name)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+// NewScaffoldEvent returns the command to scaffold a typed event into a module.
+func NewScaffoldEvent() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "event NAME [field]...",
+		Short: "Typed event emitted by a Cosmos SDK module",
+		Long: `Scaffold a typed event for a Cosmos SDK module.
+
+Typed events are protocol buffer messages emitted in the events of a transaction,
+they can be decoded by clients and indexers. The command adds an "Event<Name>"
+message to the "events.proto" file of the module and a method to emit the event
+from the keeper:
+
+	ignite scaffold event post-published title author:address --module blog
+
+	err := k.EmitPostPublishedEvent(ctx, types.EventPostPublished{
+		Title:  post.Title,
+		Author: post.Creator,
+	})
+
+Fields of the event are defined the same way as the fields of a message. The
+messages of the types scaffolded with the "--events" flag of the "list", "map"
+and "single" commands emit created, updated and deleted events.
+`,
+		Args:    cobra.MinimumNArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldEventHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+
+	c.Flags().String(flagModule, "", "module to add the event into (default: app's main module)")
+
+	return c
+}
+
+func scaffoldEventHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name       = args[0]
+		fields     = args[1:]
+		appPath    = flagGetPath(cmd)
+		moduleName = flagGetModule(cmd)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.AddEvent(cmd.Context(), moduleName, name, fields...); err != nil {
+		return err
+	}
+
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
+		return err
+	}
+	session.Printf("\n🎉 Created a %s event.\n\n", name)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+const flagRemove = "remove"
+
+// NewScaffoldField returns a command to add or remove fields of a scaffolded type.
+func NewScaffoldField() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "field [type] [field]...",
+		Short: "Add or remove fields of a list, map or single type",
+		Long: `Add fields to a type previously scaffolded with the "list", "map" or "single"
+commands, or remove them with the "--remove" flag.
+
+The fields are appended to the proto definition of the type with the next free
+field numbers, as well as to the create and update messages. The message server,
+the positional arguments of the CLI commands and, for single types, the genesis
+tests are updated accordingly.
+
+For example, to add a "tags" field to a "post" list of the "blog" module:
+
+  ignite scaffold field post tags:array.string --module blog
+
+Fields use the same syntax as the other scaffolding commands. To remove fields,
+only their names are required:
+
+  ignite scaffold field post tags --module blog --remove
+
+The index and the signer of a type are managed by the scaffolder and can't be
+removed. Types that were not scaffolded with Ignite are refused.
+`,
+		Args:    cobra.MinimumNArgs(2),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldFieldHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module of the type. Default: app's main module")
+	c.Flags().Bool(flagRemove, false, "remove the fields from the type")
+
+	return c
+}
+
+func scaffoldFieldHandler(cmd *cobra.Command, args []string) error {
+	var (
+		typeName   = args[0]
+		fields     = args[1:]
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+		remove, _  = cmd.Flags().GetBool(flagRemove)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if remove {
+		err = sc.RemoveTypeFields(cmd.Context(), moduleName, typeName, fields...)
+	} else {
+		err = sc.AddTypeFields(cmd.Context(), moduleName, typeName, fields...)
+	}
+	if err != nil {
+		return err
+	}
+
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
+		return err
+	}
+	if remove {
+		session.Printf("\n🗑  Fields removed from `%s`.\n\n", typeName)
+	} else {
+		session.Printf("\n🎉 Fields added to `%s`.\n\n", typeName)
+	}
+
+	return nil
+}
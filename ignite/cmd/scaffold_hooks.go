@@ -0,0 +1,101 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+// NewScaffoldHooks returns the command to scaffold custom keeper hooks into a module.
+func NewScaffoldHooks() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "hooks NAME [method]...",
+		Short: "Custom keeper hooks for a Cosmos SDK module",
+		Long: `Scaffold custom keeper hooks for a Cosmos SDK module.
+
+Hooks let other modules react to events of a module without the module depending
+on them. The command scaffolds a hooks interface with the given methods, a multi
+hooks type calling every registered implementation and a "Set<Name>Hooks" method
+on the keeper. The module is wired with depinject so that dependent modules can
+register their implementation:
+
+	ignite scaffold hooks staking afterDeal beforeDeal --module mars
+
+Each method receives the context and returns an error. Call the hooks from the
+keeper of the module, for example:
+
+	if err := k.StakingHooks().AfterDeal(ctx); err != nil {
+		return err
+	}
+
+A module subscribes to the hooks by returning a wrapper of its implementation from
+its depinject outputs:
+
+	type ModuleOutputs struct {
+		depinject.Out
+
+		StakingHooks marstypes.StakingHooksWrapper
+	}
+
+	...
+	StakingHooks: marstypes.StakingHooksWrapper{StakingHooks: k.Hooks()},
+
+The hooks of all subscribing modules are set on the keeper in the alphabetical
+order of the modules names.
+`,
+		Args:    cobra.MinimumNArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldHooksHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+
+	c.Flags().String(flagModule, "", "module to add the hooks into (default: app's main module)")
+
+	return c
+}
+
+func scaffoldHooksHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name       = args[0]
+		methods    = args[1:]
+		appPath    = flagGetPath(cmd)
+		moduleName = flagGetModule(cmd)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.AddHooks(moduleName, name, methods...); err != nil {
+		return err
+	}
+
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
+		return err
+	}
+	session.Printf("\n🎉 Created %s hooks.\n\n", name)
+
+	return nil
+}
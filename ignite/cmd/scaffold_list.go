@@ -50,11 +50,16 @@ business logic you will implement next.
 
 By default, all fields are assumed to be strings. If you want a field of a
 different type, you can specify it after a colon ":". The following types are
-supported: string, bool, int, uint, coin, array.string, array.int, array.uint,
-array.coin. An example of using field types:
+supported: string, bool, int, uint, int32, uint32, coin, dec, math.int,
+timestamp, duration, array.string, array.int, array.uint, array.coin. An
+example of using field types:
 
 	ignite scaffold list pool amount:coin tags:array.string height:int
 
+Enums are declared with their values, and scaffolded in their own proto file:
+
+	ignite scaffold list order status:enum:OrderStatus(OPEN|CLOSED)
+
 For detailed type information use ignite scaffold type --help
 
 "Index" indicates whether the type can be used as an index in
@@ -103,6 +108,7 @@ The "creator" field is not generated if a list is scaffolded with the
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().Bool(flagEvents, false, "emit typed events when a value is created, updated or deleted")
 
 	return c
 }
@@ -6,7 +6,11 @@ import (
 	"github.com/ignite/cli/v29/ignite/services/scaffolder"
 )
 
-const FlagIndexName = "index"
+const (
+	FlagIndexName          = "index"
+	flagSecondaryIndexName = "secondary-index"
+	flagUniqueIndexName    = "unique-index"
+)
 
 // NewScaffoldMap returns a new command to scaffold a map.
 func NewScaffoldMap() *cobra.Command {
@@ -42,6 +46,19 @@ for the "hello" key.
 
 By default, the index is called "index", to customize the index, use the "--index" flag.
 
+To look up values by other fields, add secondary or unique indexes on the fields
+of the map with the "--secondary-index" and "--unique-index" flags. The values
+are then stored in an indexed map:
+
+	ignite scaffold map post title body:string --secondary-index creator --unique-index title
+
+A secondary index adds a paginated query listing the values sharing the same
+field value, and a unique index ensures no two values share the same field value
+and adds a query fetching a value by this field:
+
+	blogd q blog list-post-by-creator [creator]
+	blogd q blog get-post-by-title [title]
+
 Since the behavior of "list" and "map" scaffolding is very similar, you can use
 the "--no-message", "--module", "--signer" flags as well as the colon syntax for
 custom types.
@@ -58,12 +75,26 @@ For detailed type information use ignite scaffold type --help
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().Bool(flagEvents, false, "emit typed events when a value is created, updated or deleted")
 	c.Flags().String(FlagIndexName, "index", "field that index the value")
+	c.Flags().StringSlice(flagSecondaryIndexName, []string{}, "fields of the map to look up the values by")
+	c.Flags().StringSlice(flagUniqueIndexName, []string{}, "fields of the map whose values must be unique")
 
 	return c
 }
 
 func scaffoldMapHandler(cmd *cobra.Command, args []string) error {
-	index, _ := cmd.Flags().GetString(FlagIndexName)
-	return scaffoldType(cmd, args, scaffolder.MapType(index))
+	var (
+		index, _            = cmd.Flags().GetString(FlagIndexName)
+		secondaryIndexes, _ = cmd.Flags().GetStringSlice(flagSecondaryIndexName)
+		uniqueIndexes, _    = cmd.Flags().GetStringSlice(flagUniqueIndexName)
+		options             []scaffolder.AddTypeOption
+	)
+	if len(secondaryIndexes) > 0 {
+		options = append(options, scaffolder.TypeWithSecondaryIndexes(secondaryIndexes...))
+	}
+	if len(uniqueIndexes) > 0 {
+		options = append(options, scaffolder.TypeWithUniqueIndexes(uniqueIndexes...))
+	}
+	return scaffoldType(cmd, args, scaffolder.MapType(index), options...)
 }
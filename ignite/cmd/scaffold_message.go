@@ -5,7 +5,6 @@ import (
 
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
-	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
 	"github.com/ignite/cli/v29/ignite/services/scaffolder"
 	"github.com/ignite/cli/v29/ignite/templates/field"
 )
@@ -144,21 +143,9 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
-	if err != nil {
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
 		return err
 	}
-
-	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
-		return err
-	}
-
-	modificationsStr, err := sm.String()
-	if err != nil {
-		return err
-	}
-
-	session.Println(modificationsStr)
 	session.Printf("\n🎉 Created a message `%[1]v`.\n\n", args[0])
 
 	return nil
@@ -0,0 +1,94 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+const (
+	flagFromVersion = "from"
+	flagToVersion   = "to"
+)
+
+// NewScaffoldMigration returns the command to scaffold an in-place store migration of a module.
+func NewScaffoldMigration() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "migration",
+		Short: "In-place store migration of a module",
+		Long: `Scaffold an in-place store migration of a Cosmos SDK module.
+
+Once a chain is live, a change of the state stored by a module must be migrated
+when the chain is upgraded. The command scaffolds a "migrations/vN" package with
+the store migration, a keeper migrator method registered in the "RegisterServices"
+method of the module and bumps the consensus version of the module:
+
+	ignite scaffold migration --module blog --from 1 --to 2
+
+A migration upgrades the consensus version of the module by one and must start
+from its current consensus version. Both versions are optional, by default the
+migration starts from the current consensus version of the module.
+
+Write the migration in the "MigrateStore" function of the migration package. The
+command also scaffolds a test loading the genesis state of the previous version
+from "migrations/vN/testdata", running the migration and comparing the store to
+the expected genesis state of the new version.
+`,
+		Args:    cobra.NoArgs,
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldMigrationHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+
+	c.Flags().String(flagModule, "", "module to add the migration into (default: app's main module)")
+	c.Flags().Uint64(flagFromVersion, 0, "consensus version migrated from (default: current consensus version of the module)")
+	c.Flags().Uint64(flagToVersion, 0, "consensus version migrated to (default: next consensus version of the module)")
+
+	return c
+}
+
+func scaffoldMigrationHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		appPath        = flagGetPath(cmd)
+		moduleName     = flagGetModule(cmd)
+		fromVersion, _ = cmd.Flags().GetUint64(flagFromVersion)
+		toVersion, _   = cmd.Flags().GetUint64(flagToVersion)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.AddMigration(moduleName, fromVersion, toVersion); err != nil {
+		return err
+	}
+
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
+		return err
+	}
+	session.Printf("\n🎉 Created a migration.\n\n")
+
+	return nil
+}
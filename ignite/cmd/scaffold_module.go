@@ -10,7 +10,6 @@ import (
 
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
-	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
 	"github.com/ignite/cli/v29/ignite/services/scaffolder"
 	modulecreate "github.com/ignite/cli/v29/ignite/templates/module/create"
 )
@@ -190,21 +189,9 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
-	if err != nil {
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
 		return err
 	}
 
-	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
-		return err
-	}
-
-	modificationsStr, err := sm.String()
-	if err != nil {
-		return err
-	}
-
-	session.Println(modificationsStr)
-
 	return session.Print(msg.String())
 }
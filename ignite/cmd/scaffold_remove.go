@@ -0,0 +1,87 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+// NewScaffoldRemove returns a command to remove a scaffolded component from a module.
+func NewScaffoldRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "remove [list|map|single|message|query] [name]",
+		Short: "Remove a scaffolded list, map, single, message or query",
+		Long: `Remove a component previously scaffolded in a module.
+
+The remove command reverses the code modifications made by the "list", "map",
+"single", "message" and "query" scaffolding commands: the proto definitions,
+the keeper collections, the genesis state fields, the CLI options, the codec
+registrations and the simulation operations are removed, as well as the files
+created for the component.
+
+For example, to remove a "post" map from the "blog" module:
+
+  ignite scaffold remove map post --module blog
+
+Code added manually to the component files is removed along with them, so
+commit your changes before running this command.
+`,
+		Args:    cobra.ExactArgs(2),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldRemoveHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module to remove the component from. Default: app's main module")
+
+	return c
+}
+
+func scaffoldRemoveHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name       = args[1]
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	kind, err := scaffolder.ParseRemoveKind(args[0])
+	if err != nil {
+		return err
+	}
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.RemoveComponent(cmd.Context(), kind, moduleName, name); err != nil {
+		return err
+	}
+
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
+		return err
+	}
+	session.Printf("\n🗑  %s `%s` removed.\n\n", kind, name)
+
+	return nil
+}
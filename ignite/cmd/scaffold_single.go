@@ -25,6 +25,7 @@ For detailed type information use ignite scaffold type --help.`,
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().Bool(flagEvents, false, "emit typed events when a value is created, updated or deleted")
 
 	return c
 }
@@ -0,0 +1,93 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+const (
+	flagAddStore    = "add-store"
+	flagDeleteStore = "delete-store"
+)
+
+// NewScaffoldUpgrade returns the command to scaffold a chain upgrade handler.
+func NewScaffoldUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade NAME",
+		Short: "Chain upgrade handler with store upgrades",
+		Long: `Scaffold a handler of a chain upgrade executed by the upgrade module.
+
+A chain upgrade is planned on-chain with a software upgrade proposal. At the
+height of the upgrade the chain halts and the new binary runs the handler of the
+upgrade registered under the name of the plan. The command scaffolds the upgrade
+in the "app/upgrades/NAME" package and registers it in the app:
+
+	ignite scaffold upgrade v2.0.0 --add-store foo --delete-store bar
+
+The handler runs the migrations of all the modules whose consensus version was
+bumped, see "ignite scaffold migration". The stores of the modules added to or
+removed from the app by the upgrade must be declared with "--add-store" and
+"--delete-store", they are applied when the stores are loaded at the height of
+the upgrade.
+
+A test running the handler against an in-memory app is scaffolded with the
+upgrade.
+`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldUpgradeHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+
+	c.Flags().StringSlice(flagAddStore, nil, "store added by the upgrade")
+	c.Flags().StringSlice(flagDeleteStore, nil, "store deleted by the upgrade")
+
+	return c
+}
+
+func scaffoldUpgradeHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name             = args[0]
+		appPath          = flagGetPath(cmd)
+		addedStores, _   = cmd.Flags().GetStringSlice(flagAddStore)
+		deletedStores, _ = cmd.Flags().GetStringSlice(flagDeleteStore)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.AddUpgrade(name, addedStores, deletedStores); err != nil {
+		return err
+	}
+
+	if applied, err := applyScaffoldModifications(cmd, session, sc, cacheStorage); err != nil || !applied {
+		return err
+	}
+	session.Printf("\n🎉 Created the %s upgrade.\n\n", name)
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package ignitecmd
 
 import (
+	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
@@ -32,6 +33,20 @@ func scaffoldVueHandler(cmd *cobra.Command, _ []string) error {
 	)
 	defer session.End()
 
+	if flagGetDryRun(cmd) {
+		// the app is created in a temporary directory to preview it.
+		tmpDir, err := os.MkdirTemp("", "ignite-scaffold-vue")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+
+		if err := cosmosgen.Vue(filepath.Join(tmpDir, chainconfig.DefaultVuePath)); err != nil {
+			return err
+		}
+		return previewScaffoldDir(cmd, session, tmpDir, ".")
+	}
+
 	path := filepath.Join(".", chainconfig.DefaultVuePath)
 	if err := cosmosgen.Vue(path); err != nil {
 		return err
@@ -0,0 +1,216 @@
+package base
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// VestingType is the type of vesting of an account.
+type VestingType string
+
+const (
+	// VestingContinuous vests the coins linearly from the start time to the end time.
+	VestingContinuous VestingType = "continuous"
+
+	// VestingDelayed vests all the coins at the end time.
+	VestingDelayed VestingType = "delayed"
+
+	// VestingPeriodic vests the coins of each period at the end of the period.
+	VestingPeriodic VestingType = "periodic"
+)
+
+// Module account permissions.
+const (
+	PermissionMinter  = "minter"
+	PermissionBurner  = "burner"
+	PermissionStaking = "staking"
+)
+
+// Vesting holds the vesting schedule of an account.
+type Vesting struct {
+	// Type is the type of vesting: continuous, delayed or periodic.
+	Type VestingType `yaml:"type" doc:"Type of vesting (continuous, delayed or periodic)."`
+
+	// Coins are the original vesting coins, all the account coins when empty.
+	Coins []string `yaml:"coins,omitempty" doc:"Original vesting coins (default is all the account coins)."`
+
+	// StartTime is the time when the vesting starts, the genesis time when empty.
+	StartTime string `yaml:"start_time,omitempty" doc:"RFC3339 time when the vesting starts (default is the genesis time)."`
+
+	// EndTime is the time when the continuous and delayed vesting ends.
+	EndTime string `yaml:"end_time,omitempty" doc:"RFC3339 time when the continuous or delayed vesting ends."`
+
+	// Periods are the periods of the periodic vesting.
+	Periods []VestingPeriod `yaml:"periods,omitempty" doc:"Periods of the periodic vesting."`
+}
+
+// VestingPeriod is a period of a periodic vesting.
+type VestingPeriod struct {
+	// Length is the duration of the period.
+	Length string `yaml:"length" doc:"Duration of the period (e.g. 720h)."`
+
+	// Coins are the coins vested at the end of the period.
+	Coins []string `yaml:"coins" doc:"Coins vested at the end of the period."`
+}
+
+// IsModule returns true when the account is a module account.
+func (a Account) IsModule() bool {
+	return a.Module != ""
+}
+
+// Validate checks the module account and the vesting options of the account.
+func (a Account) Validate() error {
+	if a.IsModule() {
+		if a.Address != "" || a.Mnemonic != "" {
+			return errors.Errorf("module account '%s' can't have an address or a mnemonic", a.Name)
+		}
+		if a.Vesting != nil {
+			return errors.Errorf("module account '%s' can't have a vesting", a.Name)
+		}
+		for _, p := range a.Permissions {
+			switch p {
+			case PermissionMinter, PermissionBurner, PermissionStaking:
+			default:
+				return errors.Errorf("module account '%s': invalid permission '%s'", a.Name, p)
+			}
+		}
+	} else if len(a.Permissions) > 0 {
+		return errors.Errorf("account '%s': permissions require a module account", a.Name)
+	}
+
+	if a.Vesting == nil {
+		return nil
+	}
+	if err := a.Vesting.validate(a.Coins); err != nil {
+		return errors.Errorf("account '%s': %w", a.Name, err)
+	}
+	return nil
+}
+
+// OriginalVesting returns the original vesting coins of the account.
+func (a Account) OriginalVesting() (sdk.Coins, error) {
+	if a.Vesting == nil {
+		return nil, nil
+	}
+	coins := a.Vesting.Coins
+	if len(coins) == 0 {
+		coins = a.Coins
+	}
+	return parseCoins(coins)
+}
+
+// Start returns the start time of the vesting, zero when it is not set.
+func (v Vesting) Start() (time.Time, error) {
+	return parseTime(v.StartTime)
+}
+
+// End returns the end time of the vesting, zero when it is not set.
+func (v Vesting) End() (time.Time, error) {
+	return parseTime(v.EndTime)
+}
+
+// Duration returns the length of the period.
+func (p VestingPeriod) Duration() (time.Duration, error) {
+	return time.ParseDuration(p.Length)
+}
+
+// Amount returns the coins vested at the end of the period.
+func (p VestingPeriod) Amount() (sdk.Coins, error) {
+	return parseCoins(p.Coins)
+}
+
+func (v Vesting) validate(accountCoins []string) error {
+	balance, err := parseCoins(accountCoins)
+	if err != nil {
+		return err
+	}
+	coins := v.Coins
+	if len(coins) == 0 {
+		coins = accountCoins
+	}
+	vesting, err := parseCoins(coins)
+	if err != nil {
+		return errors.Errorf("invalid vesting coins: %w", err)
+	}
+	if vesting.IsZero() {
+		return errors.New("vesting has no coins")
+	}
+	if !balance.IsAllGTE(vesting) {
+		return errors.Errorf("vesting coins %s exceed the account coins %s", vesting, balance)
+	}
+
+	start, err := v.Start()
+	if err != nil {
+		return errors.Errorf("invalid vesting start time: %w", err)
+	}
+	end, err := v.End()
+	if err != nil {
+		return errors.Errorf("invalid vesting end time: %w", err)
+	}
+
+	switch v.Type {
+	case VestingContinuous, VestingDelayed:
+		if len(v.Periods) > 0 {
+			return errors.Errorf("%s vesting can't have periods", v.Type)
+		}
+		if end.IsZero() {
+			return errors.Errorf("%s vesting requires an end time", v.Type)
+		}
+		if !start.IsZero() && !end.After(start) {
+			return errors.New("vesting end time must be after the start time")
+		}
+		if v.Type == VestingDelayed && !start.IsZero() {
+			return errors.New("delayed vesting can't have a start time")
+		}
+	case VestingPeriodic:
+		if v.EndTime != "" {
+			return errors.New("periodic vesting ends with its last period and can't have an end time")
+		}
+		if len(v.Periods) == 0 {
+			return errors.New("periodic vesting requires periods")
+		}
+		total := sdk.NewCoins()
+		for i, p := range v.Periods {
+			d, err := p.Duration()
+			if err != nil {
+				return errors.Errorf("vesting period #%d: invalid length: %w", i+1, err)
+			}
+			if d < time.Second {
+				return errors.Errorf("vesting period #%d: the length must be at least one second", i+1)
+			}
+			coins, err := p.Amount()
+			if err != nil {
+				return errors.Errorf("vesting period #%d: invalid coins: %w", i+1, err)
+			}
+			total = total.Add(coins...)
+		}
+		if !total.Equal(vesting) {
+			return errors.Errorf("vesting periods coins %s must sum to the vesting coins %s", total, vesting)
+		}
+	default:
+		return errors.Errorf("invalid vesting type '%s', use continuous, delayed or periodic", v.Type)
+	}
+	return nil
+}
+
+func parseCoins(coins []string) (sdk.Coins, error) {
+	parsed := sdk.NewCoins()
+	for _, c := range coins {
+		coin, err := sdk.ParseCoinNormalized(c)
+		if err != nil {
+			return nil, err
+		}
+		parsed = parsed.Add(coin)
+	}
+	return parsed, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
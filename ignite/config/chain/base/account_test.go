@@ -0,0 +1,178 @@
+package base_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+)
+
+func TestAccountValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		account base.Account
+		err     string
+	}{
+		{
+			name:    "base account",
+			account: base.Account{Name: "alice", Coins: []string{"100stake"}},
+		},
+		{
+			name: "continuous vesting",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"100stake", "50token"},
+				Vesting: &base.Vesting{
+					Type:      base.VestingContinuous,
+					Coins:     []string{"50token"},
+					StartTime: "2024-01-01T00:00:00Z",
+					EndTime:   "2025-01-01T00:00:00Z",
+				},
+			},
+		},
+		{
+			name: "delayed vesting",
+			account: base.Account{
+				Name:    "alice",
+				Coins:   []string{"100stake"},
+				Vesting: &base.Vesting{Type: base.VestingDelayed, EndTime: "2025-01-01T00:00:00Z"},
+			},
+		},
+		{
+			name: "periodic vesting",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"100stake"},
+				Vesting: &base.Vesting{
+					Type: base.VestingPeriodic,
+					Periods: []base.VestingPeriod{
+						{Length: "24h", Coins: []string{"30stake"}},
+						{Length: "48h", Coins: []string{"70stake"}},
+					},
+				},
+			},
+		},
+		{
+			name: "module account",
+			account: base.Account{
+				Name:        "treasury",
+				Coins:       []string{"100stake"},
+				Module:      "treasury",
+				Permissions: []string{base.PermissionMinter, base.PermissionBurner},
+			},
+		},
+		{
+			name: "invalid vesting type",
+			account: base.Account{
+				Name:    "alice",
+				Coins:   []string{"100stake"},
+				Vesting: &base.Vesting{Type: "linear"},
+			},
+			err: "account 'alice': invalid vesting type 'linear', use continuous, delayed or periodic",
+		},
+		{
+			name: "vesting coins exceed the account coins",
+			account: base.Account{
+				Name:    "alice",
+				Coins:   []string{"100stake"},
+				Vesting: &base.Vesting{Type: base.VestingDelayed, Coins: []string{"200stake"}, EndTime: "2025-01-01T00:00:00Z"},
+			},
+			err: "account 'alice': vesting coins 200stake exceed the account coins 100stake",
+		},
+		{
+			name: "continuous vesting without end time",
+			account: base.Account{
+				Name:    "alice",
+				Coins:   []string{"100stake"},
+				Vesting: &base.Vesting{Type: base.VestingContinuous},
+			},
+			err: "account 'alice': continuous vesting requires an end time",
+		},
+		{
+			name: "continuous vesting ending before its start",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"100stake"},
+				Vesting: &base.Vesting{
+					Type:      base.VestingContinuous,
+					StartTime: "2025-01-01T00:00:00Z",
+					EndTime:   "2024-01-01T00:00:00Z",
+				},
+			},
+			err: "account 'alice': vesting end time must be after the start time",
+		},
+		{
+			name: "invalid end time",
+			account: base.Account{
+				Name:    "alice",
+				Coins:   []string{"100stake"},
+				Vesting: &base.Vesting{Type: base.VestingDelayed, EndTime: "2025-01-01"},
+			},
+			err: "account 'alice': invalid vesting end time",
+		},
+		{
+			name: "periodic vesting not summing to the vesting coins",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"100stake"},
+				Vesting: &base.Vesting{
+					Type: base.VestingPeriodic,
+					Periods: []base.VestingPeriod{
+						{Length: "24h", Coins: []string{"30stake"}},
+						{Length: "48h", Coins: []string{"60stake"}},
+					},
+				},
+			},
+			err: "account 'alice': vesting periods coins 90stake must sum to the vesting coins 100stake",
+		},
+		{
+			name: "periodic vesting with invalid length",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"100stake"},
+				Vesting: &base.Vesting{
+					Type:    base.VestingPeriodic,
+					Periods: []base.VestingPeriod{{Length: "1 month", Coins: []string{"100stake"}}},
+				},
+			},
+			err: "account 'alice': vesting period #1: invalid length",
+		},
+		{
+			name: "module account with an address",
+			account: base.Account{
+				Name:    "treasury",
+				Module:  "treasury",
+				Address: "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw",
+			},
+			err: "module account 'treasury' can't have an address or a mnemonic",
+		},
+		{
+			name: "module account with invalid permission",
+			account: base.Account{
+				Name:        "treasury",
+				Module:      "treasury",
+				Permissions: []string{"admin"},
+			},
+			err: "module account 'treasury': invalid permission 'admin'",
+		},
+		{
+			name: "permissions without module",
+			account: base.Account{
+				Name:        "alice",
+				Permissions: []string{base.PermissionMinter},
+			},
+			err: "account 'alice': permissions require a module account",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.account.Validate()
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
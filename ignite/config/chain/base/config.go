@@ -17,6 +17,9 @@ type Account struct {
 	CoinType      string   `yaml:"cointype,omitempty" doc:"Coin type number for HD derivation (default is 118)."`
 	AccountNumber string   `yaml:"account_number,omitempty" doc:"Account number for HD derivation (must be ≤ 2147483647)."`
 	AddressIndex  string   `yaml:"address_index,omitempty" doc:"Address index number for HD derivation (must be ≤ 2147483647)."`
+	Vesting       *Vesting `yaml:"vesting,omitempty" doc:"Vesting schedule of the account coins."`
+	Module        string   `yaml:"module,omitempty" doc:"Module name of a module account, its address is derived from the name."`
+	Permissions   []string `yaml:"permissions,omitempty" doc:"Permissions of a module account (minter, burner or staking)."`
 }
 
 // Build holds build configs.
@@ -85,8 +88,107 @@ type Faucet struct {
 
 	// TxFee is the tx fee the faucet needs to pay for each transaction.
 	TxFee string `yaml:"tx_fee,omitempty" doc:"Tx fee the faucet needs to pay for each transaction."`
+
+	// Backend is how the faucet sends the coins, with the chain binary or in-process.
+	Backend string `yaml:"backend,omitempty" doc:"How the faucet sends the coins: 'binary' (default) runs the chain binary, 'client' signs and broadcasts the transactions in-process."`
+
+	// Ledger is the path of the file keeping the coins sent by the client backend.
+	Ledger string `yaml:"ledger,omitempty" doc:"Path of the file keeping the coins sent to each address by the 'client' backend, relative to the chain home (default: faucet.db)."`
+
+	// BatchSize is the maximum number of transfers sent in one transaction by the client backend.
+	BatchSize int `yaml:"batch_size,omitempty" doc:"Maximum number of transfers the 'client' backend sends in one transaction (default: 50)."`
+
+	// IPLimit limits the requests of each client IP.
+	IPLimit *FaucetLimit `yaml:"ip_limit,omitempty" doc:"Limit of requests of each client IP."`
+
+	// SubnetLimit limits the requests of each client subnet (/24 for IPv4, /64 for IPv6).
+	SubnetLimit *FaucetLimit `yaml:"subnet_limit,omitempty" doc:"Limit of requests of each client subnet, /24 for IPv4 and /64 for IPv6."`
+
+	// TrustedProxies are the CIDRs of the reverse proxies giving the client IP in their headers.
+	TrustedProxies []string `yaml:"trusted_proxies,omitempty" doc:"CIDRs of the reverse proxies whose X-Forwarded-For and X-Real-IP headers give the client IP."`
+
+	// AllowList holds the only addresses the faucet sends coins to.
+	AllowList []string `yaml:"allow_list,omitempty" doc:"Only addresses the faucet sends coins to."`
+
+	// DenyList holds the addresses the faucet never sends coins to.
+	DenyList []string `yaml:"deny_list,omitempty" doc:"Addresses the faucet never sends coins to."`
+
+	// Challenge is the challenge the clients solve to request coins.
+	Challenge *FaucetChallenge `yaml:"challenge,omitempty" doc:"Challenge the clients solve to request coins."`
+
+	// FeeGrant enables the fee allowances granted at /feegrant.
+	FeeGrant *FaucetFeeGrant `yaml:"fee_grant,omitempty" doc:"Fee allowance granted by the faucet account at /feegrant."`
+
+	// AdminToken is the bearer token of the faucet admin API, which is disabled without a token.
+	AdminToken string `yaml:"admin_token,omitempty" doc:"Bearer token of the admin API, the admin API is disabled without a token."`
+}
+
+// FaucetLimit is a limit of faucet requests in a time window.
+type FaucetLimit struct {
+	Requests int    `yaml:"requests" doc:"Number of requests allowed in the window."`
+	Window   string `yaml:"window" doc:"Duration of the window, like 1h."`
+}
+
+// FaucetFeeGrant is the x/feegrant allowance granted by the faucet account, a
+// periodic allowance when a period is set.
+type FaucetFeeGrant struct {
+	// SpendLimit is the maximum amount of fees the grantee can spend.
+	SpendLimit []string `yaml:"spend_limit,omitempty" doc:"Maximum amount of fees the grantee can spend, not limited when empty."`
+
+	// Expiration is the time after the grant the allowance expires.
+	Expiration string `yaml:"expiration,omitempty" doc:"Time after the grant the allowance expires, like 720h, it never expires when empty."`
+
+	// Period is the period of a periodic allowance.
+	Period string `yaml:"period,omitempty" doc:"Period of a periodic allowance, like 24h."`
+
+	// PeriodLimit is the maximum amount of fees the grantee can spend each period.
+	PeriodLimit []string `yaml:"period_limit,omitempty" doc:"Maximum amount of fees the grantee can spend each period."`
+
+	// AllowedMessages restricts the allowance to the fees of transactions with these messages.
+	AllowedMessages []string `yaml:"allowed_messages,omitempty" doc:"Type URLs of the messages the allowance pays the fees of."`
+}
+
+// FaucetChallenge configures the challenge the clients solve to request coins.
+type FaucetChallenge struct {
+	// Type is proof-of-work or captcha.
+	Type string `yaml:"type" doc:"Type of the challenge: 'proof-of-work' or 'captcha'."`
+
+	// Difficulty is the number of leading zero bits of a proof of work.
+	Difficulty int `yaml:"difficulty,omitempty" doc:"Number of leading zero bits of the proof-of-work hash."`
+
+	// TTL is the time a proof-of-work challenge can be solved in.
+	TTL string `yaml:"ttl,omitempty" doc:"Time a proof-of-work challenge can be solved in."`
+
+	// VerifyURL is the siteverify API of the captcha provider.
+	VerifyURL string `yaml:"verify_url,omitempty" doc:"Siteverify API URL of the captcha provider."`
+
+	// SiteKey is the site key of the captcha.
+	SiteKey string `yaml:"site_key,omitempty" doc:"Site key of the captcha."`
+
+	// Secret is the secret key of the captcha.
+	Secret string `yaml:"secret,omitempty" doc:"Secret key of the captcha."`
 }
 
+const (
+	// FaucetBackendBinary sends the faucet coins by running the chain binary.
+	FaucetBackendBinary = "binary"
+
+	// FaucetBackendClient signs and broadcasts the faucet transactions in-process.
+	FaucetBackendClient = "client"
+
+	// FaucetLedger is the default file of the faucet client backend ledger, in the chain home.
+	FaucetLedger = "faucet.db"
+
+	// FaucetChallengeProofOfWork requires the faucet clients to solve a proof of work.
+	FaucetChallengeProofOfWork = "proof-of-work"
+
+	// FaucetChallengeCaptcha requires the faucet clients to solve a captcha.
+	FaucetChallengeCaptcha = "captcha"
+
+	// FaucetMaxDifficulty is the maximum difficulty of the faucet proof of work.
+	FaucetMaxDifficulty = 32
+)
+
 // Init overwrites sdk configurations with given values.
 // Deprecated: Used in config v0 only.
 type Init struct {
@@ -135,6 +237,7 @@ type Config struct {
 	Version      version.Version `yaml:"version" doc:"Defines the configuration version number."`
 	Build        Build           `yaml:"build,omitempty" doc:"Contains build configuration options."`
 	Accounts     []Account       `yaml:"accounts" doc:"Lists the options for setting up Cosmos Accounts."`
+	AccountsFile string          `yaml:"accounts_file,omitempty" doc:"Path of a CSV or JSON file of addresses and coins added to the genesis."`
 	Faucet       Faucet          `yaml:"faucet,omitempty" doc:"Configuration for the faucet."`
 	Client       Client          `yaml:"client,omitempty" doc:"Configures client code generation."`
 	Genesis      xyaml.Map       `yaml:"genesis,omitempty" doc:"Custom genesis block modifications. Follow the nesting of the genesis file here to access all the parameters."`
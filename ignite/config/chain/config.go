@@ -9,6 +9,7 @@ import (
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
 	v0 "github.com/ignite/cli/v29/ignite/config/chain/v0"
 	v1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
 	"github.com/ignite/cli/v29/ignite/config/chain/version"
@@ -53,6 +54,9 @@ type (
 
 	// Validator defines the latest validator settings.
 	Validator = v1.Validator
+
+	// Account defines the latest account settings.
+	Account = base.Account
 )
 
 // DefaultChainConfig returns a config for the latest version initialized with default values.
@@ -2,6 +2,7 @@ package chain
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ignite/cli/v29/ignite/config/chain/version"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
@@ -19,6 +20,19 @@ func (e ValidationError) Error() string {
 	return fmt.Sprintf("config is not valid: %s", e.Message)
 }
 
+// ProfileNotFoundError is returned when the config profile is not defined in the config file.
+type ProfileNotFoundError struct {
+	Profile  string
+	Profiles []string
+}
+
+func (e ProfileNotFoundError) Error() string {
+	if len(e.Profiles) == 0 {
+		return fmt.Sprintf("config profile '%s' not found: the config has no profiles", e.Profile)
+	}
+	return fmt.Sprintf("config profile '%s' not found, available profiles: %s", e.Profile, strings.Join(e.Profiles, ", "))
+}
+
 // UnsupportedVersionError is returned when the version of the config is not supported.
 type UnsupportedVersionError struct {
 	Version version.Version
@@ -0,0 +1,81 @@
+package chain
+
+import (
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// envVarRegexp matches the escaped "$${" and the ${VAR}, ${VAR:-default} and ${VAR:?message} variables.
+var envVarRegexp = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_]*)(?:(:-|:\?)([^}]*))?\}`)
+
+// interpolateEnv replaces the environment variables in the scalar values of a YAML node.
+// ${VAR} is replaced by the value of VAR, ${VAR:-default} uses default when VAR is unset
+// or empty and ${VAR:?message} fails with message when VAR is unset or empty. "$${" is
+// replaced by a literal "${".
+func interpolateEnv(n *yaml.Node) error {
+	switch n.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, c := range n.Content {
+			if err := interpolateEnv(c); err != nil {
+				return err
+			}
+		}
+	case yaml.MappingNode:
+		// only the values are interpolated, keys are left as is.
+		for i := 1; i < len(n.Content); i += 2 {
+			if err := interpolateEnv(n.Content[i]); err != nil {
+				return err
+			}
+		}
+	case yaml.ScalarNode:
+		value, err := expandEnv(n.Value)
+		if err != nil {
+			return errors.Errorf("line %d: %w", n.Line, err)
+		}
+		if value == n.Value {
+			return nil
+		}
+		n.Value = value
+
+		// let plain values be resolved to their type, e.g. to use variables for numbers.
+		if n.Style == 0 {
+			n.Tag = ""
+		}
+	}
+	return nil
+}
+
+// expandEnv replaces the environment variables in s.
+func expandEnv(s string) (string, error) {
+	var err error
+	expanded := envVarRegexp.ReplaceAllStringFunc(s, func(match string) string {
+		if match == "$${" {
+			return "${"
+		}
+
+		m := envVarRegexp.FindStringSubmatch(match)
+		name, operator, arg := m[1], m[2], m[3]
+		value := os.Getenv(name)
+		if value != "" {
+			return value
+		}
+
+		switch operator {
+		case ":-":
+			return arg
+		case ":?":
+			if err == nil {
+				if arg == "" {
+					arg = "not set"
+				}
+				err = errors.Errorf("environment variable %s: %s", name, arg)
+			}
+		}
+		return value
+	})
+	return expanded, err
+}
@@ -2,6 +2,7 @@ package chain
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -17,13 +18,17 @@ import (
 	"github.com/ignite/cli/v29/ignite/config/chain/defaults"
 	"github.com/ignite/cli/v29/ignite/config/chain/version"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
 )
 
 // Parse reads a config file.
 // When the version of the file being read is not the latest
 // it is automatically migrated to the latest version.
-func Parse(configFile io.Reader) (*Config, error) {
-	cfg, err := parse(configFile)
+// The environment variables of the config values are interpolated, the included
+// config files are merged and finally the config profile is applied when one is
+// given as option.
+func Parse(configFile io.Reader, options ...ParseOption) (*Config, error) {
+	cfg, err := parse(configFile, options...)
 	if err != nil {
 		return cfg, errors.Errorf("error parsing config file: %w", err)
 	}
@@ -43,18 +48,37 @@ func ParseNetwork(configFile io.Reader) (*Config, error) {
 	return cfg, validateNetworkConfig(cfg)
 }
 
-func parse(configFile io.Reader) (*Config, error) {
-	var buf bytes.Buffer
+func parse(configFile io.Reader, options ...ParseOption) (*Config, error) {
+	var o parseOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	data, err := io.ReadAll(configFile)
+	if err != nil {
+		return DefaultChainConfig(), err
+	}
+
+	// Take the profile and interpolate the environment variables
+	data, profile, err := preprocess(data, o.profile, o.knownFields)
+	if err != nil {
+		return DefaultChainConfig(), err
+	}
 
 	// Read the config file version first to know how to decode it
-	v, err := ReadConfigVersion(io.TeeReader(configFile, &buf))
+	v, err := ReadConfigVersion(bytes.NewReader(data))
 	if err != nil {
 		return DefaultChainConfig(), err
 	}
 
+	// The profile is overlaid on the latest config
+	if profile != nil && v != LatestVersion {
+		return DefaultChainConfig(), &ValidationError{fmt.Sprintf("config profiles require the config version %d", LatestVersion)}
+	}
+
 	// Decode the current config file version and assign default
 	// values for the fields that are empty
-	c, err := decodeConfig(&buf, v)
+	c, err := decodeConfig(bytes.NewReader(data), v)
 	if err != nil {
 		return DefaultChainConfig(), err
 	}
@@ -72,15 +96,96 @@ func parse(configFile io.Reader) (*Config, error) {
 	}
 
 	// Handle includes
-	if err := handleIncludes(cfg); err != nil {
+	if err := handleIncludes(cfg, o.knownFields); err != nil {
 		return DefaultChainConfig(), err
 	}
 
+	// Apply the profile last so the included values never override the profile values
+	if profile != nil {
+		if cfg, err = profile.apply(cfg); err != nil {
+			return DefaultChainConfig(), err
+		}
+	}
+
 	return cfg, nil
 }
 
+// preprocess removes the profiles of the config file and returns the profile with the
+// given name, interpolates the environment variables of the config file values and
+// of the profile and, when knownFields is true, checks that the config and the profile
+// don't have unknown fields. The variables of the other profiles are never interpolated.
+func preprocess(data []byte, name string, knownFields bool) ([]byte, *profile, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+
+	// Keep empty documents as is
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		if name != "" {
+			return nil, nil, &ProfileNotFoundError{Profile: name}
+		}
+		return data, nil, nil
+	}
+
+	profile, err := takeProfile(doc.Content[0], name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := interpolateEnv(&doc); err != nil {
+		return nil, nil, err
+	}
+	if profile != nil {
+		if err := interpolateEnv(profile.fields); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if knownFields {
+		var v struct {
+			Version version.Version `yaml:"version"`
+		}
+		if err := doc.Decode(&v); err != nil {
+			return nil, nil, err
+		}
+		if err := checkKnownFields(doc.Content[0], v.Version); err != nil {
+			return nil, nil, err
+		}
+		if profile != nil {
+			if err := checkKnownFields(profile.fields, v.Version); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	data, err = yaml.Marshal(&doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, profile, nil
+}
+
+// checkKnownFields checks the fields of a config node with the config type of the
+// file version, unsupported versions are reported when the config is decoded.
+func checkKnownFields(n *yaml.Node, v version.Version) error {
+	if c, ok := Versions[v]; ok {
+		return xyaml.CheckKnownFields(n, c)
+	}
+	return nil
+}
+
+// WithKnownFields makes the parsing fail when the config file has fields that
+// don't exist in the config of the file version, like "coin" instead of "coins".
+// The errors contain the line and column of the unknown fields.
+func WithKnownFields() ParseOption {
+	return func(o *parseOptions) {
+		o.knownFields = true
+	}
+}
+
 // ParseFile parses a config from a file path.
-func ParseFile(path string) (*Config, error) {
+func ParseFile(path string, options ...ParseOption) (*Config, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return DefaultChainConfig(), err
@@ -88,7 +193,7 @@ func ParseFile(path string) (*Config, error) {
 
 	defer file.Close()
 
-	return Parse(file)
+	return Parse(file, options...)
 }
 
 // ParseNetworkFile parses a config for Ignite Network genesis from a file path.
@@ -152,12 +257,19 @@ func decodeConfig(r io.Reader, version version.Version) (version.Converter, erro
 }
 
 // validateConfig validates a chain configuration by checking that at least one
-// account exists and that all validators have required name and bonded fields.
+// account exists, that all validators have required name and bonded fields and
+// that the serve options are valid.
 func validateConfig(c *Config) error {
 	if len(c.Accounts) == 0 {
 		return &ValidationError{"at least one account is required"}
 	}
 
+	for _, account := range c.Accounts {
+		if err := account.Validate(); err != nil {
+			return &ValidationError{err.Error()}
+		}
+	}
+
 	for _, validator := range c.Validators {
 		if validator.Name == "" {
 			return &ValidationError{"validator 'name' is required"}
@@ -168,6 +280,10 @@ func validateConfig(c *Config) error {
 		}
 	}
 
+	if err := c.Serve.Watch.Validate(); err != nil {
+		return &ValidationError{err.Error()}
+	}
+
 	return nil
 }
 
@@ -198,7 +314,8 @@ func validateNetworkConfig(c *Config) error {
 
 // handleIncludes processes included configuration files referenced in the main config.
 // It supports both local files and remote URLs, merging their contents with the main config.
-func handleIncludes(cfg *Config) error {
+// The included files are checked for unknown fields when knownFields is true.
+func handleIncludes(cfg *Config, knownFields bool) error {
 	if len(cfg.Include) == 0 {
 		return nil
 	}
@@ -225,7 +342,11 @@ func handleIncludes(cfg *Config) error {
 		defer includeFile.Close()
 
 		// Parse the included config.
-		includeCfg, err := parse(includeFile)
+		var options []ParseOption
+		if knownFields {
+			options = append(options, WithKnownFields())
+		}
+		includeCfg, err := parse(includeFile, options...)
 		if err != nil {
 			return errors.Wrapf(err, "failed to parse included config file '%s'", includePath)
 		}
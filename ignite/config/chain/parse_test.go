@@ -13,6 +13,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
 	"github.com/ignite/cli/v29/ignite/config/chain/version"
 	"github.com/ignite/cli/v29/ignite/config/testdata"
 	"github.com/ignite/cli/v29/ignite/pkg/availableport"
@@ -506,3 +507,82 @@ func startTestServer(t *testing.T) string {
 
 	return fmt.Sprintf("http://localhost:%d", ports[0])
 }
+
+func TestParseUnknownFields(t *testing.T) {
+	config := `
+version: 1
+accounts:
+  - name: alice
+    coin: ["100token"]
+validators:
+  - name: alice
+    bonded: 100stake
+    gentx:
+      amout: 100stake
+`
+
+	// Unknown fields are ignored by default
+	_, err := chainconfig.Parse(strings.NewReader(config))
+	require.NoError(t, err)
+
+	_, err = chainconfig.Parse(strings.NewReader(config), chainconfig.WithKnownFields())
+	require.EqualError(t, err, `error parsing config file: line 5, column 5: unknown field "accounts[0].coin"
+line 10, column 7: unknown field "validators[0].gentx.amout"`)
+}
+
+func TestParseVestingAndModuleAccounts(t *testing.T) {
+	cfg, err := chainconfig.Parse(strings.NewReader(`
+version: 1
+accounts:
+  - name: alice
+    coins: ["100token", "200stake"]
+  - name: bob
+    coins: ["100token"]
+    vesting:
+      type: periodic
+      start_time: 2024-01-01T00:00:00Z
+      periods:
+        - length: 720h
+          coins: ["40token"]
+        - length: 720h
+          coins: ["60token"]
+  - name: treasury
+    coins: ["1000token"]
+    module: treasury
+    permissions: ["burner"]
+validators:
+  - name: alice
+    bonded: 100stake
+`))
+	require.NoError(t, err)
+	require.Equal(t, base.VestingPeriodic, cfg.Accounts[1].Vesting.Type)
+	require.Len(t, cfg.Accounts[1].Vesting.Periods, 2)
+	require.True(t, cfg.Accounts[2].IsModule())
+
+	_, err = chainconfig.Parse(strings.NewReader(`
+version: 1
+accounts:
+  - name: bob
+    coins: ["100token"]
+    vesting:
+      type: periodic
+      periods:
+        - length: 720h
+          coins: ["40token"]
+      lenght: 720h
+`), chainconfig.WithKnownFields())
+	require.EqualError(t, err, `error parsing config file: line 11, column 7: unknown field "accounts[0].vesting.lenght"`)
+
+	_, err = chainconfig.Parse(strings.NewReader(`
+version: 1
+accounts:
+  - name: bob
+    coins: ["100token"]
+    vesting:
+      type: periodic
+      periods:
+        - length: 720h
+          coins: ["40token"]
+`))
+	require.EqualError(t, err, "config is not valid: account 'bob': vesting periods coins 40token must sum to the vesting coins 100token")
+}
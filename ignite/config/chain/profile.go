@@ -0,0 +1,264 @@
+package chain
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// profilesKey is the config key that defines the config profiles.
+	profilesKey = "profiles"
+
+	// profileMergeKey is the profile key that defines the merge strategies of the profile fields.
+	profileMergeKey = "merge"
+
+	// profileNameKey is the key of the list items replaced by the profile items with the same name.
+	profileNameKey = "name"
+
+	// includeKey is the config key that lists the included config files.
+	includeKey = "include"
+
+	// versionKey is the config key of the config version.
+	versionKey = "version"
+)
+
+// MergeStrategy defines how a profile field is merged into the base config field.
+type MergeStrategy string
+
+const (
+	// MergeAppend appends the items of a profile list to the base list. The base
+	// items with the same name as a profile item are replaced by the profile item.
+	MergeAppend MergeStrategy = "append"
+
+	// MergeReplace replaces the base value with the profile value.
+	MergeReplace MergeStrategy = "replace"
+
+	// MergeDeep merges a profile map into the base map recursively.
+	MergeDeep MergeStrategy = "merge"
+)
+
+// defaultMergeStrategies are the merge strategies of the config fields when they are
+// not defined by the profile. Other maps are deep merged and other values are replaced.
+var defaultMergeStrategies = map[string]MergeStrategy{
+	"accounts": MergeAppend,
+}
+
+type (
+	// ParseOption configures the config parsing.
+	ParseOption func(*parseOptions)
+
+	parseOptions struct {
+		profile     string
+		knownFields bool
+	}
+)
+
+// WithProfile overlays the profile with the given name on the base config.
+// A profile is a partial config defined in the "profiles" section of the config file:
+//
+//	profiles:
+//	  ci:
+//	    merge:
+//	      accounts: replace
+//	    accounts:
+//	      - name: ci
+//	        coins: ["1000token"]
+//	    genesis:
+//	      chain_id: mars-ci
+//
+// The maps of the profile, like genesis, are deep merged into the base config, the
+// accounts are appended and the other values are replaced. The "merge"
+// section of a profile overrides these strategies for the given field paths.
+func WithProfile(name string) ParseOption {
+	return func(o *parseOptions) {
+		o.profile = name
+	}
+}
+
+// profile is a config profile selected with WithProfile.
+type profile struct {
+	// fields is the partial config overlaid on the config.
+	fields *yaml.Node
+
+	// strategies are the merge strategies of the profile field paths.
+	strategies map[string]MergeStrategy
+}
+
+// takeProfile removes the profiles section of the config document and returns the
+// named profile, or nil when the name is empty.
+func takeProfile(doc *yaml.Node, name string) (*profile, error) {
+	names := profileNames(doc)
+	profiles := removeKey(doc, profilesKey)
+	if name == "" {
+		return nil, nil
+	}
+
+	var fields *yaml.Node
+	if profiles != nil && profiles.Kind == yaml.MappingNode {
+		fields = lookupKey(profiles, name)
+	}
+	if fields == nil {
+		return nil, &ProfileNotFoundError{Profile: name, Profiles: names}
+	}
+	if fields.Kind != yaml.MappingNode {
+		return nil, &ValidationError{"profile '" + name + "' must be a map"}
+	}
+	// the includes are merged before the profile is applied.
+	if lookupKey(fields, includeKey) != nil {
+		return nil, &ValidationError{"profile '" + name + "' can't include config files"}
+	}
+
+	strategies := make(map[string]MergeStrategy)
+	if merge := removeKey(fields, profileMergeKey); merge != nil {
+		if err := merge.Decode(&strategies); err != nil {
+			return nil, errors.Wrapf(err, "invalid merge strategies of profile '%s'", name)
+		}
+	}
+	for path, strategy := range strategies {
+		switch strategy {
+		case MergeAppend, MergeReplace, MergeDeep:
+		default:
+			return nil, &ValidationError{"unknown merge strategy '" + string(strategy) + "' for '" + path + "'"}
+		}
+	}
+
+	return &profile{fields: fields, strategies: strategies}, nil
+}
+
+// apply overlays the profile on the config and returns the resulting config.
+// The default values of the config are kept, so they must be set before.
+func (p profile) apply(cfg *Config) (*Config, error) {
+	var doc yaml.Node
+	if err := doc.Encode(cfg); err != nil {
+		return nil, err
+	}
+	if err := overlay(&doc, p.fields, "", p.strategies); err != nil {
+		return nil, err
+	}
+
+	var profiled Config
+	if err := doc.Decode(&profiled); err != nil {
+		return nil, err
+	}
+	return &profiled, nil
+}
+
+// overlay merges the src mapping node into the dst mapping node.
+func overlay(dst, src *yaml.Node, path string, strategies map[string]MergeStrategy) error {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+		fieldPath := key.Value
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+
+		j := indexKey(dst, key.Value)
+		if j < 0 {
+			dst.Content = append(dst.Content, key, value)
+			continue
+		}
+		current := dst.Content[j+1]
+
+		strategy, ok := strategies[fieldPath]
+		if !ok {
+			strategy, ok = defaultMergeStrategies[fieldPath]
+		}
+		if !ok {
+			strategy = MergeReplace
+			if current.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode {
+				strategy = MergeDeep
+			}
+		}
+
+		switch strategy {
+		case MergeReplace:
+			dst.Content[j+1] = value
+		case MergeAppend:
+			if current.Kind != yaml.SequenceNode || value.Kind != yaml.SequenceNode {
+				return &ValidationError{"cannot append '" + fieldPath + "': it is not a list"}
+			}
+			appendItems(current, value)
+		case MergeDeep:
+			if current.Kind != yaml.MappingNode || value.Kind != yaml.MappingNode {
+				return &ValidationError{"cannot merge '" + fieldPath + "': it is not a map"}
+			}
+			if err := overlay(current, value, fieldPath, strategies); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// appendItems appends the src sequence items to the dst sequence,
+// replacing the dst items that have the same name as a src item.
+func appendItems(dst, src *yaml.Node) {
+	for _, item := range src.Content {
+		name := itemName(item)
+		replaced := false
+		for i, existing := range dst.Content {
+			if name != "" && itemName(existing) == name {
+				dst.Content[i] = item
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			dst.Content = append(dst.Content, item)
+		}
+	}
+}
+
+// itemName returns the name of a sequence item or an empty string when it has no name.
+func itemName(item *yaml.Node) string {
+	if item.Kind != yaml.MappingNode {
+		return ""
+	}
+	if name := lookupKey(item, profileNameKey); name != nil && name.Kind == yaml.ScalarNode {
+		return name.Value
+	}
+	return ""
+}
+
+// indexKey returns the index of the key in a mapping node or -1 when it is not found.
+func indexKey(n *yaml.Node, key string) int {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// lookupKey returns the value of the key in a mapping node or nil when it is not found.
+func lookupKey(n *yaml.Node, key string) *yaml.Node {
+	if i := indexKey(n, key); i >= 0 {
+		return n.Content[i+1]
+	}
+	return nil
+}
+
+// removeKey removes the key from a mapping node and returns its value.
+func removeKey(n *yaml.Node, key string) *yaml.Node {
+	i := indexKey(n, key)
+	if i < 0 {
+		return nil
+	}
+	value := n.Content[i+1]
+	n.Content = append(n.Content[:i], n.Content[i+2:]...)
+	return value
+}
+
+// profileNames returns the profile names defined in the config file.
+func profileNames(doc *yaml.Node) []string {
+	profiles := lookupKey(doc, profilesKey)
+	if profiles == nil || profiles.Kind != yaml.MappingNode {
+		return nil
+	}
+	names := make([]string, 0, len(profiles.Content)/2)
+	for i := 0; i+1 < len(profiles.Content); i += 2 {
+		names = append(names, profiles.Content[i].Value)
+	}
+	return names
+}
@@ -0,0 +1,226 @@
+package chain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+const profilesConfig = `
+version: 1
+accounts:
+  - name: alice
+    coins: ["100token"]
+  - name: bob
+    coins: ["200token"]
+build:
+  binary: marsd
+  ldflags: ["-X main.Env=dev"]
+genesis:
+  chain_id: mars-1
+  app_state:
+    staking:
+      params:
+        bond_denom: stake
+        max_validators: 100
+validators:
+  - name: alice
+    bonded: 100000000stake
+profiles:
+  ci:
+    accounts:
+      - name: bob
+        coins: ["999token"]
+      - name: ci
+        coins: ["300token"]
+    build:
+      ldflags: ["-X main.Env=ci"]
+    genesis:
+      app_state:
+        staking:
+          params:
+            max_validators: 3
+  staging:
+    merge:
+      accounts: replace
+      genesis: replace
+    accounts:
+      - name: staging
+        coins: ["400token"]
+    genesis:
+      chain_id: mars-staging
+  invalid:
+    merge:
+      accounts: prepend
+  include:
+    include:
+      - ./testdata/include1.yml
+`
+
+func TestParseWithProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  string
+		accounts []base.Account
+		ldflags  []string
+		genesis  xyaml.Map
+		err      string
+	}{
+		{
+			name: "no profile",
+			accounts: []base.Account{
+				{Name: "alice", Coins: []string{"100token"}},
+				{Name: "bob", Coins: []string{"200token"}},
+			},
+			ldflags: []string{"-X main.Env=dev"},
+			genesis: xyaml.Map{
+				"chain_id": "mars-1",
+				"app_state": map[string]interface{}{
+					"staking": map[string]interface{}{
+						"params": map[string]interface{}{"bond_denom": "stake", "max_validators": 100},
+					},
+				},
+			},
+		},
+		{
+			name:    "default merge strategies",
+			profile: "ci",
+			accounts: []base.Account{
+				{Name: "alice", Coins: []string{"100token"}},
+				{Name: "bob", Coins: []string{"999token"}},
+				{Name: "ci", Coins: []string{"300token"}},
+			},
+			ldflags: []string{"-X main.Env=ci"},
+			genesis: xyaml.Map{
+				"chain_id": "mars-1",
+				"app_state": map[string]interface{}{
+					"staking": map[string]interface{}{
+						"params": map[string]interface{}{"bond_denom": "stake", "max_validators": 3},
+					},
+				},
+			},
+		},
+		{
+			name:     "replace merge strategies",
+			profile:  "staging",
+			accounts: []base.Account{{Name: "staging", Coins: []string{"400token"}}},
+			ldflags:  []string{"-X main.Env=dev"},
+			genesis:  xyaml.Map{"chain_id": "mars-staging"},
+		},
+		{
+			name:    "unknown merge strategy",
+			profile: "invalid",
+			err:     "config is not valid: unknown merge strategy 'prepend' for 'accounts'",
+		},
+		{
+			name:    "profile with includes",
+			profile: "include",
+			err:     "config is not valid: profile 'include' can't include config files",
+		},
+		{
+			name:    "unknown profile",
+			profile: "prod",
+			err:     "config profile 'prod' not found, available profiles: ci, staging, invalid, include",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := chainconfig.Parse(strings.NewReader(profilesConfig), chainconfig.WithProfile(tt.profile))
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.accounts, cfg.Accounts)
+			require.Equal(t, tt.ldflags, cfg.Build.LDFlags)
+			require.Equal(t, "marsd", cfg.Build.Binary)
+			require.Equal(t, tt.genesis, cfg.Genesis)
+			require.Len(t, cfg.Validators, 1)
+		})
+	}
+}
+
+func TestParseWithEnv(t *testing.T) {
+	t.Setenv("MARS_CHAIN_ID", "mars-env")
+	t.Setenv("MARS_EMPTY", "")
+
+	cfg, err := chainconfig.Parse(strings.NewReader(`
+version: 1
+accounts:
+  - name: alice
+    coins: ["${MARS_COINS:-100token}"]
+faucet:
+  port: ${MARS_FAUCET_PORT:-4600}
+build:
+  binary: '${MARS_EMPTY:-marsd}'
+  ldflags: ["-X main.Escaped=$${HOME}"]
+genesis:
+  chain_id: ${MARS_CHAIN_ID}
+`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"100token"}, cfg.Accounts[0].Coins)
+	require.Equal(t, uint(4600), cfg.Faucet.Port)
+	require.Equal(t, "marsd", cfg.Build.Binary)
+	require.Equal(t, []string{"-X main.Escaped=${HOME}"}, cfg.Build.LDFlags)
+	require.Equal(t, "mars-env", cfg.Genesis["chain_id"])
+
+	_, err = chainconfig.Parse(strings.NewReader(`
+version: 1
+accounts:
+  - name: alice
+    mnemonic: ${MARS_MNEMONIC:?the alice mnemonic is required}
+`))
+	require.ErrorContains(t, err, "line 5: environment variable MARS_MNEMONIC: the alice mnemonic is required")
+}
+
+func TestParseWithProfileAndIncludes(t *testing.T) {
+	config := `
+version: 1
+accounts:
+  - name: alice
+    coins: ["100token"]
+include:
+  - ./testdata/include1.yml
+profiles:
+  ci:
+    client:
+      typescript:
+        path: ci
+  staging:
+    genesis:
+      chain_id: ${MARS_STAGING_CHAIN_ID:?the staging chain id is required}
+`
+
+	// the profile is applied on the included values.
+	cfg, err := chainconfig.Parse(strings.NewReader(config), chainconfig.WithProfile("ci"))
+	require.NoError(t, err)
+	require.Equal(t, "ci", cfg.Client.Typescript.Path)
+	require.Equal(t, "docs/static/include1.yml", cfg.Client.OpenAPI.Path)
+
+	cfg, err = chainconfig.Parse(strings.NewReader(config))
+	require.NoError(t, err)
+	require.Equal(t, "override-1", cfg.Client.Typescript.Path)
+
+	// the variables of the other profiles are not interpolated.
+	_, err = chainconfig.Parse(strings.NewReader(config), chainconfig.WithProfile("staging"))
+	require.ErrorContains(t, err, "environment variable MARS_STAGING_CHAIN_ID: the staging chain id is required")
+}
+
+func TestParseWithProfileOfOldVersion(t *testing.T) {
+	_, err := chainconfig.Parse(strings.NewReader(`
+version: 0
+accounts:
+  - name: alice
+    coins: ["100token"]
+profiles:
+  ci:
+    genesis:
+      chain_id: mars-ci
+`), chainconfig.WithProfile("ci"))
+	require.ErrorContains(t, err, "config profiles require the config version 1")
+}
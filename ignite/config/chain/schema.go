@@ -0,0 +1,22 @@
+package chain
+
+import (
+	"github.com/ignite/cli/v29/ignite/pkg/jsonschema"
+)
+
+// Schema returns the JSON Schema of the latest config version.
+func Schema() *jsonschema.Schema {
+	s := jsonschema.Reflect(Config{})
+	s.Schema = jsonschema.Draft
+	s.Title = "Ignite chain config"
+	s.Description = "Configuration of a blockchain built with Ignite CLI."
+
+	// Profiles are partial configs overlaid on the config and the
+	// merge strategies of their fields, see WithProfile.
+	s.Properties[profilesKey] = &jsonschema.Schema{
+		Type:                 jsonschema.TypeObject,
+		Description:          "Environment specific partial configs overlaid on the config with the --profile flag.",
+		AdditionalProperties: &jsonschema.Schema{Type: jsonschema.TypeObject},
+	}
+	return s
+}
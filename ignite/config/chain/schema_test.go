@@ -0,0 +1,41 @@
+package chain_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	jsonschemavalidator "github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/testdata"
+)
+
+func TestSchema(t *testing.T) {
+	schemaJSON, err := json.Marshal(chainconfig.Schema())
+	require.NoError(t, err)
+	schemaDoc, err := jsonschemavalidator.UnmarshalJSON(bytes.NewReader(schemaJSON))
+	require.NoError(t, err)
+
+	compiler := jsonschemavalidator.NewCompiler()
+	require.NoError(t, compiler.AddResource("config.json", schemaDoc))
+	schema, err := compiler.Compile("config.json")
+	require.NoError(t, err)
+
+	validate := func(configYAML []byte) error {
+		var config interface{}
+		require.NoError(t, yaml.Unmarshal(configYAML, &config))
+		configJSON, err := json.Marshal(config)
+		require.NoError(t, err)
+		doc, err := jsonschemavalidator.UnmarshalJSON(bytes.NewReader(configJSON))
+		require.NoError(t, err)
+		return schema.Validate(doc)
+	}
+
+	require.NoError(t, validate(testdata.Versions[chainconfig.LatestVersion]))
+	require.NoError(t, validate([]byte(profilesConfig)))
+	require.Error(t, validate([]byte("version: 1\naccounts:\n  - name: alice\n    coin: [\"1token\"]\n")))
+	require.Error(t, validate([]byte("version: -1\n")))
+}
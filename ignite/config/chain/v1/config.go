@@ -25,6 +25,7 @@ type Config struct {
 	base.Config `yaml:",inline"`
 
 	Validators []Validator `yaml:"validators" doc:"Contains information related to the list of validators and settings."`
+	Serve      Serve       `yaml:"serve,omitempty" doc:"Configures how the chain is served in development."`
 }
 
 func (c *Config) SetDefaults() error {
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gobwas/glob"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// WatchAction is the action performed by chain serve when a watched file changes.
+type WatchAction string
+
+const (
+	// WatchActionRebuild rebuilds the app and restarts the chain keeping its state.
+	// This is the action performed when no other action matches a changed file.
+	WatchActionRebuild WatchAction = "rebuild"
+
+	// WatchActionProto regenerates the code from the proto files and restarts the chain without rebuilding it.
+	WatchActionProto WatchAction = "proto"
+
+	// WatchActionRestart restarts the chain without rebuilding the app.
+	WatchActionRestart WatchAction = "restart"
+)
+
+// Serve holds the options used to serve the chain in development.
+type Serve struct {
+	// Watch configures the files watched to reload the chain.
+	Watch Watch `yaml:"watch,omitempty" doc:"Configures the files watched to reload the chain."`
+}
+
+// Watch configures the files watched by chain serve.
+// Paths are glob patterns relative to the app directory where "*" matches
+// any sequence of characters except "/" and "**" matches any sequence.
+type Watch struct {
+	// Include is a list of glob patterns of files watched in addition to the app source code.
+	Include []string `yaml:"include,omitempty" doc:"Glob patterns of files watched in addition to the app source code."`
+
+	// Exclude is a list of glob patterns of files that are not watched.
+	Exclude []string `yaml:"exclude,omitempty" doc:"Glob patterns of files that are not watched."`
+
+	// Debounce is the time to wait for more changes before reloading the chain.
+	Debounce string `yaml:"debounce,omitempty" doc:"Time to wait for more changes before reloading the chain (e.g. 500ms)."`
+
+	// Actions lists the actions performed when the files matching the patterns change.
+	Actions []WatchRule `yaml:"actions,omitempty" doc:"Actions performed when the files matching the patterns change."`
+}
+
+// WatchRule defines the action performed when the files matching the patterns change.
+type WatchRule struct {
+	// Paths is a list of glob patterns of the files.
+	Paths []string `yaml:"paths" doc:"Glob patterns of the files."`
+
+	// Action is the action performed when one of the files changes.
+	Action WatchAction `yaml:"action" doc:"Action performed when one of the files changes (rebuild, proto or restart)."`
+}
+
+// DebounceDuration returns the debounce interval, zero when it is not set.
+func (w Watch) DebounceDuration() (time.Duration, error) {
+	if w.Debounce == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(w.Debounce)
+}
+
+// Validate checks that the patterns, the debounce interval and the actions are valid.
+func (w Watch) Validate() error {
+	patterns := append(append([]string{}, w.Include...), w.Exclude...)
+	for _, r := range w.Actions {
+		switch r.Action {
+		case WatchActionRebuild, WatchActionProto, WatchActionRestart:
+		default:
+			return errors.Errorf("invalid serve watch action %q", r.Action)
+		}
+		if len(r.Paths) == 0 {
+			return errors.Errorf("serve watch action %q has no paths", r.Action)
+		}
+		patterns = append(patterns, r.Paths...)
+	}
+
+	for _, p := range patterns {
+		if _, err := glob.Compile(p, '/'); err != nil {
+			return errors.Errorf("invalid serve watch pattern %q: %w", p, err)
+		}
+	}
+
+	d, err := w.DebounceDuration()
+	if err != nil {
+		return errors.Errorf("invalid serve watch debounce %q: %w", w.Debounce, err)
+	}
+	if d < 0 {
+		return errors.Errorf("serve watch debounce %q can't be negative", w.Debounce)
+	}
+	return nil
+}
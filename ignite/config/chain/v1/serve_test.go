@@ -0,0 +1,84 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
+)
+
+func TestWatchValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		watch v1.Watch
+		err   string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			watch: v1.Watch{
+				Include:  []string{"docs/**/*.md"},
+				Exclude:  []string{"x/**/mocks/*"},
+				Debounce: "500ms",
+				Actions: []v1.WatchRule{
+					{Paths: []string{"proto/**"}, Action: v1.WatchActionProto},
+					{Paths: []string{"docs/**"}, Action: v1.WatchActionRestart},
+					{Paths: []string{"app/*.go"}, Action: v1.WatchActionRebuild},
+				},
+			},
+		},
+		{
+			name:  "invalid pattern",
+			watch: v1.Watch{Exclude: []string{"x/[a"}},
+			err:   `invalid serve watch pattern "x/[a"`,
+		},
+		{
+			name:  "invalid debounce",
+			watch: v1.Watch{Debounce: "1 minute"},
+			err:   `invalid serve watch debounce "1 minute"`,
+		},
+		{
+			name:  "negative debounce",
+			watch: v1.Watch{Debounce: "-1s"},
+			err:   `serve watch debounce "-1s" can't be negative`,
+		},
+		{
+			name: "invalid action",
+			watch: v1.Watch{Actions: []v1.WatchRule{
+				{Paths: []string{"docs/**"}, Action: "reload"},
+			}},
+			err: `invalid serve watch action "reload"`,
+		},
+		{
+			name: "action without paths",
+			watch: v1.Watch{Actions: []v1.WatchRule{
+				{Action: v1.WatchActionRestart},
+			}},
+			err: `serve watch action "restart" has no paths`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.watch.Validate()
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWatchDebounceDuration(t *testing.T) {
+	d, err := v1.Watch{}.DebounceDuration()
+	require.NoError(t, err)
+	require.Zero(t, d)
+
+	d, err = v1.Watch{Debounce: "1.5s"}.DebounceDuration()
+	require.NoError(t, err)
+	require.Equal(t, 1500*time.Millisecond, d)
+}
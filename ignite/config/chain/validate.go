@@ -0,0 +1,199 @@
+package chain
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+)
+
+// ValidationErrors is returned when a configuration has several issues.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Message
+	}
+	return fmt.Sprintf("config is not valid:\n- %s", strings.Join(messages, "\n- "))
+}
+
+// Validate checks the semantic rules of a chain configuration: the coins must be
+// valid, the account addresses must use the bech32 address prefix of the chain,
+// the account names must be unique and the first validator, which is bonded when
+// the chain is initialized, must have an account. It returns ValidationErrors with
+// all the issues found.
+func Validate(c *Config, addressPrefix string) error {
+	var errs ValidationErrors
+	addError := func(format string, args ...interface{}) {
+		errs = append(errs, ValidationError{fmt.Sprintf(format, args...)})
+	}
+
+	accounts := make(map[string]bool)
+	for i, account := range c.Accounts {
+		if account.Name == "" {
+			addError("account #%d: 'name' is required", i+1)
+		} else if accounts[account.Name] {
+			addError("account '%s': the name is used by another account", account.Name)
+		}
+		accounts[account.Name] = true
+
+		for _, coin := range account.Coins {
+			if _, err := sdk.ParseCoinNormalized(coin); err != nil {
+				addError("account '%s': invalid coin '%s': %s", account.Name, coin, err)
+			}
+		}
+
+		if account.Address != "" {
+			prefix, _, err := bech32.DecodeAndConvert(account.Address)
+			switch {
+			case err != nil:
+				addError("account '%s': invalid address '%s': %s", account.Name, account.Address, err)
+			case addressPrefix != "" && prefix != addressPrefix:
+				addError("account '%s': address '%s' must use the '%s' prefix", account.Name, account.Address, addressPrefix)
+			}
+		}
+	}
+
+	for _, coin := range c.Faucet.Coins {
+		if _, err := sdk.ParseCoinNormalized(coin); err != nil {
+			addError("faucet: invalid coin '%s': %s", coin, err)
+		}
+	}
+	for _, coin := range c.Faucet.CoinsMax {
+		if _, err := sdk.ParseCoinNormalized(coin); err != nil {
+			addError("faucet: invalid max coin '%s': %s", coin, err)
+		}
+	}
+
+	faucetLists := []struct {
+		name      string
+		addresses []string
+	}{
+		{"allow_list", c.Faucet.AllowList},
+		{"deny_list", c.Faucet.DenyList},
+	}
+	for _, list := range faucetLists {
+		name := list.name
+		for _, address := range list.addresses {
+			prefix, _, err := bech32.DecodeAndConvert(address)
+			switch {
+			case err != nil:
+				addError("faucet: invalid %s address '%s': %s", name, address, err)
+			case addressPrefix != "" && prefix != addressPrefix:
+				addError("faucet: %s address '%s' must use the '%s' prefix", name, address, addressPrefix)
+			}
+		}
+	}
+	faucetLimits := []struct {
+		name  string
+		limit *base.FaucetLimit
+	}{
+		{"ip_limit", c.Faucet.IPLimit},
+		{"subnet_limit", c.Faucet.SubnetLimit},
+	}
+	for _, l := range faucetLimits {
+		name, limit := l.name, l.limit
+		if limit == nil {
+			continue
+		}
+		if limit.Requests <= 0 {
+			addError("faucet: %s requests must be positive", name)
+		}
+		if window, err := time.ParseDuration(limit.Window); err != nil || window <= 0 {
+			addError("faucet: invalid %s window '%s'", name, limit.Window)
+		}
+	}
+	for _, proxy := range c.Faucet.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			addError("faucet: invalid trusted_proxies CIDR '%s'", proxy)
+		}
+	}
+	if challenge := c.Faucet.Challenge; challenge != nil {
+		switch challenge.Type {
+		case base.FaucetChallengeProofOfWork:
+			if challenge.Difficulty < 1 || challenge.Difficulty > base.FaucetMaxDifficulty {
+				addError("faucet: the challenge difficulty must be between 1 and %d", base.FaucetMaxDifficulty)
+			}
+			if challenge.TTL != "" {
+				if _, err := time.ParseDuration(challenge.TTL); err != nil {
+					addError("faucet: invalid challenge ttl '%s'", challenge.TTL)
+				}
+			}
+		case base.FaucetChallengeCaptcha:
+			if challenge.VerifyURL == "" || challenge.SiteKey == "" || challenge.Secret == "" {
+				addError("faucet: the captcha challenge requires 'verify_url', 'site_key' and 'secret'")
+			}
+		default:
+			addError("faucet: unknown challenge type '%s', use '%s' or '%s'", challenge.Type, base.FaucetChallengeProofOfWork, base.FaucetChallengeCaptcha)
+		}
+	}
+
+	if feeGrant := c.Faucet.FeeGrant; feeGrant != nil {
+		validateFeeGrant(feeGrant, addError)
+	}
+
+	switch c.Faucet.Backend {
+	case "", base.FaucetBackendBinary, base.FaucetBackendClient:
+	default:
+		addError("faucet: unknown backend '%s', use '%s' or '%s'", c.Faucet.Backend, base.FaucetBackendBinary, base.FaucetBackendClient)
+	}
+	if c.Faucet.BatchSize < 0 {
+		addError("faucet: batch_size must be positive")
+	}
+
+	for i, validator := range c.Validators {
+		if _, err := sdk.ParseCoinNormalized(validator.Bonded); err != nil {
+			addError("validator '%s': invalid bonded coin '%s': %s", validator.Name, validator.Bonded, err)
+		}
+
+		// Only the first validator is bonded with the key of its account on init
+		if i == 0 && c.IsSovereignChain() && !accounts[validator.Name] {
+			addError("validator '%s': no account with the validator name to bond the coins", validator.Name)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateFeeGrant checks the fee allowance granted by the faucet.
+func validateFeeGrant(feeGrant *base.FaucetFeeGrant, addError func(string, ...interface{})) {
+	for _, coins := range [][]string{feeGrant.SpendLimit, feeGrant.PeriodLimit} {
+		for _, coin := range coins {
+			if _, err := sdk.ParseCoinNormalized(coin); err != nil {
+				addError("faucet: invalid fee grant coin '%s': %s", coin, err)
+			}
+		}
+	}
+
+	var expiration, period time.Duration
+	if feeGrant.Expiration != "" {
+		var err error
+		if expiration, err = time.ParseDuration(feeGrant.Expiration); err != nil || expiration <= 0 {
+			addError("faucet: invalid fee grant expiration '%s'", feeGrant.Expiration)
+		}
+	}
+	if feeGrant.Period != "" {
+		var err error
+		if period, err = time.ParseDuration(feeGrant.Period); err != nil || period < time.Second {
+			addError("faucet: invalid fee grant period '%s'", feeGrant.Period)
+		}
+	}
+
+	switch {
+	case period > 0 && len(feeGrant.PeriodLimit) == 0:
+		addError("faucet: the fee grant period requires a 'period_limit'")
+	case feeGrant.Period == "" && len(feeGrant.PeriodLimit) > 0:
+		addError("faucet: the fee grant 'period_limit' requires a period")
+	case period > 0 && expiration > 0 && period > expiration:
+		addError("faucet: the fee grant period can't be longer than its expiration")
+	}
+}
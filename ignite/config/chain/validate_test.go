@@ -0,0 +1,157 @@
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	v1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
+)
+
+func TestValidate(t *testing.T) {
+	newConfig := func() *chainconfig.Config {
+		cfg := chainconfig.DefaultChainConfig()
+		cfg.Accounts = []base.Account{
+			{Name: "alice", Coins: []string{"1000token", "100000000stake"}},
+			{Name: "bob", Coins: []string{"500token"}, Address: "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw"},
+		}
+		cfg.Faucet.Coins = []string{"5token"}
+		cfg.Faucet.CoinsMax = []string{"100token"}
+		cfg.Validators = []v1.Validator{{Name: "alice", Bonded: "100000000stake"}}
+		return cfg
+	}
+
+	tests := []struct {
+		name   string
+		prefix string
+		update func(*chainconfig.Config)
+		errs   []string
+	}{
+		{
+			name:   "valid config",
+			prefix: "cosmos",
+		},
+		{
+			name:   "invalid coins",
+			prefix: "cosmos",
+			update: func(cfg *chainconfig.Config) {
+				cfg.Accounts[0].Coins = []string{"token"}
+				cfg.Faucet.Coins = []string{"-5token"}
+				cfg.Faucet.CoinsMax = []string{"100"}
+				cfg.Validators[0].Bonded = "stake"
+			},
+			errs: []string{
+				"account 'alice': invalid coin 'token'",
+				"faucet: invalid coin '-5token'",
+				"faucet: invalid max coin '100'",
+				"validator 'alice': invalid bonded coin 'stake'",
+			},
+		},
+		{
+			name:   "address with another prefix",
+			prefix: "mars",
+			errs:   []string{"account 'bob': address 'cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw' must use the 'mars' prefix"},
+		},
+		{
+			name:   "invalid address",
+			prefix: "cosmos",
+			update: func(cfg *chainconfig.Config) {
+				cfg.Accounts[1].Address = "cosmos1invalid"
+			},
+			errs: []string{"account 'bob': invalid address 'cosmos1invalid'"},
+		},
+		{
+			name:   "duplicated account names",
+			prefix: "cosmos",
+			update: func(cfg *chainconfig.Config) {
+				cfg.Accounts[1].Name = "alice"
+			},
+			errs: []string{"account 'alice': the name is used by another account"},
+		},
+		{
+			name:   "unknown faucet backend",
+			prefix: "cosmos",
+			update: func(cfg *chainconfig.Config) {
+				cfg.Faucet.Backend = "grpc"
+				cfg.Faucet.BatchSize = -1
+			},
+			errs: []string{
+				"faucet: unknown backend 'grpc', use 'binary' or 'client'",
+				"faucet: batch_size must be positive",
+			},
+		},
+		{
+			name:   "faucet protection",
+			prefix: "cosmos",
+			update: func(cfg *chainconfig.Config) {
+				cfg.Faucet.AllowList = []string{"cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw"}
+				cfg.Faucet.DenyList = []string{"cosmos1invalid"}
+				cfg.Faucet.IPLimit = &base.FaucetLimit{Requests: 5, Window: "1h"}
+				cfg.Faucet.SubnetLimit = &base.FaucetLimit{Requests: 0, Window: "forever"}
+				cfg.Faucet.TrustedProxies = []string{"10.0.0.0/8", "10.0.0.1"}
+				cfg.Faucet.Challenge = &base.FaucetChallenge{Type: base.FaucetChallengeProofOfWork, Difficulty: 64}
+			},
+			errs: []string{
+				"faucet: invalid deny_list address 'cosmos1invalid'",
+				"faucet: subnet_limit requests must be positive",
+				"faucet: invalid subnet_limit window 'forever'",
+				"faucet: invalid trusted_proxies CIDR '10.0.0.1'",
+				"faucet: the challenge difficulty must be between 1 and 32",
+			},
+		},
+		{
+			name:   "faucet captcha without secret",
+			prefix: "cosmos",
+			update: func(cfg *chainconfig.Config) {
+				cfg.Faucet.Challenge = &base.FaucetChallenge{Type: base.FaucetChallengeCaptcha, SiteKey: "key"}
+			},
+			errs: []string{"faucet: the captcha challenge requires 'verify_url', 'site_key' and 'secret'"},
+		},
+		{
+			name:   "faucet fee grant",
+			prefix: "cosmos",
+			update: func(cfg *chainconfig.Config) {
+				cfg.Faucet.FeeGrant = &base.FaucetFeeGrant{
+					SpendLimit: []string{"stake"},
+					Expiration: "1h",
+					Period:     "24h",
+				}
+			},
+			errs: []string{
+				"faucet: invalid fee grant coin 'stake'",
+				"faucet: the fee grant period requires a 'period_limit'",
+			},
+		},
+		{
+			name:   "validator without account",
+			prefix: "cosmos",
+			update: func(cfg *chainconfig.Config) {
+				cfg.Validators[0].Name = "carol"
+			},
+			errs: []string{"validator 'carol': no account with the validator name to bond the coins"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newConfig()
+			if tt.update != nil {
+				tt.update(cfg)
+			}
+
+			err := chainconfig.Validate(cfg, tt.prefix)
+			if len(tt.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var errs chainconfig.ValidationErrors
+			require.ErrorAs(t, err, &errs)
+			require.Len(t, errs, len(tt.errs))
+			for i, msg := range tt.errs {
+				require.Contains(t, errs[i].Message, msg)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package chaincmd
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -65,6 +68,11 @@ const (
 	optionNumValidator                     = "--v"
 	optionNodeDirPrefix                    = "--node-dir-prefix"
 	optionPorts                            = "--list-ports"
+	optionSpendLimit                       = "--spend-limit"
+	optionExpiration                       = "--expiration"
+	optionPeriod                           = "--period"
+	optionPeriodLimit                      = "--period-limit"
+	optionAllowedMessages                  = "--allowed-messages"
 
 	constTendermint = "tendermint"
 	constJSON       = "json"
@@ -546,6 +554,86 @@ func (c ChainCmd) BankSendCommand(fromAddress, toAddress, amount string, options
 	return c.cliCommand(command)
 }
 
+// FeeGrantOption for the FeeGrantCommand.
+type FeeGrantOption func([]string) []string
+
+// FeeGrantWithSpendLimit sets the maximum amount of fees the grantee can spend.
+func FeeGrantWithSpendLimit(limit sdk.Coins) FeeGrantOption {
+	return func(command []string) []string {
+		if !limit.Empty() {
+			return append(command, optionSpendLimit, limit.String())
+		}
+		return command
+	}
+}
+
+// FeeGrantWithExpiration sets the time the allowance expires.
+func FeeGrantWithExpiration(expiration time.Time) FeeGrantOption {
+	return func(command []string) []string {
+		if !expiration.IsZero() {
+			return append(command, optionExpiration, expiration.UTC().Format(time.RFC3339))
+		}
+		return command
+	}
+}
+
+// FeeGrantWithPeriod grants a periodic allowance: the grantee can spend limit every period.
+func FeeGrantWithPeriod(period time.Duration, limit sdk.Coins) FeeGrantOption {
+	return func(command []string) []string {
+		if period > 0 {
+			return append(command,
+				optionPeriod, strconv.FormatInt(int64(period.Seconds()), 10),
+				optionPeriodLimit, limit.String(),
+			)
+		}
+		return command
+	}
+}
+
+// FeeGrantWithAllowedMessages restricts the allowance to the fees of the messages.
+func FeeGrantWithAllowedMessages(messages ...string) FeeGrantOption {
+	return func(command []string) []string {
+		if len(messages) > 0 {
+			return append(command, optionAllowedMessages, strings.Join(messages, ","))
+		}
+		return command
+	}
+}
+
+// FeeGrantWithFees sets fees to pay along with transaction for the fee grant command.
+func FeeGrantWithFees(fee sdk.Coin) FeeGrantOption {
+	return func(command []string) []string {
+		if !fee.IsNil() {
+			return append(command, optionFees, fee.String())
+		}
+		return command
+	}
+}
+
+// FeeGrantCommand returns the command for granting a fee allowance to grantee.
+func (c ChainCmd) FeeGrantCommand(granter, grantee string, options ...FeeGrantOption) step.Option {
+	command := []string{
+		commandTx,
+		"feegrant",
+		"grant",
+		granter,
+		grantee,
+		optionBroadcastMode, flags.BroadcastSync,
+		optionYes,
+	}
+
+	// Apply the options provided by the user
+	for _, apply := range options {
+		command = apply(command)
+	}
+
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+
+	return c.cliCommand(command)
+}
+
 // QueryTxCommand returns the command to query tx.
 func (c ChainCmd) QueryTxCommand(txHash string) step.Option {
 	command := []string{
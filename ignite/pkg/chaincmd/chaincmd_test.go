@@ -2,7 +2,9 @@ package chaincmd
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
@@ -164,3 +166,42 @@ func TestKeyringBackendFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestFeeGrantCommand(t *testing.T) {
+	cmd := New("simd", WithChainID("my-chain"))
+	expiration := time.Date(2025, 1, 30, 15, 4, 5, 0, time.UTC)
+
+	s := step.New(cmd.FeeGrantCommand(
+		"faucet",
+		"cosmos1grantee",
+		FeeGrantWithSpendLimit(sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		FeeGrantWithExpiration(expiration),
+		FeeGrantWithPeriod(time.Hour, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		FeeGrantWithAllowedMessages("/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1.MsgVote"),
+		FeeGrantWithFees(sdk.Coin{}),
+	))
+
+	require.Equal(t, "simd", s.Exec.Command)
+	require.Equal(t, []string{
+		"tx",
+		"feegrant",
+		"grant",
+		"faucet",
+		"cosmos1grantee",
+		"--broadcast-mode",
+		"sync",
+		"--yes",
+		"--spend-limit",
+		"100stake",
+		"--expiration",
+		"2025-01-30T15:04:05Z",
+		"--period",
+		"3600",
+		"--period-limit",
+		"10stake",
+		"--allowed-messages",
+		"/cosmos.bank.v1beta1.MsgSend,/cosmos.gov.v1.MsgVote",
+		"--chain-id",
+		"my-chain",
+	}, s.Exec.Args)
+}
@@ -169,25 +169,9 @@ func (r Runner) Status(ctx context.Context) (NodeStatus, error) {
 // BankSend sends amount from fromAccount to toAccount.
 func (r Runner) BankSend(ctx context.Context, fromAccount, toAccount, amount string, options ...chaincmd.BankSendOption) (string, error) {
 	b := newBuffer()
-	opt := []step.Option{
-		r.chainCmd.BankSendCommand(fromAccount, toAccount, amount, options...),
-	}
-
-	if r.chainCmd.KeyringPassword() != "" {
-		input := newBuffer()
-		_, err := fmt.Fprintln(input, r.chainCmd.KeyringPassword())
-		if err != nil {
-			return "", err
-		}
-		_, err = fmt.Fprintln(input, r.chainCmd.KeyringPassword())
-		if err != nil {
-			return "", err
-		}
-		_, err = fmt.Fprintln(input, r.chainCmd.KeyringPassword())
-		if err != nil {
-			return "", err
-		}
-		opt = append(opt, step.Write(input.Bytes()))
+	opt, err := r.txOptions(r.chainCmd.BankSendCommand(fromAccount, toAccount, amount, options...))
+	if err != nil {
+		return "", err
 	}
 
 	if err := r.run(ctx, runOptions{stdout: b}, opt...); err != nil {
@@ -210,6 +194,48 @@ func (r Runner) BankSend(ctx context.Context, fromAccount, toAccount, amount str
 	return txResult.TxHash, nil
 }
 
+// FeeGrant grants a fee allowance from granterAccount to grantee.
+func (r Runner) FeeGrant(ctx context.Context, granterAccount, grantee string, options ...chaincmd.FeeGrantOption) (string, error) {
+	b := newBuffer()
+	opt, err := r.txOptions(r.chainCmd.FeeGrantCommand(granterAccount, grantee, options...))
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.run(ctx, runOptions{stdout: b}, opt...); err != nil {
+		return "", err
+	}
+
+	txResult, err := decodeTxResult(b)
+	if err != nil {
+		return "", err
+	}
+
+	if txResult.Code > 0 {
+		return "", errors.Errorf("cannot grant the fee allowance (SDK code %d): %s", txResult.Code, txResult.RawLog)
+	}
+
+	return txResult.TxHash, nil
+}
+
+// txOptions returns the step options of a tx command, which writes the keyring
+// password to the command input when the keyring has a password.
+func (r Runner) txOptions(command step.Option) ([]step.Option, error) {
+	opt := []step.Option{command}
+
+	if r.chainCmd.KeyringPassword() != "" {
+		input := newBuffer()
+		for i := 0; i < 3; i++ {
+			if _, err := fmt.Fprintln(input, r.chainCmd.KeyringPassword()); err != nil {
+				return nil, err
+			}
+		}
+		opt = append(opt, step.Write(input.Bytes()))
+	}
+
+	return opt, nil
+}
+
 // WaitTx waits until a tx is successfully added to a block and can be queried.
 func (r Runner) WaitTx(ctx context.Context, txHash string, retryDelay time.Duration, maxRetry int) error {
 	retry := 0
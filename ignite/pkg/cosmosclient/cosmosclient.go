@@ -4,6 +4,7 @@ package cosmosclient
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"io"
 	"net/url"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"cosmossdk.io/x/feegrant"
 	"github.com/cenkalti/backoff"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
@@ -66,6 +68,8 @@ const (
 
 	defaultTXsPerPage = 30
 
+	defaultBroadcastBackoff = 500 * time.Millisecond
+
 	searchHeight = "tx.height"
 
 	orderAsc = "asc"
@@ -133,6 +137,10 @@ type Client struct {
 	gasAdjustment float64
 	fees          string
 	generateOnly  bool
+
+	sequences        *SequenceManager
+	broadcastRetries uint64
+	broadcastBackoff time.Duration
 }
 
 // Option configures your client.
@@ -288,17 +296,39 @@ func WithSigner(signer Signer) Option {
 	}
 }
 
+// WithSequenceManager sets the manager of the account sequences used by BroadcastTx.
+// Clients sharing a manager can broadcast concurrently transactions from the same accounts.
+// When it is not provided, each client has its own manager.
+func WithSequenceManager(sequences *SequenceManager) Option {
+	return func(c *Client) {
+		c.sequences = sequences
+	}
+}
+
+// WithBroadcastRetry sets the number of times BroadcastTx broadcasts again a
+// transaction rejected because the mempool is full, because the node timed out
+// or because the account sequence doesn't match.
+// The delay between two broadcasts starts with initialBackoff and increases
+// exponentially.
+func WithBroadcastRetry(maxRetries uint64, initialBackoff time.Duration) Option {
+	return func(c *Client) {
+		c.broadcastRetries = maxRetries
+		c.broadcastBackoff = initialBackoff
+	}
+}
+
 // New creates a new client with given options.
 func New(ctx context.Context, options ...Option) (Client, error) {
 	c := Client{
-		nodeAddress:     defaultNodeAddress,
-		keyringBackend:  cosmosaccount.KeyringTest,
-		bech32Prefix:    cosmosaccount.AccountPrefixCosmos,
-		faucetAddress:   defaultFaucetAddress,
-		faucetDenom:     defaultFaucetDenom,
-		faucetMinAmount: defaultFaucetMinAmount,
-		out:             io.Discard,
-		gas:             strconv.Itoa(defaultGasLimit),
+		nodeAddress:      defaultNodeAddress,
+		keyringBackend:   cosmosaccount.KeyringTest,
+		bech32Prefix:     cosmosaccount.AccountPrefixCosmos,
+		faucetAddress:    defaultFaucetAddress,
+		faucetDenom:      defaultFaucetDenom,
+		faucetMinAmount:  defaultFaucetMinAmount,
+		out:              io.Discard,
+		gas:              strconv.Itoa(defaultGasLimit),
+		broadcastBackoff: defaultBroadcastBackoff,
 	}
 
 	var err error
@@ -365,6 +395,9 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 	if c.signer == nil {
 		c.signer = signer{}
 	}
+	if c.sequences == nil {
+		c.sequences = NewSequenceManager()
+	}
 	// set address prefix in SDK global config
 	c.SetConfigAddressPrefix()
 
@@ -546,13 +579,46 @@ func (c Client) lockBech32Prefix() (unlockFn func()) {
 	return mconf.Unlock
 }
 
+// BroadcastTx creates, signs and broadcasts a transaction with msgs and waits
+// for it to be included in a block.
+// The account sequence is managed by the client, so BroadcastTx can be called
+// concurrently for the same account: the transactions are signed one at a time
+// with consecutive sequences. When the sequence doesn't match the one of the
+// chain, it is fetched again and the transaction is broadcasted again.
+// Use WithBroadcastRetry to retry the transactions rejected because the mempool
+// is full or because the node timed out.
 func (c Client) BroadcastTx(ctx context.Context, account cosmosaccount.Account, msgs ...sdktypes.Msg) (Response, error) {
-	txService, err := c.CreateTx(ctx, account, msgs...)
+	return c.BroadcastTxWithOptions(ctx, account, TxOptions{}, msgs...)
+}
+
+// BroadcastTxWithOptions is like BroadcastTx, the options override the client
+// options for this transaction.
+func (c Client) BroadcastTxWithOptions(ctx context.Context, account cosmosaccount.Account, options TxOptions, msgs ...sdktypes.Msg) (Response, error) {
+	txService, resp, done, err := c.broadcastWithSequence(ctx, account, options, msgs...)
+	if err != nil {
+		return Response{}, err
+	}
+	defer done()
+
+	return txService.waitTx(ctx, resp)
+}
+
+// BroadcastTxAsyncWithOptions is like BroadcastTxWithOptions but it returns once
+// the transaction is accepted in the mempool, without waiting for it to be
+// included in a block. Use it when the indexer of the node is disabled.
+func (c Client) BroadcastTxAsyncWithOptions(ctx context.Context, account cosmosaccount.Account, options TxOptions, msgs ...sdktypes.Msg) (Response, error) {
+	txService, resp, done, err := c.broadcastWithSequence(ctx, account, options, msgs...)
 	if err != nil {
 		return Response{}, err
 	}
+	// the confirmation of the transaction is not waited for, so it is not kept
+	// in the pending transactions.
+	done()
 
-	return txService.Broadcast(ctx)
+	return Response{
+		Codec:      txService.clientContext.Codec,
+		TxResponse: resp,
+	}, nil
 }
 
 // CreateTxWithOptions creates a transaction with the given options.
@@ -780,11 +846,24 @@ func handleBroadcastResult(resp *sdktypes.TxResponse, err error) error {
 	}
 
 	if resp.Code > 0 {
-		return errors.Errorf("error code: '%d' msg: '%s'", resp.Code, resp.RawLog)
+		return errors.WithStack(TxError{Code: resp.Code, Codespace: resp.Codespace, Log: resp.RawLog})
 	}
 	return nil
 }
 
+// TxError is returned when a transaction is rejected by the node or fails once it
+// is included in a block, so its messages have no effect.
+type TxError struct {
+	Code      uint32
+	Codespace string
+	Log       string
+}
+
+// Error implements error.
+func (e TxError) Error() string {
+	return fmt.Sprintf("error code: '%d' msg: '%s'", e.Code, e.Log)
+}
+
 func (c *Client) prepareFactory(clientCtx client.Context) (tx.Factory, error) {
 	var (
 		from = clientCtx.GetFromAddress()
@@ -828,6 +907,7 @@ func (c Client) newContext() client.Context {
 	staking.RegisterInterfaces(interfaceRegistry)
 	cryptocodec.RegisterInterfaces(interfaceRegistry)
 	banktypes.RegisterInterfaces(interfaceRegistry)
+	feegrant.RegisterInterfaces(interfaceRegistry)
 
 	return client.Context{}.
 		WithChainID(c.chainID).
@@ -0,0 +1,286 @@
+package cosmosclient
+
+import (
+	"context"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// errSequenceMismatch is the error of a transaction signed with a wrong account sequence.
+const errSequenceMismatch = "account sequence mismatch"
+
+// ErrTxNotBroadcasted is matched by the errors of the transactions which can't be
+// created, like when their simulation fails, so they are not broadcasted.
+var ErrTxNotBroadcasted = errors.New("transaction not broadcasted")
+
+// expectedSequenceRe matches the sequence expected by the chain in a sequence mismatch error.
+var expectedSequenceRe = regexp.MustCompile(errSequenceMismatch + `, expected (\d+)`)
+
+// SequenceManager tracks the sequences of the accounts broadcasting transactions.
+// The transactions of an account are signed one at a time with consecutive sequences,
+// so they can be broadcasted concurrently without waiting for the previous ones
+// to be included in a block.
+// A SequenceManager can be shared by the clients of a chain with WithSequenceManager.
+type SequenceManager struct {
+	mu       sync.Mutex
+	accounts map[string]*accountSequence
+}
+
+// accountSequence is the sequence of an account and its pending transactions.
+type accountSequence struct {
+	// mu is locked while a transaction of the account is signed and broadcasted.
+	mu sync.Mutex
+
+	synced   bool
+	number   uint64
+	sequence uint64
+
+	// pending are the hashes of the broadcasted transactions which are not
+	// confirmed yet, by sequence.
+	pending map[uint64]string
+}
+
+// NewSequenceManager creates a new sequence manager.
+func NewSequenceManager() *SequenceManager {
+	return &SequenceManager{
+		accounts: make(map[string]*accountSequence),
+	}
+}
+
+// Pending returns the hashes of the broadcasted transactions of address which
+// are not confirmed yet, ordered by sequence.
+func (m *SequenceManager) Pending(address sdktypes.AccAddress) []string {
+	s := m.account(address)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sequences := make([]uint64, 0, len(s.pending))
+	for seq := range s.pending {
+		sequences = append(sequences, seq)
+	}
+	slices.Sort(sequences)
+
+	hashes := make([]string, len(sequences))
+	for i, seq := range sequences {
+		hashes[i] = s.pending[seq]
+	}
+	return hashes
+}
+
+// Reset forgets the sequence of address, it is fetched from the chain again
+// before the next transaction.
+func (m *SequenceManager) Reset(address sdktypes.AccAddress) {
+	s := m.account(address)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.synced = false
+}
+
+func (m *SequenceManager) account(address sdktypes.AccAddress) *accountSequence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := string(address)
+	s, ok := m.accounts[key]
+	if !ok {
+		s = &accountSequence{pending: make(map[uint64]string)}
+		m.accounts[key] = s
+	}
+	return s
+}
+
+// sync fetches the account number and sequence from the chain.
+// The pending transactions with a lower sequence are included in a block or dropped.
+func (s *accountSequence) sync(c Client, clientCtx client.Context) error {
+	num, seq, err := c.accountRetriever.GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	s.number, s.sequence, s.synced = num, seq, true
+	for pendingSeq := range s.pending {
+		if pendingSeq < seq {
+			delete(s.pending, pendingSeq)
+		}
+	}
+	return nil
+}
+
+// done removes a confirmed transaction from the pending ones.
+func (s *accountSequence) done(seq uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.pending, seq)
+}
+
+// broadcastWithSequence creates, signs and broadcasts a transaction with the
+// next sequence of the account.
+// When the sequence doesn't match, the sequence is fetched again and the
+// transaction is signed again. The transactions rejected because the mempool
+// is full or because the node timed out are broadcasted again with a backoff,
+// up to the number of retries set with WithBroadcastRetry.
+// The returned done func removes the transaction from the pending ones, it must
+// be called once the transaction is confirmed.
+func (c Client) broadcastWithSequence(
+	ctx context.Context,
+	account cosmosaccount.Account,
+	options TxOptions,
+	msgs ...sdktypes.Msg,
+) (txService TxService, resp *sdktypes.TxResponse, done func(), err error) {
+	sdkaddr, err := account.Record.GetAddress()
+	if err != nil {
+		return TxService{}, nil, nil, errors.WithStack(err)
+	}
+
+	s := c.sequences.account(sdkaddr)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clientCtx := c.context.
+		WithFromName(account.Name).
+		WithFromAddress(sdkaddr)
+
+	retryBackoff := backoff.NewExponentialBackOff()
+	retryBackoff.InitialInterval = c.broadcastBackoff
+	retryBackoff.MaxElapsedTime = 0
+	retryBackoff.Reset()
+
+	var signed bool
+	for retries := uint64(0); ; retries++ {
+		if !s.synced {
+			if err := s.sync(c, clientCtx); err != nil {
+				return TxService{}, nil, nil, notBroadcastedError{err}
+			}
+		}
+
+		// the tx is created again when the sequence changes, otherwise the same
+		// tx is broadcasted again, so the node finds it in its cache if the
+		// previous broadcast reached the mempool.
+		if !signed {
+			client := c
+			client.TxFactory = client.TxFactory.
+				WithAccountNumber(s.number).
+				WithSequence(s.sequence)
+
+			txService, err = client.CreateTxWithOptions(ctx, account, options, msgs...)
+			if err != nil {
+				// the gas simulation also fails when the sequence doesn't match
+				if retries == 0 && strings.Contains(err.Error(), errSequenceMismatch) {
+					s.synced = false
+					continue
+				}
+				return TxService{}, nil, nil, notBroadcastedError{err}
+			}
+			signed = true
+		}
+
+		resp, err = txService.signAndBroadcast(ctx, WithSequence(s.sequence))
+		switch {
+		case err == nil && (resp.Code == 0 || isBroadcastError(resp, sdkerrors.ErrTxInMempoolCache)):
+			seq := s.sequence
+			s.pending[seq] = resp.TxHash
+			s.sequence++
+			return txService, resp, func() { s.done(seq) }, nil
+
+		case isBroadcastError(resp, sdkerrors.ErrWrongSequence) || (err != nil && strings.Contains(err.Error(), errSequenceMismatch)):
+			// the sequence expected by the chain includes the txs of the mempool
+			// while the one of the account only includes the committed txs.
+			if err := s.sync(c, clientCtx); err != nil {
+				return TxService{}, nil, nil, err
+			}
+			if resp != nil {
+				if expected, ok := expectedSequence(resp.RawLog); ok && expected > s.sequence {
+					s.sequence = expected
+				}
+			}
+			signed = false
+
+			// a sequence mismatch is always retried once
+			if retries < max(c.broadcastRetries, 1) {
+				continue
+			}
+
+		case isBroadcastError(resp, sdkerrors.ErrMempoolIsFull) || isTimeout(err):
+			if retries < c.broadcastRetries {
+				select {
+				case <-ctx.Done():
+					return TxService{}, nil, nil, ctx.Err()
+				case <-time.After(retryBackoff.NextBackOff()):
+				}
+				continue
+			}
+		}
+
+		return TxService{}, nil, nil, handleBroadcastResult(resp, err)
+	}
+}
+
+// notBroadcastedError is the error of a transaction which is not broadcasted,
+// it matches ErrTxNotBroadcasted and keeps the message of the error.
+type notBroadcastedError struct {
+	err error
+}
+
+// Error implements error.
+func (e notBroadcastedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error which prevented the broadcast.
+func (e notBroadcastedError) Unwrap() error {
+	return e.err
+}
+
+// Is matches ErrTxNotBroadcasted.
+func (e notBroadcastedError) Is(target error) bool {
+	return target == ErrTxNotBroadcasted
+}
+
+// abciError is an error registered with an ABCI code, like the errors of sdkerrors.
+type abciError interface {
+	Codespace() string
+	ABCICode() uint32
+}
+
+// isBroadcastError checks if the broadcasted tx was rejected with err.
+func isBroadcastError(resp *sdktypes.TxResponse, err abciError) bool {
+	return resp != nil && resp.Codespace == err.Codespace() && resp.Code == err.ABCICode()
+}
+
+// isTimeout checks if the request to the node timed out.
+func isTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded) || strings.Contains(err.Error(), "timed out")
+}
+
+// expectedSequence returns the sequence expected by the chain in a sequence mismatch error.
+func expectedSequence(log string) (uint64, bool) {
+	m := expectedSequenceRe.FindStringSubmatch(log)
+	if m == nil {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(m[1], 10, 64)
+	return seq, err == nil
+}
@@ -0,0 +1,264 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func TestClientBroadcastTx(t *testing.T) {
+	var (
+		accountName = "bob"
+		passphrase  = "passphrase"
+		txHash      = []byte{1, 2, 3}
+	)
+	r, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	a, _, err := r.Create(accountName)
+	require.NoError(t, err)
+	key, err := r.Export(accountName, passphrase)
+	require.NoError(t, err)
+	sdkaddr, err := a.Record.GetAddress()
+	require.NoError(t, err)
+	msg := &banktypes.MsgSend{
+		FromAddress: sdkaddr.String(),
+		ToAddress:   "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga",
+		Amount: sdktypes.NewCoins(
+			sdktypes.NewCoin("token", math.NewIntFromUint64(1)),
+		),
+	}
+
+	// expectSign expects the tx to be signed with sequence.
+	expectSign := func(s suite, sequence uint64) {
+		s.signer.EXPECT().
+			Sign(mock.Anything, mock.MatchedBy(func(txf tx.Factory) bool {
+				return txf.Sequence() == sequence
+			}), "bob", mock.Anything, true).
+			Return(nil).Once()
+	}
+	rejected := func(err interface {
+		Codespace() string
+		ABCICode() uint32
+	}, log string,
+	) *ctypes.ResultBroadcastTx {
+		return &ctypes.ResultBroadcastTx{Codespace: err.Codespace(), Code: err.ABCICode(), Log: log}
+	}
+
+	tests := []struct {
+		name          string
+		opts          []cosmosclient.Option
+		expectedError string
+		setup         func(suite)
+	}{
+		{
+			name: "ok: sequence mismatch recovered",
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				expectSign(s, 2)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(rejected(sdkerrors.ErrWrongSequence, "account sequence mismatch, expected 5, got 2: incorrect account sequence"), nil).Once()
+				expectSign(s, 5)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{Hash: txHash}, nil).Once()
+				s.rpcClient.EXPECT().Tx(mock.Anything, txHash, false).
+					Return(&ctypes.ResultTx{Hash: txHash}, nil)
+			},
+		},
+		{
+			name:          "fail: sequence mismatch retried once",
+			expectedError: "error code: '32' msg: 'account sequence mismatch, expected 5, got 2: incorrect account sequence'",
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				s.signer.EXPECT().
+					Sign(mock.Anything, mock.Anything, "bob", mock.Anything, true).
+					Return(nil).Twice()
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(rejected(sdkerrors.ErrWrongSequence, "account sequence mismatch, expected 5, got 2: incorrect account sequence"), nil).Twice()
+			},
+		},
+		{
+			name:          "fail: mempool is full",
+			expectedError: "error code: '20' msg: 'mempool is full'",
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				expectSign(s, 2)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(rejected(sdkerrors.ErrMempoolIsFull, "mempool is full"), nil).Once()
+			},
+		},
+		{
+			name: "ok: mempool is full retried",
+			opts: []cosmosclient.Option{cosmosclient.WithBroadcastRetry(2, time.Millisecond)},
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				s.signer.EXPECT().
+					Sign(mock.Anything, mock.Anything, "bob", mock.Anything, true).
+					Return(nil).Times(3)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(rejected(sdkerrors.ErrMempoolIsFull, "mempool is full"), nil).Twice()
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{Hash: txHash}, nil).Once()
+				s.rpcClient.EXPECT().Tx(mock.Anything, txHash, false).
+					Return(&ctypes.ResultTx{Hash: txHash}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, tt.setup, tt.opts...)
+			account, err := c.AccountRegistry.Import(accountName, key, passphrase)
+			require.NoError(t, err)
+
+			_, err = c.BroadcastTx(context.Background(), account, msg)
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClientBroadcastTxConcurrent(t *testing.T) {
+	var (
+		accountName = "bob"
+		passphrase  = "passphrase"
+		txHash      = []byte{1, 2, 3}
+		txCount     = 10
+
+		mu        sync.Mutex
+		sequences []uint64
+	)
+	r, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	a, _, err := r.Create(accountName)
+	require.NoError(t, err)
+	key, err := r.Export(accountName, passphrase)
+	require.NoError(t, err)
+	sdkaddr, err := a.Record.GetAddress()
+	require.NoError(t, err)
+	msg := &banktypes.MsgSend{
+		FromAddress: sdkaddr.String(),
+		ToAddress:   "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga",
+		Amount: sdktypes.NewCoins(
+			sdktypes.NewCoin("token", math.NewIntFromUint64(1)),
+		),
+	}
+
+	sequenceManager := cosmosclient.NewSequenceManager()
+	c := newClient(t, func(s suite) {
+		s.expectPrepareFactory(sdkaddr)
+		s.signer.EXPECT().
+			Sign(mock.Anything, mock.Anything, "bob", mock.Anything, true).
+			RunAndReturn(func(_ context.Context, txf tx.Factory, _ string, _ client.TxBuilder, _ bool) error {
+				mu.Lock()
+				defer mu.Unlock()
+				sequences = append(sequences, txf.Sequence())
+				return nil
+			})
+		s.rpcClient.EXPECT().
+			BroadcastTxSync(mock.Anything, mock.Anything).
+			Return(&ctypes.ResultBroadcastTx{Hash: txHash}, nil)
+		s.rpcClient.EXPECT().Tx(mock.Anything, txHash, false).
+			Return(&ctypes.ResultTx{Hash: txHash}, nil)
+	}, cosmosclient.WithSequenceManager(sequenceManager))
+	account, err := c.AccountRegistry.Import(accountName, key, passphrase)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range txCount {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.BroadcastTx(context.Background(), account, msg)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// the account sequence is fetched once, then each tx uses the next sequence.
+	require.Len(t, sequences, txCount)
+	for i, seq := range sequences {
+		require.EqualValues(t, 2+i, seq)
+	}
+	require.Empty(t, sequenceManager.Pending(sdkaddr))
+}
+
+func TestClientBroadcastTxAsync(t *testing.T) {
+	var (
+		accountName = "bob"
+		passphrase  = "passphrase"
+		txHash      = []byte{1, 2, 3}
+	)
+	r, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	a, _, err := r.Create(accountName)
+	require.NoError(t, err)
+	key, err := r.Export(accountName, passphrase)
+	require.NoError(t, err)
+	sdkaddr, err := a.Record.GetAddress()
+	require.NoError(t, err)
+	msg := &banktypes.MsgSend{
+		FromAddress: sdkaddr.String(),
+		ToAddress:   "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga",
+		Amount: sdktypes.NewCoins(
+			sdktypes.NewCoin("token", math.NewIntFromUint64(1)),
+		),
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		sequenceManager := cosmosclient.NewSequenceManager()
+		c := newClient(t, func(s suite) {
+			s.expectPrepareFactory(sdkaddr)
+			s.signer.EXPECT().
+				Sign(mock.Anything, mock.Anything, "bob", mock.Anything, true).
+				Return(nil).Once()
+			s.rpcClient.EXPECT().
+				BroadcastTxSync(mock.Anything, mock.Anything).
+				Return(&ctypes.ResultBroadcastTx{Hash: txHash}, nil).Once()
+		}, cosmosclient.WithSequenceManager(sequenceManager))
+		account, err := c.AccountRegistry.Import(accountName, key, passphrase)
+		require.NoError(t, err)
+
+		// the tx is not waited for
+		resp, err := c.BroadcastTxAsyncWithOptions(context.Background(), account, cosmosclient.TxOptions{GasLimit: 100000}, msg)
+		require.NoError(t, err)
+		require.Equal(t, "010203", resp.TxHash)
+		require.Empty(t, sequenceManager.Pending(sdkaddr))
+	})
+
+	t.Run("fail: not broadcasted", func(t *testing.T) {
+		c := newClient(t, func(s suite) {
+			s.accountRetriever.EXPECT().
+				GetAccountNumberSequence(mock.Anything, sdkaddr).
+				Return(0, 0, errors.New("account not found")).Once()
+		})
+		account, err := c.AccountRegistry.Import(accountName, key, passphrase)
+		require.NoError(t, err)
+
+		_, err = c.BroadcastTxAsyncWithOptions(context.Background(), account, cosmosclient.TxOptions{}, msg)
+		require.EqualError(t, err, "account not found")
+		require.ErrorIs(t, err, cosmosclient.ErrTxNotBroadcasted)
+	})
+}
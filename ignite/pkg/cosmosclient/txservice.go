@@ -49,6 +49,17 @@ func (s TxService) Gas() uint64 {
 
 // broadcast signs and broadcasts the transaction returning the initial broadcast response.
 func (s TxService) broadcast(ctx context.Context, opts ...BroadcastOption) (*sdktypes.TxResponse, error) {
+	resp, err := s.signAndBroadcast(ctx, opts...)
+	if err := handleBroadcastResult(resp, err); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// signAndBroadcast signs and broadcasts the transaction, the broadcast response is
+// returned as is so the caller can inspect the code of a rejected transaction.
+func (s TxService) signAndBroadcast(ctx context.Context, opts ...BroadcastOption) (*sdktypes.TxResponse, error) {
 	defer s.client.lockBech32Prefix()()
 
 	// validate msgs.
@@ -84,12 +95,7 @@ func (s TxService) broadcast(ctx context.Context, opts ...BroadcastOption) (*sdk
 		return nil, errors.WithStack(err)
 	}
 
-	resp, err := s.clientContext.BroadcastTx(txBytes)
-	if err := handleBroadcastResult(resp, err); err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return s.clientContext.BroadcastTx(txBytes)
 }
 
 // Broadcast signs and broadcasts this tx.
@@ -103,6 +109,11 @@ func (s TxService) Broadcast(ctx context.Context, opts ...BroadcastOption) (Resp
 		return Response{}, err
 	}
 
+	return s.waitTx(ctx, resp)
+}
+
+// waitTx waits for the broadcasted tx to be included in a block and returns its result.
+func (s TxService) waitTx(ctx context.Context, resp *sdktypes.TxResponse) (Response, error) {
 	res, err := s.client.WaitForTx(ctx, resp.TxHash)
 	if err != nil {
 		return Response{}, err
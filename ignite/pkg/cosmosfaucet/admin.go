@@ -0,0 +1,208 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// maxRecentGrants is the number of recent transfers kept by the faucet.
+const maxRecentGrants = 100
+
+var (
+	// ErrFaucetPaused is returned when coins are requested while the faucet is paused.
+	ErrFaucetPaused = errors.New("the faucet is paused")
+
+	// ErrBalanceUnavailable is returned when the faucet backend can't get the faucet balance.
+	ErrBalanceUnavailable = errors.New("the faucet balance is only available with a backend implementing Balancer")
+)
+
+// LimitError is returned when a transfer exceeds the max amount of coins an account can receive.
+type LimitError struct {
+	msg string
+}
+
+// Error implements error.
+func (e LimitError) Error() string {
+	return e.msg
+}
+
+// RecentGrant is a transfer sent by the faucet.
+type RecentGrant struct {
+	Address string    `json:"address"`
+	Coins   sdk.Coins `json:"coins"`
+	Hash    string    `json:"hash"`
+	Time    time.Time `json:"time"`
+}
+
+// state is the state of the faucet shared by the copies of a faucet and changed at runtime.
+type state struct {
+	mu       sync.RWMutex
+	paused   bool
+	coins    sdk.Coins
+	coinsMax map[string]sdkmath.Int
+	resets   map[string]time.Time
+	recent   []RecentGrant
+}
+
+func newState(coins sdk.Coins, coinsMax map[string]sdkmath.Int) *state {
+	return &state{
+		coins:    coins,
+		coinsMax: coinsMax,
+		resets:   make(map[string]time.Time),
+	}
+}
+
+// Pause stops the faucet from sending coins until it is resumed.
+func (f Faucet) Pause() {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	f.state.paused = true
+}
+
+// Resume resumes the faucet paused by Pause.
+func (f Faucet) Resume() {
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	f.state.paused = false
+}
+
+// IsPaused tells whether the faucet is paused.
+func (f Faucet) IsPaused() bool {
+	if f.state == nil {
+		return false
+	}
+	f.state.mu.RLock()
+	defer f.state.mu.RUnlock()
+	return f.state.paused
+}
+
+// SetCoins changes the coins sent on each request and the maximum amounts of
+// coins an account can receive. The coins without a maximum amount are not limited.
+func (f Faucet) SetCoins(coins, coinsMax sdk.Coins) error {
+	if coins.Empty() {
+		return errors.New("the faucet requires at least one coin")
+	}
+	if err := coins.Validate(); err != nil {
+		return err
+	}
+	if err := coinsMax.Validate(); err != nil {
+		return err
+	}
+
+	limits := make(map[string]sdkmath.Int, len(coins))
+	for _, c := range coins {
+		limits[c.Denom] = coinsMax.AmountOf(c.Denom)
+	}
+
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	f.state.coins, f.state.coinsMax = coins.Sort(), limits
+	return nil
+}
+
+// Coins returns the coins sent on each request and the maximum amounts of coins
+// an account can receive.
+func (f Faucet) Coins() (coins, coinsMax sdk.Coins) {
+	coins, limits := f.amounts()
+	coinsMax = sdk.NewCoins()
+	for denom, amount := range limits {
+		if !amount.IsNil() && amount.IsPositive() {
+			coinsMax = coinsMax.Add(sdk.NewCoin(denom, amount))
+		}
+	}
+	return coins, coinsMax
+}
+
+// ResetLimits resets the limits of address, the coins it received so far are not
+// counted anymore to check its maximum amounts.
+func (f Faucet) ResetLimits(address string) error {
+	if f.ledger != nil {
+		f.ledgerMutex.Lock()
+		err := f.ledger.SetGrants(address, nil)
+		f.ledgerMutex.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	f.state.resets[address] = time.Now()
+	return nil
+}
+
+// RecentGrants returns the recent transfers sent by the faucet, the newest first.
+// Only the transfers to address are returned when it is not empty.
+func (f Faucet) RecentGrants(address string) []RecentGrant {
+	f.state.mu.RLock()
+	defer f.state.mu.RUnlock()
+
+	grants := make([]RecentGrant, 0, len(f.state.recent))
+	for i := len(f.state.recent) - 1; i >= 0; i-- {
+		if g := f.state.recent[i]; address == "" || g.Address == address {
+			grants = append(grants, g)
+		}
+	}
+	return grants
+}
+
+// Balance returns the balance of the faucet account.
+func (f Faucet) Balance(ctx context.Context) (sdk.Coins, error) {
+	balancer, ok := f.sender.(Balancer)
+	if !ok {
+		return nil, ErrBalanceUnavailable
+	}
+	return balancer.Balance(ctx)
+}
+
+// Address returns the address of the faucet account.
+func (f Faucet) Address(ctx context.Context) (string, error) {
+	if f.sender != nil {
+		return f.sender.Address(), nil
+	}
+	account, err := f.runner.ShowAccount(ctx, f.accountName)
+	if err != nil {
+		return "", err
+	}
+	return account.Address, nil
+}
+
+// amounts returns the coins sent on each request and the maximum amounts per denom.
+func (f Faucet) amounts() (sdk.Coins, map[string]sdkmath.Int) {
+	if f.state == nil {
+		return f.coins, f.coinsMax
+	}
+	f.state.mu.RLock()
+	defer f.state.mu.RUnlock()
+	return f.state.coins, f.state.coinsMax
+}
+
+// limitsResetTime returns the time the limits of address were reset.
+func (f Faucet) limitsResetTime(address string) time.Time {
+	if f.state == nil {
+		return time.Time{}
+	}
+	f.state.mu.RLock()
+	defer f.state.mu.RUnlock()
+	return f.state.resets[address]
+}
+
+// recordRecentGrant adds a transfer to the recent transfers.
+func (f Faucet) recordRecentGrant(g RecentGrant) {
+	if f.state == nil {
+		return
+	}
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+	f.state.recent = append(f.state.recent, g)
+	if len(f.state.recent) > maxRecentGrants {
+		f.state.recent = f.state.recent[len(f.state.recent)-maxRecentGrants:]
+	}
+}
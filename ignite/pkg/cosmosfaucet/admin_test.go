@@ -0,0 +1,95 @@
+package cosmosfaucet_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+)
+
+func adminRequest(f cosmosfaucet.Faucet, token, method, path, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	res := httptest.NewRecorder()
+	f.ServeHTTP(res, r)
+	return res
+}
+
+func TestServeHTTPAdmin(t *testing.T) {
+	f := newBackendFaucet(t, &sender{}, cosmosfaucet.Admin("secret"))
+
+	require.Equal(t, http.StatusUnauthorized, adminRequest(f, "", http.MethodGet, "/admin/status", "").Code)
+	require.Equal(t, http.StatusUnauthorized, adminRequest(f, "wrong", http.MethodGet, "/admin/status", "").Code)
+
+	res := adminRequest(f, "secret", http.MethodGet, "/admin/status", "")
+	require.Equal(t, http.StatusOK, res.Code)
+	var status cosmosfaucet.AdminStatusResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&status))
+	require.Equal(t, addressFaucet, status.Address)
+	require.Equal(t, "10token", status.Coins.String())
+	require.Equal(t, "25token", status.CoinsMax.String())
+	require.Equal(t, "1000token", status.Balance.String())
+
+	// alice reaches the max amount, until her limits are reset.
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressAlice, nil)).Code)
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressAlice, nil)).Code)
+	require.Equal(t, http.StatusInternalServerError, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressAlice, nil)).Code)
+	require.Equal(t, http.StatusNoContent, adminRequest(f, "secret", http.MethodPost, "/admin/reset", `{"address": "`+addressAlice+`"}`).Code)
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressAlice, nil)).Code)
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressBob, nil)).Code)
+
+	res = adminRequest(f, "secret", http.MethodGet, "/admin/grants?address="+addressAlice, "")
+	require.Equal(t, http.StatusOK, res.Code)
+	var grants []cosmosfaucet.RecentGrant
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&grants))
+	require.Len(t, grants, 3)
+	require.Equal(t, addressAlice, grants[0].Address)
+	require.Len(t, f.RecentGrants(""), 4)
+	require.Equal(t, addressBob, f.RecentGrants("")[0].Address)
+
+	require.Equal(t, http.StatusNoContent, adminRequest(f, "secret", http.MethodPost, "/admin/pause", "").Code)
+	require.True(t, f.IsPaused())
+	require.Equal(t, http.StatusServiceUnavailable, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressCarol, nil)).Code)
+	require.Equal(t, http.StatusNoContent, adminRequest(f, "secret", http.MethodPost, "/admin/resume", "").Code)
+
+	res = adminRequest(f, "secret", http.MethodPut, "/admin/coins", `{"coins": ["5token", "1stake"], "coins_max": ["5token"]}`)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressCarol, nil)).Code)
+	require.Equal(t, "1stake,5token", f.RecentGrants("")[0].Coins.String())
+	require.Equal(t, http.StatusInternalServerError, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressCarol, nil)).Code)
+
+	require.Equal(t, http.StatusBadRequest, adminRequest(f, "secret", http.MethodPut, "/admin/coins", `{"coins": []}`).Code)
+}
+
+func TestServeHTTPAdminDisabled(t *testing.T) {
+	f := newBackendFaucet(t, &sender{})
+	require.Equal(t, http.StatusNotFound, adminRequest(f, "secret", http.MethodGet, "/admin/status", "").Code)
+}
+
+func TestServeHTTPMetrics(t *testing.T) {
+	f := newBackendFaucet(t, &sender{}, cosmosfaucet.DenyList(addressBob))
+
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressAlice, nil)).Code)
+	require.Equal(t, http.StatusForbidden, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressBob, nil)).Code)
+
+	res := httptest.NewRecorder()
+	f.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, res.Code)
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	metrics := string(data)
+	require.Contains(t, metrics, "cosmosfaucet_requests_total 2")
+	require.Contains(t, metrics, `cosmosfaucet_denials_total{reason="address_not_allowed"} 1`)
+	require.Contains(t, metrics, `cosmosfaucet_dispensed_total{denom="token"} 10`)
+	require.Contains(t, metrics, `cosmosfaucet_balance{denom="token"} 1000`)
+	require.Contains(t, metrics, "cosmosfaucet_broadcast_duration_seconds_count 1")
+}
@@ -0,0 +1,143 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// DefaultBatchSize is the default maximum number of transfers sent in one transaction by a backend.
+const DefaultBatchSize = 50
+
+// Transfer is a transfer of coins from the faucet account to an address.
+type Transfer struct {
+	// Address is the address receiving the coins.
+	Address string
+
+	// Coins are the coins transferred.
+	Coins sdk.Coins
+}
+
+// Sender sends the coins of the faucet in-process, instead of running the chain binary.
+type Sender interface {
+	// Address returns the address of the faucet account.
+	Address() string
+
+	// Send transfers the coins from the faucet account in a single transaction
+	// and returns its hash.
+	Send(ctx context.Context, transfers []Transfer) (string, error)
+}
+
+// AddressChecker is implemented by the senders able to check that an address can
+// receive coins. The addresses are checked before the transfers are queued, so an
+// address which can't receive coins doesn't fail the transfers sent with it.
+type AddressChecker interface {
+	// CheckAddress returns an error wrapping ErrAddressNotAllowed when the address
+	// can't receive coins, like the module accounts.
+	CheckAddress(ctx context.Context, address string) error
+}
+
+// TxFailedError is returned by a Sender when the transaction wasn't broadcasted or
+// failed, so none of the coins of the transfers were sent.
+type TxFailedError struct {
+	Err error
+}
+
+// Error implements error.
+func (e TxFailedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error of the transaction.
+func (e TxFailedError) Unwrap() error {
+	return e.Err
+}
+
+// batchItem is a transfer waiting to be sent by a batcher.
+type batchItem struct {
+	transfer Transfer
+	hash     string
+	err      error
+	done     chan struct{}
+}
+
+// batcher sends the transfers with a sender. The transfers requested while a
+// transaction is being sent are queued and sent together in the next transaction.
+type batcher struct {
+	sender Sender
+	size   int
+
+	mu      sync.Mutex
+	queue   []*batchItem
+	sending bool
+}
+
+func newBatcher(sender Sender, size int) *batcher {
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+	return &batcher{sender: sender, size: size}
+}
+
+// transfer queues a transfer and waits until it is sent.
+// A queued transfer is sent even if ctx is canceled before.
+func (b *batcher) transfer(ctx context.Context, t Transfer) (string, error) {
+	item := &batchItem{transfer: t, done: make(chan struct{})}
+
+	b.mu.Lock()
+	b.queue = append(b.queue, item)
+	if !b.sending {
+		b.sending = true
+		go b.run(context.WithoutCancel(ctx))
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-item.done:
+		return item.hash, item.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// run sends the queued transfers in batches until the queue is empty.
+func (b *batcher) run(ctx context.Context) {
+	for {
+		b.mu.Lock()
+		if len(b.queue) == 0 {
+			b.sending = false
+			b.mu.Unlock()
+			return
+		}
+		n := min(len(b.queue), b.size)
+		items := b.queue[:n:n]
+		b.queue = b.queue[n:]
+		b.mu.Unlock()
+
+		transfers := make([]Transfer, len(items))
+		for i, item := range items {
+			transfers[i] = item.transfer
+		}
+
+		hash, err := b.sender.Send(ctx, transfers)
+
+		// the transfers of a failed batch are sent one by one, so one transfer
+		// can't fail the others and each caller gets the result of its transfer.
+		var txErr TxFailedError
+		if len(items) > 1 && errors.As(err, &txErr) {
+			for _, item := range items {
+				item.hash, item.err = b.sender.Send(ctx, []Transfer{item.transfer})
+				close(item.done)
+			}
+			continue
+		}
+
+		for _, item := range items {
+			item.hash, item.err = hash, err
+			close(item.done)
+		}
+	}
+}
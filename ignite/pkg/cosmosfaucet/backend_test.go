@@ -0,0 +1,319 @@
+package cosmosfaucet_test
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	addressFaucet = "cosmos1h2ame0d7hlqvrskrcnzud37ge89vhnxdutce3s"
+	addressAlice  = "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e"
+	addressBob    = "cosmos1ruszzg3rysjjvfeg9y4zktpd9chnqvfje038ze"
+	addressCarol  = "cosmos18cl5qs2zgdzy23j8fpy55j6vf48y75z395ggwe"
+	addressDave   = "cosmos1037huluqsxpg8py9s6rc3zv23wxgmr50qhc2ly"
+)
+
+// sender records the transfers sent by the faucet.
+type sender struct {
+	mu      sync.Mutex
+	batches [][]cosmosfaucet.Transfer
+	err     error
+
+	// failAddress fails the transactions sending coins to the address.
+	failAddress string
+
+	// blocked are the addresses which can't receive coins.
+	blocked []string
+
+	// grants are the fee allowances granted.
+	grants []feeGrant
+
+	// started is closed when the first transfer is sent, which waits until release is closed.
+	started, release chan struct{}
+	once             sync.Once
+}
+
+func (s *sender) Address() string {
+	return addressFaucet
+}
+
+func (s *sender) Balance(context.Context) (sdk.Coins, error) {
+	return sdk.NewCoins(sdk.NewInt64Coin("token", 1000)), nil
+}
+
+// feeGrant is a fee allowance granted by a sender.
+type feeGrant struct {
+	grantee   string
+	allowance cosmosfaucet.FeeAllowance
+}
+
+func (s *sender) GrantFees(_ context.Context, grantee string, allowance cosmosfaucet.FeeAllowance) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, g := range s.grants {
+		if g.grantee == grantee {
+			return "", errors.New("failed to execute message; message index: 0: fee allowance already exists: invalid request")
+		}
+	}
+	s.grants = append(s.grants, feeGrant{grantee, allowance})
+	return "hash", nil
+}
+
+func (s *sender) CheckAddress(_ context.Context, address string) error {
+	if slices.Contains(s.blocked, address) {
+		return errors.Wrap(cosmosfaucet.ErrAddressNotAllowed, address)
+	}
+	return nil
+}
+
+func (s *sender) Send(_ context.Context, transfers []cosmosfaucet.Transfer) (string, error) {
+	if s.release != nil {
+		s.once.Do(func() { close(s.started) })
+		<-s.release
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return "", s.err
+	}
+	for _, t := range transfers {
+		if t.Address == s.failAddress {
+			return "", cosmosfaucet.TxFailedError{Err: errors.New("tx failed")}
+		}
+	}
+	s.batches = append(s.batches, transfers)
+	return "hash", nil
+}
+
+func newBackendFaucet(t *testing.T, s cosmosfaucet.Sender, options ...cosmosfaucet.Option) cosmosfaucet.Faucet {
+	t.Helper()
+	options = append([]cosmosfaucet.Option{
+		cosmosfaucet.Backend(s),
+		cosmosfaucet.ChainID("mars"),
+		cosmosfaucet.Coin(sdkmath.NewInt(10), sdkmath.NewInt(25), "token"),
+	}, options...)
+	f, err := cosmosfaucet.New(context.Background(), chaincmdrunner.Runner{}, options...)
+	require.NoError(t, err)
+	return f
+}
+
+func TestTransferWithBackend(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		s     = &sender{}
+		f     = newBackendFaucet(t, s)
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+	)
+
+	hash, err := f.Transfer(ctx, addressAlice, coins)
+	require.NoError(t, err)
+	require.Equal(t, "hash", hash)
+
+	_, err = f.Transfer(ctx, addressAlice, coins)
+	require.NoError(t, err)
+
+	_, err = f.Transfer(ctx, addressAlice, coins)
+	require.EqualError(t, err, `ask less amount for "token" denom. account is reaching to the limit (25) that faucet can tolerate`)
+
+	_, err = f.Transfer(ctx, addressAlice, sdk.NewCoins(sdk.NewInt64Coin("token", 5)))
+	require.NoError(t, err)
+
+	_, err = f.Transfer(ctx, addressAlice, sdk.NewCoins(sdk.NewInt64Coin("token", 1)))
+	require.EqualError(t, err, `account has reached to the max. allowed amount (25) for "token" denom`)
+
+	// the limits are per address
+	_, err = f.Transfer(ctx, addressBob, coins)
+	require.NoError(t, err)
+
+	_, err = f.Transfer(ctx, "mars1t4097crpvf3kgetxva5xj6ntd3kkummsp5v8ae", coins)
+	require.EqualError(t, err, "invalid address mars1t4097crpvf3kgetxva5xj6ntd3kkummsp5v8ae: the address prefix must be cosmos")
+	_, err = f.Transfer(ctx, "cosmos1invalid", coins)
+	require.ErrorContains(t, err, "invalid address cosmos1invalid")
+
+	require.Equal(t, [][]cosmosfaucet.Transfer{
+		{{Address: addressAlice, Coins: coins}},
+		{{Address: addressAlice, Coins: coins}},
+		{{Address: addressAlice, Coins: sdk.NewCoins(sdk.NewInt64Coin("token", 5))}},
+		{{Address: addressBob, Coins: coins}},
+	}, s.batches)
+}
+
+func TestTransferWithBackendError(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		s     = &sender{err: errors.New("insufficient funds")}
+		f     = newBackendFaucet(t, s)
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+	)
+
+	for i := 0; i < 3; i++ {
+		_, err := f.Transfer(ctx, addressAlice, coins)
+		require.EqualError(t, err, "insufficient funds")
+	}
+
+	// failed transfers don't count in the limits
+	s.err = nil
+	_, err := f.Transfer(ctx, addressAlice, coins)
+	require.NoError(t, err)
+	_, err = f.Transfer(ctx, addressAlice, coins)
+	require.NoError(t, err)
+}
+
+func TestTransferWithBackendBatch(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		s     = &sender{started: make(chan struct{}), release: make(chan struct{})}
+		f     = newBackendFaucet(t, s, cosmosfaucet.BatchSize(2))
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 1))
+		wg    sync.WaitGroup
+	)
+
+	transfer := func(address string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := f.Transfer(ctx, address, coins)
+			require.NoError(t, err)
+		}()
+	}
+
+	// the first transfer is sent alone, the next ones are queued meanwhile
+	// and sent in batches of two transfers.
+	transfer(addressAlice)
+	<-s.started
+	transfer(addressBob)
+	transfer(addressCarol)
+	transfer(addressDave)
+	time.Sleep(100 * time.Millisecond)
+	close(s.release)
+	wg.Wait()
+
+	require.Len(t, s.batches, 3)
+	require.Equal(t, []cosmosfaucet.Transfer{{Address: addressAlice, Coins: coins}}, s.batches[0])
+	require.Len(t, s.batches[1], 2)
+	require.Len(t, s.batches[2], 1)
+}
+
+func TestTransferWithBackendBatchFailure(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		s     = &sender{started: make(chan struct{}), release: make(chan struct{}), failAddress: addressCarol}
+		f     = newBackendFaucet(t, s)
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 1))
+		wg    sync.WaitGroup
+		errs  sync.Map
+	)
+
+	transfer := func(address string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := f.Transfer(ctx, address, coins)
+			errs.Store(address, err)
+		}()
+	}
+
+	// the batch of bob, carol and dave fails, so the transfers are sent one by one.
+	transfer(addressAlice)
+	<-s.started
+	transfer(addressBob)
+	transfer(addressCarol)
+	transfer(addressDave)
+	time.Sleep(100 * time.Millisecond)
+	close(s.release)
+	wg.Wait()
+
+	for _, address := range []string{addressAlice, addressBob, addressDave} {
+		err, _ := errs.Load(address)
+		require.Nil(t, err, address)
+	}
+	err, _ := errs.Load(addressCarol)
+	require.EqualError(t, err.(error), "tx failed")
+	require.Len(t, s.batches, 3)
+	for _, batch := range s.batches {
+		require.Len(t, batch, 1)
+	}
+}
+
+func TestTransferWithBackendBlockedAddress(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		s      = &sender{blocked: []string{addressBob}}
+		ledger = cosmosfaucet.NewMemoryLedger()
+		f      = newBackendFaucet(t, s, cosmosfaucet.Grants(ledger))
+		coins  = sdk.NewCoins(sdk.NewInt64Coin("token", 1))
+	)
+
+	_, err := f.Transfer(ctx, addressBob, coins)
+	require.ErrorIs(t, err, cosmosfaucet.ErrAddressNotAllowed)
+	require.Empty(t, s.batches)
+
+	// the address is rejected before the grant is recorded
+	grants, err := ledger.Grants(addressBob)
+	require.NoError(t, err)
+	require.Empty(t, grants)
+}
+
+func TestCacheLedger(t *testing.T) {
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+
+	var (
+		ctx   = context.Background()
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+		f     = newBackendFaucet(t, &sender{}, cosmosfaucet.Grants(cosmosfaucet.NewCacheLedger(storage)))
+	)
+	_, err = f.Transfer(ctx, addressAlice, coins)
+	require.NoError(t, err)
+	_, err = f.Transfer(ctx, addressAlice, coins)
+	require.NoError(t, err)
+
+	// a new faucet keeps the grants of the previous one
+	f = newBackendFaucet(t, &sender{}, cosmosfaucet.Grants(cosmosfaucet.NewCacheLedger(storage)))
+	_, err = f.Transfer(ctx, addressAlice, coins)
+	require.ErrorContains(t, err, "ask less amount")
+
+	grants, err := cosmosfaucet.NewCacheLedger(storage).Grants(addressAlice)
+	require.NoError(t, err)
+	require.Len(t, grants, 2)
+	require.Equal(t, coins, grants[0].Coins)
+}
+
+func TestBackendRefreshWindow(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		ledger = cosmosfaucet.NewMemoryLedger()
+		coins  = sdk.NewCoins(sdk.NewInt64Coin("token", 20))
+		f      = newBackendFaucet(t, &sender{}, cosmosfaucet.Grants(ledger), cosmosfaucet.RefreshWindow(time.Hour))
+	)
+
+	require.NoError(t, ledger.SetGrants(addressAlice, []cosmosfaucet.Grant{
+		{Coins: coins, Time: time.Now().Add(-2 * time.Hour)},
+	}))
+
+	// the expired grant is removed
+	_, err := f.Transfer(ctx, addressAlice, coins)
+	require.NoError(t, err)
+	grants, err := ledger.Grants(addressAlice)
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+}
+
+func TestNewWithBackend(t *testing.T) {
+	_, err := cosmosfaucet.New(context.Background(), chaincmdrunner.Runner{}, cosmosfaucet.Backend(&sender{}))
+	require.EqualError(t, err, "the chain id is required to use a faucet backend")
+}
@@ -0,0 +1,252 @@
+package cosmosfaucet
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math/bits"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// ChallengeProofOfWork is the type of the proof-of-work challenges.
+	ChallengeProofOfWork = "proof-of-work"
+
+	// ChallengeCaptcha is the type of the captcha challenges.
+	ChallengeCaptcha = "captcha"
+
+	// DefaultChallengeTTL is the time a proof-of-work challenge can be solved in.
+	DefaultChallengeTTL = 5 * time.Minute
+
+	// DefaultMaxChallenges is the maximum number of unsolved proof-of-work challenges,
+	// the oldest challenges are removed when more are requested.
+	DefaultMaxChallenges = 10000
+)
+
+// ErrChallengeFailed is returned when a transfer request doesn't solve its challenge.
+var ErrChallengeFailed = errors.New("challenge failed")
+
+// ChallengeVerifier verifies the challenges the clients solve to request coins,
+// like a captcha or a proof of work.
+type ChallengeVerifier interface {
+	// Challenge returns a new challenge for a client, the faucet serves it at /challenge.
+	Challenge(ctx context.Context) (Challenge, error)
+
+	// Verify returns an error wrapping ErrChallengeFailed when the solution of
+	// the transfer request doesn't solve its challenge.
+	Verify(ctx context.Context, req TransferRequest) error
+}
+
+// Challenge is a challenge to solve to request coins.
+type Challenge struct {
+	// Type is the type of the challenge.
+	Type string `json:"type"`
+
+	// Challenge is the challenge to solve, the site key of a captcha.
+	Challenge string `json:"challenge"`
+
+	// Difficulty is the number of leading zero bits of a proof of work.
+	Difficulty int `json:"difficulty,omitempty"`
+
+	// ExpiresAt is the time the challenge must be solved before.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ProofOfWork is a challenge verifier serving proof-of-work challenges. A solution
+// is a nonce such that the SHA-256 hash of the challenge, the address and the nonce
+// starts with difficulty zero bits. The challenges can be solved once before they expire.
+// Up to DefaultMaxChallenges unsolved challenges are kept, the oldest ones are removed first.
+type ProofOfWork struct {
+	difficulty    int
+	ttl           time.Duration
+	maxChallenges int
+
+	mu sync.Mutex
+
+	// challenges are the elements of order by challenge.
+	challenges map[string]*list.Element
+
+	// order holds the unsolved challenges from the oldest to the newest.
+	order *list.List
+}
+
+// issuedChallenge is an unsolved proof-of-work challenge.
+type issuedChallenge struct {
+	challenge string
+	expiresAt time.Time
+}
+
+// NewProofOfWork returns a proof-of-work challenge verifier. The challenges expire after ttl.
+func NewProofOfWork(difficulty int, ttl time.Duration) *ProofOfWork {
+	if ttl == 0 {
+		ttl = DefaultChallengeTTL
+	}
+	return &ProofOfWork{
+		difficulty:    difficulty,
+		ttl:           ttl,
+		maxChallenges: DefaultMaxChallenges,
+		challenges:    make(map[string]*list.Element),
+		order:         list.New(),
+	}
+}
+
+// Challenge returns a new proof-of-work challenge.
+func (p *ProofOfWork) Challenge(context.Context) (Challenge, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return Challenge{}, err
+	}
+	challenge := hex.EncodeToString(nonce)
+	expiresAt := time.Now().Add(p.ttl)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.prune()
+	for p.order.Len() >= p.maxChallenges {
+		p.remove(p.order.Front())
+	}
+	p.challenges[challenge] = p.order.PushBack(issuedChallenge{challenge, expiresAt})
+
+	return Challenge{
+		Type:       ChallengeProofOfWork,
+		Challenge:  challenge,
+		Difficulty: p.difficulty,
+		ExpiresAt:  &expiresAt,
+	}, nil
+}
+
+// Verify checks the proof of work of the transfer request and invalidates its challenge.
+func (p *ProofOfWork) Verify(_ context.Context, req TransferRequest) error {
+	p.mu.Lock()
+	e, ok := p.challenges[req.Challenge]
+	if ok {
+		p.remove(e)
+	}
+	p.mu.Unlock()
+
+	switch {
+	case !ok:
+		return errors.Wrap(ErrChallengeFailed, "unknown or already solved challenge")
+	case time.Now().After(e.Value.(issuedChallenge).expiresAt):
+		return errors.Wrap(ErrChallengeFailed, "the challenge expired")
+	case proofOfWorkZeros(req.Challenge, req.AccountAddress, req.Solution) < p.difficulty:
+		return errors.Wrap(ErrChallengeFailed, "invalid proof of work")
+	}
+	return nil
+}
+
+// prune removes the expired challenges, which are the oldest ones.
+func (p *ProofOfWork) prune() {
+	now := time.Now()
+	for e := p.order.Front(); e != nil && now.After(e.Value.(issuedChallenge).expiresAt); e = p.order.Front() {
+		p.remove(e)
+	}
+}
+
+// remove removes the challenge of the element.
+func (p *ProofOfWork) remove(e *list.Element) {
+	delete(p.challenges, p.order.Remove(e).(issuedChallenge).challenge)
+}
+
+// SolveProofOfWork returns the nonce solving the proof-of-work challenge for address.
+func SolveProofOfWork(ctx context.Context, challenge, address string, difficulty int) (string, error) {
+	for nonce := uint64(0); ; nonce++ {
+		if nonce%(1<<16) == 0 && ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		solution := strconv.FormatUint(nonce, 10)
+		if proofOfWorkZeros(challenge, address, solution) >= difficulty {
+			return solution, nil
+		}
+	}
+}
+
+// proofOfWorkZeros returns the number of leading zero bits of the proof-of-work hash.
+func proofOfWorkZeros(challenge, address, solution string) int {
+	hash := sha256.Sum256([]byte(challenge + address + solution))
+	zeros := 0
+	for _, b := range hash {
+		zeros += bits.LeadingZeros8(b)
+		if b != 0 {
+			break
+		}
+	}
+	return zeros
+}
+
+// Captcha is a challenge verifier checking captcha tokens with a siteverify API,
+// like the ones of hCaptcha, reCAPTCHA or Turnstile. The token is the solution
+// of the transfer request.
+type Captcha struct {
+	verifyURL string
+	siteKey   string
+	secret    string
+	client    *http.Client
+}
+
+// NewCaptcha returns a captcha challenge verifier checking the tokens at verifyURL
+// with the site key and the secret of the captcha provider.
+func NewCaptcha(verifyURL, siteKey, secret string) *Captcha {
+	return &Captcha{
+		verifyURL: verifyURL,
+		siteKey:   siteKey,
+		secret:    secret,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Challenge returns the captcha site key for the clients to show the captcha.
+func (c *Captcha) Challenge(context.Context) (Challenge, error) {
+	return Challenge{Type: ChallengeCaptcha, Challenge: c.siteKey}, nil
+}
+
+// Verify checks the captcha token of the transfer request with the captcha provider.
+func (c *Captcha) Verify(ctx context.Context, req TransferRequest) error {
+	if req.Solution == "" {
+		return errors.Wrap(ErrChallengeFailed, "the captcha token is required")
+	}
+
+	form := url.Values{
+		"secret":   {c.secret},
+		"response": {req.Solution},
+		"sitekey":  {c.siteKey},
+	}
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	hreq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	hres, err := c.client.Do(hreq)
+	if err != nil {
+		return errors.Wrap(err, "cannot verify the captcha")
+	}
+	defer hres.Body.Close()
+
+	var res struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(hres.Body).Decode(&res); err != nil {
+		return errors.Wrap(err, "cannot verify the captcha")
+	}
+	if !res.Success {
+		msg := "invalid captcha"
+		if len(res.ErrorCodes) > 0 {
+			msg += ": " + strings.Join(res.ErrorCodes, ", ")
+		}
+		return errors.Wrap(ErrChallengeFailed, msg)
+	}
+	return nil
+}
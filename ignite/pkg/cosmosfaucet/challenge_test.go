@@ -0,0 +1,104 @@
+package cosmosfaucet_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+)
+
+func TestServeHTTPProofOfWork(t *testing.T) {
+	const difficulty = 8
+	f := newBackendFaucet(t, &sender{}, cosmosfaucet.RequireChallenge(cosmosfaucet.NewProofOfWork(difficulty, 0)))
+
+	req := cosmosfaucet.NewTransferRequest(addressAlice, nil)
+	require.Equal(t, http.StatusForbidden, postTransfer(f, "10.0.0.1:1234", req).Code)
+
+	res := httptest.NewRecorder()
+	f.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/challenge", nil))
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var challenge cosmosfaucet.Challenge
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&challenge))
+	require.Equal(t, cosmosfaucet.ChallengeProofOfWork, challenge.Type)
+	require.Equal(t, difficulty, challenge.Difficulty)
+
+	solution, err := cosmosfaucet.SolveProofOfWork(context.Background(), challenge.Challenge, addressAlice, difficulty)
+	require.NoError(t, err)
+	req.Challenge, req.Solution = challenge.Challenge, solution
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", req).Code)
+
+	// a challenge is solved once.
+	require.Equal(t, http.StatusForbidden, postTransfer(f, "10.0.0.1:1234", req).Code)
+}
+
+func TestCaptcha(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "secret", r.PostForm.Get("secret"))
+		if r.PostForm.Get("response") == "token" {
+			_, _ = w.Write([]byte(`{"success": true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	var (
+		ctx     = context.Background()
+		captcha = cosmosfaucet.NewCaptcha(server.URL, "site-key", "secret")
+	)
+
+	challenge, err := captcha.Challenge(ctx)
+	require.NoError(t, err)
+	require.Equal(t, cosmosfaucet.Challenge{Type: cosmosfaucet.ChallengeCaptcha, Challenge: "site-key"}, challenge)
+
+	require.NoError(t, captcha.Verify(ctx, cosmosfaucet.TransferRequest{AccountAddress: addressAlice, Solution: "token"}))
+
+	err = captcha.Verify(ctx, cosmosfaucet.TransferRequest{AccountAddress: addressAlice, Solution: "bad"})
+	require.ErrorIs(t, err, cosmosfaucet.ErrChallengeFailed)
+	require.ErrorContains(t, err, "invalid-input-response")
+}
+
+func TestProofOfWorkMaxChallenges(t *testing.T) {
+	var (
+		ctx = context.Background()
+		p   = cosmosfaucet.NewProofOfWork(0, 0)
+	)
+
+	first, err := p.Challenge(ctx)
+	require.NoError(t, err)
+	var last cosmosfaucet.Challenge
+	for i := 0; i < cosmosfaucet.DefaultMaxChallenges; i++ {
+		last, err = p.Challenge(ctx)
+		require.NoError(t, err)
+	}
+
+	// the oldest challenge is removed when there are too many challenges.
+	err = p.Verify(ctx, cosmosfaucet.TransferRequest{AccountAddress: addressAlice, Challenge: first.Challenge})
+	require.ErrorIs(t, err, cosmosfaucet.ErrChallengeFailed)
+	require.NoError(t, p.Verify(ctx, cosmosfaucet.TransferRequest{AccountAddress: addressAlice, Challenge: last.Challenge}))
+}
+
+func TestServeHTTPChallengeLimit(t *testing.T) {
+	f := newBackendFaucet(t, &sender{},
+		cosmosfaucet.RequireChallenge(cosmosfaucet.NewProofOfWork(8, 0)),
+		cosmosfaucet.IPLimit(1, time.Hour),
+	)
+
+	getChallenge := func() int {
+		r := httptest.NewRequest(http.MethodGet, "/challenge", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		res := httptest.NewRecorder()
+		f.ServeHTTP(res, r)
+		return res.Code
+	}
+	require.Equal(t, http.StatusOK, getChallenge())
+	require.Equal(t, http.StatusTooManyRequests, getChallenge())
+}
@@ -84,3 +84,53 @@ func (c HTTPClient) FaucetInfo(ctx context.Context) (FaucetInfoResponse, error)
 	err = json.NewDecoder(hres.Body).Decode(&res)
 	return res, err
 }
+
+// Challenge fetches a new challenge to solve to request tokens from a faucet requiring one.
+func (c HTTPClient) Challenge(ctx context.Context) (Challenge, error) {
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodGet, c.addr+"/challenge", nil)
+	if err != nil {
+		return Challenge{}, err
+	}
+
+	hres, err := http.DefaultClient.Do(hreq)
+	if err != nil {
+		return Challenge{}, err
+	}
+	defer hres.Body.Close()
+
+	if hres.StatusCode != http.StatusOK {
+		return Challenge{}, errors.New(http.StatusText(hres.StatusCode))
+	}
+
+	var res Challenge
+	err = json.NewDecoder(hres.Body).Decode(&res)
+	return res, err
+}
+
+// FeeGrant requests a fee allowance from the faucet with req.
+func (c HTTPClient) FeeGrant(ctx context.Context, req FeeGrantRequest) (TransferResponse, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return TransferResponse{}, err
+	}
+
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.addr+"/feegrant", bytes.NewReader(data))
+	if err != nil {
+		return TransferResponse{}, err
+	}
+
+	hres, err := http.DefaultClient.Do(hreq)
+	if err != nil {
+		return TransferResponse{}, err
+	}
+	defer hres.Body.Close()
+
+	if hres.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(hres.Body)
+		return TransferResponse{}, ErrTransferRequest{Body: string(bodyBytes), StatusCode: hres.StatusCode}
+	}
+
+	var res TransferResponse
+	err = json.NewDecoder(hres.Body).Decode(&res)
+	return res, err
+}
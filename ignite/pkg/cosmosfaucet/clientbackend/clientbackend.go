@@ -0,0 +1,263 @@
+// Package clientbackend is a faucet backend that signs and broadcasts the
+// faucet transactions in-process with a cosmosclient.Client.
+package clientbackend
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// blockedModules are the module accounts which can't receive coins in the chains
+// scaffolded by Ignite, even before they are created.
+var blockedModules = []string{
+	authtypes.FeeCollectorName,
+	distrtypes.ModuleName,
+	minttypes.ModuleName,
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+	"nft",
+}
+
+// Backend sends the faucet coins with a cosmosclient.Client.
+// The account sequence is managed by the client, so the transactions don't wait for
+// the previous ones to be included in a block to be broadcasted.
+type Backend struct {
+	client  cosmosclient.Client
+	account cosmosaccount.Account
+	address string
+
+	fees             string
+	gasLimit         uint64
+	skipConfirmation bool
+}
+
+// Option configures the backend.
+type Option func(*Backend)
+
+// Fees sets the fees paid by the faucet for each transaction.
+func Fees(fees string) Option {
+	return func(b *Backend) {
+		b.fees = fees
+	}
+}
+
+// GasLimit sets the gas limit of the transactions, the gas is simulated when it is not set.
+func GasLimit(gas uint64) Option {
+	return func(b *Backend) {
+		b.gasLimit = gas
+	}
+}
+
+// SkipTxConfirmation returns the hash of the transactions once they are accepted in the
+// mempool, without waiting for them to be included in a block. Use it when the indexer
+// of the node is disabled.
+func SkipTxConfirmation() Option {
+	return func(b *Backend) {
+		b.skipConfirmation = true
+	}
+}
+
+// New returns a faucet backend sending the coins of the account named accountName
+// in the keyring of the client.
+func New(client cosmosclient.Client, accountName string, options ...Option) (*Backend, error) {
+	account, err := client.Account(accountName)
+	if err != nil {
+		return nil, err
+	}
+	address, err := client.Address(accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Backend{
+		client:  client,
+		account: account,
+		address: address,
+	}
+	for _, apply := range options {
+		apply(b)
+	}
+	return b, nil
+}
+
+// Address returns the address of the faucet account. It implements cosmosfaucet.Sender.
+func (b *Backend) Address() string {
+	return b.address
+}
+
+// Send sends the coins of the transfers in one transaction, a bank multi-send
+// transaction when there are many transfers. It implements cosmosfaucet.Sender.
+func (b *Backend) Send(ctx context.Context, transfers []cosmosfaucet.Transfer) (string, error) {
+	if len(transfers) == 0 {
+		return "", errors.New("no transfers to send")
+	}
+
+	hash, err := b.broadcast(ctx, b.message(transfers))
+	return hash, txFailed(err)
+}
+
+// GrantFees grants the fee allowance of the faucet account to grantee.
+// It implements cosmosfaucet.FeeGranter.
+func (b *Backend) GrantFees(ctx context.Context, grantee string, allowance cosmosfaucet.FeeAllowance) (string, error) {
+	msg, err := b.grantAllowanceMessage(grantee, allowance, time.Now())
+	if err != nil {
+		return "", err
+	}
+	return b.broadcast(ctx, msg)
+}
+
+// grantAllowanceMessage returns the message granting allowance to grantee at now.
+// The allowance is a basic allowance, or a periodic allowance when it has a period,
+// restricted to the allowed messages when there are some.
+func (b *Backend) grantAllowanceMessage(grantee string, allowance cosmosfaucet.FeeAllowance, now time.Time) (*feegrant.MsgGrantAllowance, error) {
+	basic := feegrant.BasicAllowance{SpendLimit: allowance.SpendLimit}
+	if allowance.Expiration > 0 {
+		expiration := now.Add(allowance.Expiration)
+		basic.Expiration = &expiration
+	}
+
+	var feeAllowance feegrant.FeeAllowanceI = &basic
+	if allowance.Period > 0 {
+		feeAllowance = &feegrant.PeriodicAllowance{
+			Basic:            basic,
+			Period:           allowance.Period,
+			PeriodSpendLimit: allowance.PeriodLimit,
+			PeriodCanSpend:   allowance.PeriodLimit,
+			PeriodReset:      now.Add(allowance.Period),
+		}
+	}
+	if len(allowance.AllowedMessages) > 0 {
+		var err error
+		feeAllowance, err = feegrant.NewAllowedMsgAllowance(feeAllowance, allowance.AllowedMessages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	allowanceAny, err := codectypes.NewAnyWithValue(feeAllowance.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return &feegrant.MsgGrantAllowance{
+		Granter:   b.address,
+		Grantee:   grantee,
+		Allowance: allowanceAny,
+	}, nil
+}
+
+// CheckAddress checks that the address is not the address of a blocked module
+// account or of a module account created on the chain, which can't receive coins.
+// It implements cosmosfaucet.AddressChecker.
+func (b *Backend) CheckAddress(ctx context.Context, address string) error {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return errors.Errorf("invalid address %s: %w", address, err)
+	}
+	for _, name := range blockedModules {
+		if bytes.Equal(bz, authtypes.NewModuleAddress(name)) {
+			return errors.Wrapf(cosmosfaucet.ErrAddressNotAllowed, "%s is the %s module account", address, name)
+		}
+	}
+
+	clientCtx := b.client.Context().WithCmdContext(ctx)
+	account, err := clientCtx.AccountRetriever.GetAccount(clientCtx, bz)
+	if err != nil {
+		// the accounts which don't exist yet can receive coins
+		if status.Code(err) == codes.NotFound || strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return errors.Wrap(err, "cannot get the account")
+	}
+	if _, ok := account.(sdk.ModuleAccountI); ok {
+		return errors.Wrapf(cosmosfaucet.ErrAddressNotAllowed, "%s is a module account", address)
+	}
+	return nil
+}
+
+// Balance returns the balance of the faucet account. It implements cosmosfaucet.Balancer.
+func (b *Backend) Balance(ctx context.Context) (sdk.Coins, error) {
+	return b.client.BankBalances(ctx, b.address, nil)
+}
+
+// broadcast broadcasts the message with the client and returns the hash of the
+// transaction, once it is included in a block unless its confirmation is skipped.
+func (b *Backend) broadcast(ctx context.Context, msg sdk.Msg) (string, error) {
+	var (
+		options = cosmosclient.TxOptions{Fees: b.fees, GasLimit: b.gasLimit}
+		resp    cosmosclient.Response
+		err     error
+	)
+	if b.skipConfirmation {
+		resp, err = b.client.BroadcastTxAsyncWithOptions(ctx, b.account, options, msg)
+	} else {
+		resp, err = b.client.BroadcastTxWithOptions(ctx, b.account, options, msg)
+	}
+
+	var hash string
+	if resp.TxResponse != nil {
+		hash = resp.TxHash
+	}
+	return hash, err
+}
+
+// txFailed returns a cosmosfaucet.TxFailedError when the transaction wasn't broadcasted
+// or was rejected by the node, the other errors don't tell whether the transaction
+// was broadcasted.
+func txFailed(err error) error {
+	var txErr cosmosclient.TxError
+	if errors.Is(err, cosmosclient.ErrTxNotBroadcasted) || errors.As(err, &txErr) {
+		return cosmosfaucet.TxFailedError{Err: err}
+	}
+	return err
+}
+
+// message returns a bank send message for one transfer and a multi-send message for many.
+// The transfers to the same address are merged.
+func (b *Backend) message(transfers []cosmosfaucet.Transfer) sdk.Msg {
+	if len(transfers) == 1 {
+		return &banktypes.MsgSend{
+			FromAddress: b.address,
+			ToAddress:   transfers[0].Address,
+			Amount:      transfers[0].Coins,
+		}
+	}
+
+	var (
+		total   = sdk.NewCoins()
+		outputs []banktypes.Output
+		index   = make(map[string]int)
+	)
+	for _, t := range transfers {
+		total = total.Add(t.Coins...)
+		if i, ok := index[t.Address]; ok {
+			outputs[i].Coins = outputs[i].Coins.Add(t.Coins...)
+			continue
+		}
+		index[t.Address] = len(outputs)
+		outputs = append(outputs, banktypes.Output{Address: t.Address, Coins: t.Coins})
+	}
+	return &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: b.address, Coins: total}},
+		Outputs: outputs,
+	}
+}
@@ -0,0 +1,230 @@
+package clientbackend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/p2p"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient/mocks"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet/clientbackend"
+)
+
+const (
+	addressAlice = "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e"
+	addressBob   = "cosmos1ruszzg3rysjjvfeg9y4zktpd9chnqvfje038ze"
+)
+
+type suite struct {
+	rpcClient        *mocks.RPCClient
+	accountRetriever *mocks.AccountRetriever
+	signer           *mocks.Signer
+
+	// sequences are the sequences of the signed transactions.
+	sequences []uint64
+
+	// msgs are the messages of the signed transactions.
+	msgs []sdk.Msg
+}
+
+func newBackend(t *testing.T, options ...clientbackend.Option) (*clientbackend.Backend, *suite) {
+	t.Helper()
+
+	s := &suite{
+		rpcClient:        mocks.NewRPCClient(t),
+		accountRetriever: mocks.NewAccountRetriever(t),
+		signer:           mocks.NewSigner(t),
+	}
+	s.rpcClient.EXPECT().String().Return("rpc").Maybe()
+	s.rpcClient.EXPECT().Status(mock.Anything).Return(&ctypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{Network: "mars"},
+	}, nil).Once()
+	s.accountRetriever.EXPECT().EnsureExists(mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.signer.EXPECT().Sign(mock.Anything, mock.Anything, "faucet", mock.Anything, true).
+		RunAndReturn(func(_ context.Context, txf tx.Factory, _ string, b client.TxBuilder, _ bool) error {
+			s.sequences = append(s.sequences, txf.Sequence())
+			s.msgs = append(s.msgs, b.GetTx().GetMsgs()...)
+			return nil
+		}).Maybe()
+
+	c, err := cosmosclient.New(
+		context.Background(),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringMemory),
+		cosmosclient.WithRPCClient(s.rpcClient),
+		cosmosclient.WithAccountRetriever(s.accountRetriever),
+		cosmosclient.WithSigner(s.signer),
+	)
+	require.NoError(t, err)
+	_, _, err = c.AccountRegistry.Create("faucet")
+	require.NoError(t, err)
+
+	b, err := clientbackend.New(c, "faucet", append([]clientbackend.Option{clientbackend.GasLimit(200000)}, options...)...)
+	require.NoError(t, err)
+	return b, s
+}
+
+func (s *suite) expectBroadcast(code uint32, log string) {
+	s.rpcClient.EXPECT().BroadcastTxSync(mock.Anything, mock.Anything).
+		Return(&ctypes.ResultBroadcastTx{Code: code, Codespace: "sdk", Log: log, Hash: []byte{1, 2, 3}}, nil).Once()
+}
+
+func TestBackendSend(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+	)
+	b, s := newBackend(t)
+
+	s.accountRetriever.EXPECT().GetAccountNumberSequence(mock.Anything, mock.Anything).Return(1, 5, nil).Once()
+	s.expectBroadcast(0, "")
+	s.expectBroadcast(0, "")
+	s.rpcClient.EXPECT().Tx(mock.Anything, mock.Anything, false).
+		Return(&ctypes.ResultTx{Hash: []byte{1, 2, 3}}, nil).Twice()
+
+	hash, err := b.Send(ctx, []cosmosfaucet.Transfer{{Address: addressAlice, Coins: coins}})
+	require.NoError(t, err)
+	require.Equal(t, "010203", hash)
+
+	_, err = b.Send(ctx, []cosmosfaucet.Transfer{
+		{Address: addressAlice, Coins: coins},
+		{Address: addressBob, Coins: coins},
+		{Address: addressAlice, Coins: coins},
+	})
+	require.NoError(t, err)
+
+	// the sequence is incremented locally for the next transaction
+	require.Equal(t, []uint64{5, 6}, s.sequences)
+	require.Equal(t, &banktypes.MsgSend{
+		FromAddress: b.Address(),
+		ToAddress:   addressAlice,
+		Amount:      coins,
+	}, s.msgs[0])
+	require.Equal(t, &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{{Address: b.Address(), Coins: sdk.NewCoins(sdk.NewInt64Coin("token", 30))}},
+		Outputs: []banktypes.Output{
+			{Address: addressAlice, Coins: sdk.NewCoins(sdk.NewInt64Coin("token", 20))},
+			{Address: addressBob, Coins: coins},
+		},
+	}, s.msgs[1])
+}
+
+func TestBackendSendSequenceMismatch(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		transfers = []cosmosfaucet.Transfer{{Address: addressAlice, Coins: sdk.NewCoins(sdk.NewInt64Coin("token", 10))}}
+	)
+	b, s := newBackend(t, clientbackend.SkipTxConfirmation())
+
+	s.accountRetriever.EXPECT().GetAccountNumberSequence(mock.Anything, mock.Anything).Return(1, 5, nil).Once()
+	s.accountRetriever.EXPECT().GetAccountNumberSequence(mock.Anything, mock.Anything).Return(1, 7, nil).Once()
+	s.expectBroadcast(32, "account sequence mismatch, expected 7, got 5: incorrect account sequence")
+	s.expectBroadcast(0, "")
+
+	_, err := b.Send(ctx, transfers)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 7}, s.sequences)
+
+	// other errors are not retried and the sequence is used by the next transaction
+	s.expectBroadcast(5, "insufficient funds")
+	_, err = b.Send(ctx, transfers)
+	require.EqualError(t, err, "error code: '5' msg: 'insufficient funds'")
+	require.ErrorAs(t, err, &cosmosfaucet.TxFailedError{})
+
+	s.expectBroadcast(0, "")
+	_, err = b.Send(ctx, transfers)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 7, 8, 8}, s.sequences)
+}
+
+func TestBackendSendTxFailure(t *testing.T) {
+	b, s := newBackend(t)
+
+	s.accountRetriever.EXPECT().GetAccountNumberSequence(mock.Anything, mock.Anything).Return(1, 5, nil).Once()
+	s.expectBroadcast(0, "")
+	s.rpcClient.EXPECT().Tx(mock.Anything, mock.Anything, false).
+		Return(&ctypes.ResultTx{TxResult: abci.ExecTxResult{Code: 13, Log: "insufficient fee"}}, nil).Once()
+
+	_, err := b.Send(context.Background(), []cosmosfaucet.Transfer{
+		{Address: addressAlice, Coins: sdk.NewCoins(sdk.NewInt64Coin("token", 10))},
+	})
+	require.EqualError(t, err, "error code: '13' msg: 'insufficient fee'")
+	require.ErrorAs(t, err, &cosmosfaucet.TxFailedError{})
+}
+
+func TestBackendCheckAddress(t *testing.T) {
+	var (
+		ctx           = context.Background()
+		feeCollector  = sdk.MustBech32ifyAddressBytes("cosmos", authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+		moduleAccount = authtypes.NewEmptyModuleAccount("mars")
+		moduleAddress = sdk.MustBech32ifyAddressBytes("cosmos", moduleAccount.GetAddress())
+	)
+	b, s := newBackend(t)
+
+	// the blocked module accounts are rejected without querying the chain
+	err := b.CheckAddress(ctx, feeCollector)
+	require.ErrorIs(t, err, cosmosfaucet.ErrAddressNotAllowed)
+
+	s.accountRetriever.EXPECT().GetAccount(mock.Anything, moduleAccount.GetAddress()).Return(moduleAccount, nil).Once()
+	err = b.CheckAddress(ctx, moduleAddress)
+	require.ErrorIs(t, err, cosmosfaucet.ErrAddressNotAllowed)
+
+	s.accountRetriever.EXPECT().GetAccount(mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.NotFound, "account not found")).Once()
+	require.NoError(t, b.CheckAddress(ctx, addressAlice))
+}
+
+func TestBackendGrantFees(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		spendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+		allowance  = cosmosfaucet.FeeAllowance{
+			SpendLimit:      spendLimit,
+			Expiration:      24 * time.Hour,
+			Period:          time.Hour,
+			PeriodLimit:     sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend"},
+		}
+	)
+	b, s := newBackend(t, clientbackend.SkipTxConfirmation())
+
+	s.accountRetriever.EXPECT().GetAccountNumberSequence(mock.Anything, mock.Anything).Return(1, 5, nil).Once()
+	s.expectBroadcast(0, "")
+
+	hash, err := b.GrantFees(ctx, addressAlice, allowance)
+	require.NoError(t, err)
+	require.Equal(t, "010203", hash)
+
+	require.Len(t, s.msgs, 1)
+	msg, ok := s.msgs[0].(*feegrant.MsgGrantAllowance)
+	require.True(t, ok)
+	require.Equal(t, b.Address(), msg.Granter)
+	require.Equal(t, addressAlice, msg.Grantee)
+
+	// the allowance is a periodic allowance restricted to the allowed messages.
+	allowed, ok := msg.Allowance.GetCachedValue().(*feegrant.AllowedMsgAllowance)
+	require.True(t, ok)
+	require.Equal(t, allowance.AllowedMessages, allowed.AllowedMessages)
+	periodic, ok := allowed.Allowance.GetCachedValue().(*feegrant.PeriodicAllowance)
+	require.True(t, ok)
+	require.Equal(t, spendLimit, periodic.Basic.SpendLimit)
+	require.NotNil(t, periodic.Basic.Expiration)
+	require.Equal(t, time.Hour, periodic.Period)
+	require.Equal(t, allowance.PeriodLimit, periodic.PeriodSpendLimit)
+	require.Equal(t, allowance.PeriodLimit, periodic.PeriodCanSpend)
+}
@@ -3,6 +3,8 @@ package cosmosfaucet
 
 import (
 	"context"
+	"net"
+	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -77,6 +79,51 @@ type Faucet struct {
 
 	// indexerDisabled tells whether the indexing is disabled on the node.
 	indexerDisabled bool
+
+	// sender sends the coins in-process when set, instead of the runner.
+	sender Sender
+
+	// ledger keeps the grants to check the limits when coins are sent by the sender.
+	ledger Ledger
+
+	// batchSize is the maximum number of transfers sent in one transaction by the sender.
+	batchSize int
+
+	// batcher queues the transfers sent by the sender.
+	batcher *batcher
+
+	// ledgerMutex makes checking the limits and recording a grant atomic.
+	ledgerMutex *sync.Mutex
+
+	// allowList holds the only addresses the faucet sends coins to when it isn't empty.
+	allowList map[string]bool
+
+	// denyList holds the addresses the faucet never sends coins to.
+	denyList map[string]bool
+
+	// ipLimit limits the HTTP requests of each client IP.
+	ipLimit *limiter
+
+	// subnetLimit limits the HTTP requests of each client subnet.
+	subnetLimit *limiter
+
+	// trustedProxies are the networks of the reverse proxies whose headers give the client IP.
+	trustedProxies []*net.IPNet
+
+	// challenge verifies the challenges solved by the HTTP clients to request coins.
+	challenge ChallengeVerifier
+
+	// feeAllowance is the fee allowance granted to the accounts, fee grants are disabled when nil.
+	feeAllowance *FeeAllowance
+
+	// adminToken authenticates the requests of the admin API, which is disabled without a token.
+	adminToken string
+
+	// state holds the coins, the limits resets and the recent transfers changed at runtime.
+	state *state
+
+	// metrics are the Prometheus metrics of the faucet.
+	metrics *metrics
 }
 
 // Option configures the faucetOptions.
@@ -150,7 +197,87 @@ func IndexerDisabled() Option {
 	}
 }
 
+// Backend sends the coins with sender in-process instead of running the chain binary.
+// The transfer limits are checked with the grants of the ledger instead of the
+// transactions history, and the concurrent requests are sent in the same transaction.
+func Backend(sender Sender) Option {
+	return func(f *Faucet) {
+		f.sender = sender
+	}
+}
+
+// Grants sets the ledger of the grants used to check the limits with a backend.
+// Grants are kept in memory by default.
+func Grants(ledger Ledger) Option {
+	return func(f *Faucet) {
+		f.ledger = ledger
+	}
+}
+
+// BatchSize sets the maximum number of transfers sent in one transaction with a backend.
+func BatchSize(size int) Option {
+	return func(f *Faucet) {
+		f.batchSize = size
+	}
+}
+
+// AllowList restricts the addresses the faucet sends coins to.
+func AllowList(addresses ...string) Option {
+	return func(f *Faucet) {
+		f.allowList = addressSet(f.allowList, addresses)
+	}
+}
+
+// DenyList sets addresses the faucet never sends coins to.
+func DenyList(addresses ...string) Option {
+	return func(f *Faucet) {
+		f.denyList = addressSet(f.denyList, addresses)
+	}
+}
+
+// IPLimit limits the HTTP requests of each client IP to requests per window.
+// The requests are limited with a token bucket, so the clients can send the
+// requests at once and then one request each window/requests.
+func IPLimit(requests int, window time.Duration) Option {
+	return func(f *Faucet) {
+		f.ipLimit = newLimiter(requests, window)
+	}
+}
+
+// SubnetLimit limits the HTTP requests of each client subnet to requests per window.
+// The subnets are /24 for IPv4 and /64 for IPv6 clients.
+func SubnetLimit(requests int, window time.Duration) Option {
+	return func(f *Faucet) {
+		f.subnetLimit = newLimiter(requests, window)
+	}
+}
+
+// TrustedProxies sets the networks of the reverse proxies in front of the faucet.
+// The client IP of the limits is read from the X-Forwarded-For and X-Real-IP
+// headers of the requests sent by these proxies, the headers of the other
+// requests are ignored so the clients can't spoof their IP.
+func TrustedProxies(networks ...*net.IPNet) Option {
+	return func(f *Faucet) {
+		f.trustedProxies = append(f.trustedProxies, networks...)
+	}
+}
+
+// RequireChallenge requires the HTTP clients to solve a challenge of verifier to request coins.
+func RequireChallenge(verifier ChallengeVerifier) Option {
+	return func(f *Faucet) {
+		f.challenge = verifier
+	}
+}
+
+// Admin enables the admin API of the faucet, authenticated with the bearer token.
+func Admin(token string) Option {
+	return func(f *Faucet) {
+		f.adminToken = token
+	}
+}
+
 // New creates a new faucet with ccr (to access and use blockchain's CLI) and given options.
+// ccr is not used when the faucet sends the coins with a Backend.
 func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Faucet, error) {
 	f := Faucet{
 		runner:      ccr,
@@ -172,6 +299,24 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		RefreshWindow(DefaultRefreshWindow)(&f)
 	}
 
+	f.state = newState(f.coins, f.coinsMax)
+	balancer, _ := f.sender.(Balancer)
+	f.metrics = newMetrics(balancer)
+
+	// the backend doesn't use the chain binary.
+	if f.sender != nil {
+		if f.chainID == "" {
+			return Faucet{}, errors.New("the chain id is required to use a faucet backend")
+		}
+		if f.ledger == nil {
+			f.ledger = NewMemoryLedger()
+		}
+		f.openAPIData.ChainID = f.chainID
+		f.batcher = newBatcher(f.sender, f.batchSize)
+		f.ledgerMutex = &sync.Mutex{}
+		return f, nil
+	}
+
 	// import the account if mnemonic is provided.
 	if f.accountMnemonic != "" {
 		_, err := f.runner.AddAccount(
@@ -199,3 +344,13 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 
 	return f, nil
 }
+
+func addressSet(set map[string]bool, addresses []string) map[string]bool {
+	if set == nil {
+		set = make(map[string]bool, len(addresses))
+	}
+	for _, address := range addresses {
+		set[normalizeAddress(address)] = true
+	}
+	return set
+}
@@ -0,0 +1,142 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// errFeeAllowanceExists is the error of the chain when a fee allowance is granted twice.
+const errFeeAllowanceExists = "fee allowance already exists"
+
+var (
+	// ErrFeeGrantDisabled is returned when a fee allowance is requested from a faucet without fee grants.
+	ErrFeeGrantDisabled = errors.New("the faucet doesn't grant fee allowances")
+
+	// ErrFeeAllowanceExists is returned when a fee allowance is requested by an account
+	// which already has a fee allowance of the faucet account.
+	ErrFeeAllowanceExists = errors.New("the account already has a fee allowance of the faucet")
+)
+
+// FeeGranter is implemented by the senders able to grant the fee allowances of the
+// faucet account in-process.
+type FeeGranter interface {
+	// GrantFees grants allowance to grantee in a single transaction and returns its hash.
+	GrantFees(ctx context.Context, grantee string, allowance FeeAllowance) (string, error)
+}
+
+// FeeAllowance is the x/feegrant allowance granted by the faucet account.
+// It is a basic allowance, or a periodic allowance when Period is set.
+type FeeAllowance struct {
+	// SpendLimit is the maximum amount of fees the grantee can spend, it is not limited when empty.
+	SpendLimit sdk.Coins
+
+	// Expiration is the time after the grant the allowance expires, it never expires when zero.
+	Expiration time.Duration
+
+	// Period is the period of a periodic allowance, the grantee can spend PeriodLimit each period.
+	Period time.Duration
+
+	// PeriodLimit is the maximum amount of fees the grantee can spend each period.
+	PeriodLimit sdk.Coins
+
+	// AllowedMessages restricts the allowance to the fees of transactions with these messages.
+	AllowedMessages []string
+}
+
+// FeeGrant enables the fee grants: the faucet grants allowance to the accounts
+// requesting it, so they can pay the fees of their transactions with the faucet account.
+// The fee allowances are granted by the Backend of the faucet, which must implement
+// FeeGranter, or with the chain binary when the faucet doesn't use a Backend.
+func FeeGrant(allowance FeeAllowance) Option {
+	return func(f *Faucet) {
+		f.feeAllowance = &allowance
+	}
+}
+
+// GrantFees grants the fee allowance of the faucet to toAccountAddress.
+func (f *Faucet) GrantFees(ctx context.Context, toAccountAddress string) (string, error) {
+	if f.feeAllowance == nil {
+		return "", ErrFeeGrantDisabled
+	}
+	if f.IsPaused() {
+		return "", ErrFaucetPaused
+	}
+
+	toAccountAddress = normalizeAddress(toAccountAddress)
+	if err := f.checkAddressAllowed(toAccountAddress); err != nil {
+		return "", err
+	}
+
+	var (
+		txHash string
+		err    error
+	)
+	if f.sender != nil {
+		txHash, err = f.grantFeesWithBackend(ctx, toAccountAddress)
+	} else {
+		txHash, err = f.grantFeesWithRunner(ctx, toAccountAddress)
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), errFeeAllowanceExists) {
+			return "", errors.Wrap(ErrFeeAllowanceExists, toAccountAddress)
+		}
+		return txHash, err
+	}
+	f.metrics.granted()
+	return txHash, nil
+}
+
+// grantFeesWithBackend grants the fee allowance with the backend.
+func (f *Faucet) grantFeesWithBackend(ctx context.Context, toAccountAddress string) (string, error) {
+	granter, ok := f.sender.(FeeGranter)
+	if !ok {
+		return "", errors.Wrap(ErrFeeGrantDisabled, "the faucet backend can't grant fee allowances")
+	}
+	if err := f.validateAddress(toAccountAddress); err != nil {
+		return "", err
+	}
+	return granter.GrantFees(ctx, toAccountAddress, *f.feeAllowance)
+}
+
+// grantFeesWithRunner grants the fee allowance with the chain binary.
+func (f *Faucet) grantFeesWithRunner(ctx context.Context, toAccountAddress string) (string, error) {
+	transferMutex.Lock()
+	defer transferMutex.Unlock()
+
+	granter, err := f.Address(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var expiration time.Time
+	if f.feeAllowance.Expiration > 0 {
+		expiration = time.Now().Add(f.feeAllowance.Expiration)
+	}
+
+	txHash, err := f.runner.FeeGrant(
+		ctx,
+		granter,
+		toAccountAddress,
+		chaincmd.FeeGrantWithSpendLimit(f.feeAllowance.SpendLimit),
+		chaincmd.FeeGrantWithExpiration(expiration),
+		chaincmd.FeeGrantWithPeriod(f.feeAllowance.Period, f.feeAllowance.PeriodLimit),
+		chaincmd.FeeGrantWithAllowedMessages(f.feeAllowance.AllowedMessages...),
+		chaincmd.FeeGrantWithFees(f.feeAmount),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if f.indexerDisabled {
+		return txHash, nil // we cannot check the tx status if indexer is disabled
+	}
+
+	// wait for the grant tx to be confirmed
+	return txHash, f.runner.WaitTx(ctx, txHash, time.Second, 30)
+}
@@ -0,0 +1,110 @@
+package cosmosfaucet_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+)
+
+// newFakeChain returns a runner of a chain binary which writes its arguments to
+// the returned file, prints the faucet address for the keys commands and prints
+// a successful tx result for the other commands.
+func newFakeChain(t *testing.T) (chaincmdrunner.Runner, string) {
+	t.Helper()
+
+	var (
+		dir      = t.TempDir()
+		binary   = filepath.Join(dir, "marsd")
+		argsPath = filepath.Join(dir, "args")
+		script   = fmt.Sprintf(
+			"#!/bin/sh\necho \"$@\" >> %s\nif [ \"$1\" = keys ]; then echo %s; exit; fi\necho '{\"txhash\": \"hash\", \"code\": 0}'\n",
+			argsPath,
+			addressFaucet,
+		)
+	)
+	require.NoError(t, os.WriteFile(binary, []byte(script), 0o755))
+
+	runner, err := chaincmdrunner.New(context.Background(), chaincmd.New(binary, chaincmd.WithChainID("mars")))
+	require.NoError(t, err)
+	return runner, argsPath
+}
+
+func TestServeHTTPFeeGrant(t *testing.T) {
+	runner, argsPath := newFakeChain(t)
+
+	res := httptest.NewRecorder()
+	f := newBackendFaucet(t, &sender{})
+	f.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/feegrant", strings.NewReader(`{"address": "`+addressAlice+`"}`)))
+	require.Equal(t, http.StatusNotFound, res.Code)
+
+	// the fee allowances are granted with the chain binary without a backend.
+	f, err := cosmosfaucet.New(
+		context.Background(),
+		runner,
+		cosmosfaucet.ChainID("mars"),
+		cosmosfaucet.IndexerDisabled(),
+		cosmosfaucet.DenyList(addressBob),
+		cosmosfaucet.FeeGrant(cosmosfaucet.FeeAllowance{
+			SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			Expiration:  24 * time.Hour,
+			Period:      time.Hour,
+			PeriodLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		}),
+	)
+	require.NoError(t, err)
+
+	res = httptest.NewRecorder()
+	f.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/feegrant", strings.NewReader(`{"address": "`+addressAlice+`"}`)))
+	require.Equal(t, http.StatusOK, res.Code)
+	var grant cosmosfaucet.TransferResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&grant))
+	require.Equal(t, "hash", grant.Hash)
+
+	args, err := os.ReadFile(argsPath)
+	require.NoError(t, err)
+	require.Contains(t, string(args), "tx feegrant grant "+addressFaucet+" "+addressAlice)
+	require.Contains(t, string(args), "--spend-limit 1000stake --expiration ")
+	require.Contains(t, string(args), "--period 3600 --period-limit 100stake")
+
+	res = httptest.NewRecorder()
+	f.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/feegrant", strings.NewReader(`{"address": "`+addressBob+`"}`)))
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func TestServeHTTPFeeGrantWithBackend(t *testing.T) {
+	var (
+		s         = &sender{}
+		allowance = cosmosfaucet.FeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))}
+		f         = newBackendFaucet(t, s, cosmosfaucet.FeeGrant(allowance))
+	)
+
+	grantFees := func(address string) *httptest.ResponseRecorder {
+		res := httptest.NewRecorder()
+		f.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/feegrant", strings.NewReader(`{"address": "`+address+`"}`)))
+		return res
+	}
+
+	res := grantFees(addressAlice)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, []feeGrant{{grantee: addressAlice, allowance: allowance}}, s.grants)
+
+	// an account has one fee allowance of the faucet.
+	res = grantFees(addressAlice)
+	require.Equal(t, http.StatusConflict, res.Code)
+	require.Contains(t, res.Body.String(), "the account already has a fee allowance of the faucet")
+	require.Len(t, s.grants, 1)
+}
@@ -32,6 +32,32 @@ func (f Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	})))
 
+	mux.Handle("/feegrant", cors.Default().Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost || r.Method == http.MethodOptions {
+			f.feeGrantHandler(w, r)
+		} else {
+			http.NotFound(w, r)
+		}
+	})))
+
+	mux.Handle("/challenge", cors.Default().Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodOptions {
+			f.challengeHandler(w, r)
+		} else {
+			http.NotFound(w, r)
+		}
+	})))
+
+	mux.HandleFunc("/admin/", f.adminHandler)
+
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			f.metrics.handler().ServeHTTP(w, r)
+		} else {
+			http.NotFound(w, r)
+		}
+	})
+
 	mux.HandleFunc("/openapi.yml", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
 			f.openAPISpecHandler(w, r)
@@ -0,0 +1,139 @@
+package cosmosfaucet
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xhttp"
+)
+
+// AdminStatusResponse is the status of the faucet returned by the admin API.
+type AdminStatusResponse struct {
+	// Address is the address of the faucet account, to top up the faucet.
+	Address string `json:"address"`
+
+	// Paused indicates that the faucet is paused.
+	Paused bool `json:"paused"`
+
+	// Coins are the coins sent on each request.
+	Coins sdk.Coins `json:"coins"`
+
+	// CoinsMax are the maximum amounts of coins an account can receive.
+	CoinsMax sdk.Coins `json:"coins_max"`
+
+	// Balance is the balance of the faucet account, when the backend can get it.
+	Balance sdk.Coins `json:"balance,omitempty"`
+}
+
+// AdminResetRequest is the admin request to reset the limits of an address.
+type AdminResetRequest struct {
+	Address string `json:"address"`
+}
+
+// AdminCoinsRequest is the admin request to change the coins sent by the faucet.
+type AdminCoinsRequest struct {
+	Coins    []string `json:"coins"`
+	CoinsMax []string `json:"coins_max,omitempty"`
+}
+
+// adminHandler serves the admin API, authenticated with the admin token:
+//
+//	GET  /admin/status  status of the faucet
+//	GET  /admin/grants  recent transfers, the ones to an address with ?address=
+//	POST /admin/reset   reset the limits of an address
+//	POST /admin/pause   pause the faucet
+//	POST /admin/resume  resume the faucet
+//	PUT  /admin/coins   change the coins sent on each request and their maximum amounts
+func (f Faucet) adminHandler(w http.ResponseWriter, r *http.Request) {
+	if f.adminToken == "" || f.state == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(f.adminToken)) != 1 {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		responseError(w, http.StatusUnauthorized, errors.New("invalid admin token"))
+		return
+	}
+
+	switch route := r.Method + " " + strings.TrimPrefix(r.URL.Path, "/admin"); route {
+	case "GET /status":
+		f.adminStatusHandler(w, r)
+	case "GET /grants":
+		_ = xhttp.ResponseJSON(w, http.StatusOK, f.RecentGrants(r.URL.Query().Get("address")))
+	case "POST /reset":
+		var req AdminResetRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Address == "" {
+			responseError(w, http.StatusBadRequest, errors.New("an address is required"))
+			return
+		}
+		if err := f.ResetLimits(req.Address); err != nil {
+			responseError(w, http.StatusInternalServerError, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case "POST /pause":
+		f.Pause()
+		w.WriteHeader(http.StatusNoContent)
+	case "POST /resume":
+		f.Resume()
+		w.WriteHeader(http.StatusNoContent)
+	case "PUT /coins":
+		f.adminCoinsHandler(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f Faucet) adminStatusHandler(w http.ResponseWriter, r *http.Request) {
+	address, err := f.Address(r.Context())
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	balance, err := f.Balance(r.Context())
+	if err != nil && !errors.Is(err, ErrBalanceUnavailable) {
+		responseError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	coins, coinsMax := f.Coins()
+	_ = xhttp.ResponseJSON(w, http.StatusOK, AdminStatusResponse{
+		Address:  address,
+		Paused:   f.IsPaused(),
+		Coins:    coins,
+		CoinsMax: coinsMax,
+		Balance:  balance,
+	})
+}
+
+func (f Faucet) adminCoinsHandler(w http.ResponseWriter, r *http.Request) {
+	var req AdminCoinsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		responseError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(strings.Join(req.Coins, ","))
+	if err != nil {
+		responseError(w, http.StatusBadRequest, err)
+		return
+	}
+	coinsMax, err := sdk.ParseCoinsNormalized(strings.Join(req.CoinsMax, ","))
+	if err != nil {
+		responseError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := f.SetCoins(coins, coinsMax); err != nil {
+		responseError(w, http.StatusBadRequest, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -3,7 +3,9 @@ package cosmosfaucet
 import (
 	"context"
 	"encoding/json"
+	"math"
 	"net/http"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -18,6 +20,12 @@ type TransferRequest struct {
 	// Coins that are requested.
 	// default ones used when this one isn't provided.
 	Coins []string `json:"coins,omitempty"`
+
+	// Challenge is the challenge solved by the client, when the faucet requires one.
+	Challenge string `json:"challenge,omitempty"`
+
+	// Solution is the solution of the challenge, like a proof of work or a captcha token.
+	Solution string `json:"solution,omitempty"`
 }
 
 func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
@@ -34,16 +42,23 @@ type TransferResponse struct {
 
 func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
 	var req TransferRequest
+	f.metrics.request()
 
 	// decode request into req.
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		f.metrics.deny(denialInvalidRequest)
 		responseError(w, http.StatusBadRequest, err)
 		return
 	}
 
+	if !f.checkRequest(w, r, req) {
+		return
+	}
+
 	// determine coins to transfer.
 	coins, err := f.coinsFromRequest(req)
 	if err != nil {
+		f.metrics.deny(denialInvalidRequest)
 		responseError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -51,15 +66,71 @@ func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
 	// try performing the transfer
 	hash, err := f.Transfer(r.Context(), req.AccountAddress, coins)
 	if err != nil {
-		if errors.Is(err, context.Canceled) {
-			return
-		}
-		responseError(w, http.StatusInternalServerError, err)
+		f.responseTransferError(w, err)
 		return
 	}
 	responseSuccess(w, hash)
 }
 
+// checkRequest checks the request limits of the client and verifies the challenge
+// solved by the client. It writes the error response when the request is denied.
+func (f Faucet) checkRequest(w http.ResponseWriter, r *http.Request, req TransferRequest) bool {
+	// check the request limits of the client.
+	if !f.checkLimits(w, r, scopeTransfer) {
+		return false
+	}
+
+	// verify the challenge solved by the client.
+	if f.challenge != nil {
+		if err := f.challenge.Verify(r.Context(), req); err != nil {
+			code := http.StatusInternalServerError
+			if errors.Is(err, ErrChallengeFailed) {
+				f.metrics.deny(denialChallengeFailed)
+				code = http.StatusForbidden
+			}
+			responseError(w, code, err)
+			return false
+		}
+	}
+	return true
+}
+
+// checkLimits checks the request limits of the client in scope. It writes the
+// error response when a limit is reached.
+func (f Faucet) checkLimits(w http.ResponseWriter, r *http.Request, scope string) bool {
+	if ok, wait := f.checkClient(r, scope); !ok {
+		f.metrics.deny(denialRateLimited)
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		responseError(w, http.StatusTooManyRequests, errors.New("too many requests"))
+		return false
+	}
+	return true
+}
+
+// responseTransferError writes the response of a failed transfer or fee grant.
+func (f Faucet) responseTransferError(w http.ResponseWriter, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	var limitErr LimitError
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, ErrFaucetPaused):
+		f.metrics.deny(denialPaused)
+		code = http.StatusServiceUnavailable
+	case errors.Is(err, ErrAddressNotAllowed):
+		f.metrics.deny(denialAddressNotAllowed)
+		code = http.StatusForbidden
+	case errors.Is(err, ErrFeeAllowanceExists):
+		f.metrics.deny(denialLimitReached)
+		code = http.StatusConflict
+	case errors.As(err, &limitErr):
+		f.metrics.deny(denialLimitReached)
+	}
+	responseError(w, code, err)
+}
+
 // FaucetInfoResponse is the faucet info payload.
 type FaucetInfoResponse struct {
 	// IsAFaucet indicates that this is a faucet endpoint.
@@ -68,19 +139,45 @@ type FaucetInfoResponse struct {
 
 	// ChainID is chain id of the chain that faucet is running for.
 	ChainID string `json:"chain_id"`
+
+	// RequiresChallenge indicates that the transfer requests must solve a challenge of /challenge.
+	RequiresChallenge bool `json:"requires_challenge,omitempty"`
+
+	// FeeGrant indicates that the faucet grants fee allowances at /feegrant.
+	FeeGrant bool `json:"fee_grant,omitempty"`
 }
 
 func (f Faucet) faucetInfoHandler(w http.ResponseWriter, _ *http.Request) {
 	_ = xhttp.ResponseJSON(w, http.StatusOK, FaucetInfoResponse{
-		IsAFaucet: true,
-		ChainID:   f.chainID,
+		IsAFaucet:         true,
+		ChainID:           f.chainID,
+		RequiresChallenge: f.challenge != nil,
+		FeeGrant:          f.feeAllowance != nil,
 	})
 }
 
+func (f Faucet) challengeHandler(w http.ResponseWriter, r *http.Request) {
+	if f.challenge == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if !f.checkLimits(w, r, scopeChallenge) {
+		return
+	}
+
+	challenge, err := f.challenge.Challenge(r.Context())
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err)
+		return
+	}
+	_ = xhttp.ResponseJSON(w, http.StatusOK, challenge)
+}
+
 // coinsFromRequest determines tokens to transfer from transfer request.
 func (f Faucet) coinsFromRequest(req TransferRequest) (sdk.Coins, error) {
 	if len(req.Coins) == 0 {
-		return f.coins.Sort(), nil
+		coins, _ := f.amounts()
+		return coins.Sort(), nil
 	}
 
 	coins := sdk.NewCoins()
@@ -0,0 +1,50 @@
+package cosmosfaucet
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// FeeGrantRequest is the request of a fee allowance.
+type FeeGrantRequest struct {
+	// AccountAddress is the grantee of the fee allowance.
+	AccountAddress string `json:"address"`
+
+	// Challenge is the challenge solved by the client, when the faucet requires one.
+	Challenge string `json:"challenge,omitempty"`
+
+	// Solution is the solution of the challenge, like a proof of work or a captcha token.
+	Solution string `json:"solution,omitempty"`
+}
+
+func (f Faucet) feeGrantHandler(w http.ResponseWriter, r *http.Request) {
+	if f.feeAllowance == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	var req FeeGrantRequest
+	f.metrics.request()
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		f.metrics.deny(denialInvalidRequest)
+		responseError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	transferReq := TransferRequest{
+		AccountAddress: req.AccountAddress,
+		Challenge:      req.Challenge,
+		Solution:       req.Solution,
+	}
+	if !f.checkRequest(w, r, transferReq) {
+		return
+	}
+
+	hash, err := f.GrantFees(r.Context(), req.AccountAddress)
+	if err != nil {
+		f.responseTransferError(w, err)
+		return
+	}
+	responseSuccess(w, hash)
+}
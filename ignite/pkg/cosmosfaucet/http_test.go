@@ -1,9 +1,13 @@
 package cosmosfaucet_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -45,3 +49,46 @@ func TestServeHTTPCORS(t *testing.T) {
 		})
 	}
 }
+
+func postTransfer(f cosmosfaucet.Faucet, remoteAddr string, req cosmosfaucet.TransferRequest) *httptest.ResponseRecorder {
+	data, _ := json.Marshal(req)
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(data))
+	r.RemoteAddr = remoteAddr
+	res := httptest.NewRecorder()
+	f.ServeHTTP(res, r)
+	return res
+}
+
+func TestServeHTTPIPLimit(t *testing.T) {
+	f := newBackendFaucet(t, &sender{}, cosmosfaucet.IPLimit(2, time.Hour), cosmosfaucet.SubnetLimit(3, time.Hour))
+
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressAlice, nil)).Code)
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressBob, nil)).Code)
+
+	res := postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressCarol, nil))
+	require.Equal(t, http.StatusTooManyRequests, res.Code)
+	require.Equal(t, "1800", res.Header().Get("Retry-After"))
+
+	// the subnet of the client has one request left.
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.2:1234", cosmosfaucet.NewTransferRequest(addressCarol, nil)).Code)
+	require.Equal(t, http.StatusTooManyRequests, postTransfer(f, "10.0.0.3:1234", cosmosfaucet.NewTransferRequest(addressDave, nil)).Code)
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.1.1:1234", cosmosfaucet.NewTransferRequest(addressDave, nil)).Code)
+}
+
+func TestServeHTTPAddressLists(t *testing.T) {
+	f := newBackendFaucet(t, &sender{}, cosmosfaucet.DenyList(addressBob))
+	require.Equal(t, http.StatusForbidden, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressBob, nil)).Code)
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressAlice, nil)).Code)
+
+	f = newBackendFaucet(t, &sender{}, cosmosfaucet.AllowList(addressAlice))
+	require.Equal(t, http.StatusForbidden, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressBob, nil)).Code)
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressAlice, nil)).Code)
+
+	// the addresses are case-insensitive.
+	f = newBackendFaucet(t, &sender{}, cosmosfaucet.DenyList(strings.ToUpper(addressBob)))
+	require.Equal(t, http.StatusForbidden, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressBob, nil)).Code)
+
+	f = newBackendFaucet(t, &sender{}, cosmosfaucet.DenyList(addressBob), cosmosfaucet.AllowList(strings.ToUpper(addressAlice)))
+	require.Equal(t, http.StatusForbidden, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(strings.ToUpper(addressBob), nil)).Code)
+	require.Equal(t, http.StatusOK, postTransfer(f, "10.0.0.1:1234", cosmosfaucet.NewTransferRequest(addressAlice, nil)).Code)
+}
@@ -0,0 +1,105 @@
+package cosmosfaucet
+
+import (
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// ledgerCacheNamespace is the cache namespace of the faucet ledger.
+const ledgerCacheNamespace = "cosmosfaucet.ledger"
+
+// Grant is an amount of coins transferred to an address by the faucet.
+type Grant struct {
+	Coins sdk.Coins
+	Time  time.Time
+}
+
+// Ledger keeps the grants of the faucet to check the transfer limits of the addresses
+// without querying the transaction history of the chain.
+type Ledger interface {
+	// Grants returns the grants of an address.
+	Grants(address string) ([]Grant, error)
+
+	// SetGrants replaces the grants of an address.
+	SetGrants(address string, grants []Grant) error
+}
+
+type memoryLedger struct {
+	mu     sync.Mutex
+	grants map[string][]Grant
+}
+
+// NewMemoryLedger returns a ledger that keeps the grants in memory.
+func NewMemoryLedger() Ledger {
+	return &memoryLedger{grants: make(map[string][]Grant)}
+}
+
+func (l *memoryLedger) Grants(address string) ([]Grant, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Grant(nil), l.grants[address]...), nil
+}
+
+func (l *memoryLedger) SetGrants(address string, grants []Grant) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(grants) == 0 {
+		delete(l.grants, address)
+		return nil
+	}
+	l.grants[address] = append([]Grant(nil), grants...)
+	return nil
+}
+
+// cachedGrant is the cache encoding of a grant.
+type cachedGrant struct {
+	Coins string
+	Time  time.Time
+}
+
+type cacheLedger struct {
+	cache cache.Cache[[]cachedGrant]
+}
+
+// NewCacheLedger returns a ledger that keeps the grants in a cache storage,
+// so the limits of the addresses are kept when the faucet restarts.
+func NewCacheLedger(storage cache.Storage) Ledger {
+	return cacheLedger{cache: cache.New[[]cachedGrant](storage, ledgerCacheNamespace)}
+}
+
+func (l cacheLedger) Grants(address string) ([]Grant, error) {
+	cached, err := l.cache.Get(address)
+	if errors.Is(err, cache.ErrorNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]Grant, len(cached))
+	for i, g := range cached {
+		coins, err := sdk.ParseCoinsNormalized(g.Coins)
+		if err != nil {
+			return nil, err
+		}
+		grants[i] = Grant{Coins: coins, Time: g.Time}
+	}
+	return grants, nil
+}
+
+func (l cacheLedger) SetGrants(address string, grants []Grant) error {
+	if len(grants) == 0 {
+		return l.cache.Delete(address)
+	}
+
+	cached := make([]cachedGrant, len(grants))
+	for i, g := range grants {
+		cached[i] = cachedGrant{Coins: g.Coins.String(), Time: g.Time}
+	}
+	return l.cache.Put(address, cached)
+}
@@ -0,0 +1,219 @@
+package cosmosfaucet
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// scopeTransfer is the scope of the limits of the transfers and the fee grants.
+	scopeTransfer = "transfer"
+
+	// scopeChallenge is the scope of the limits of the challenges.
+	scopeChallenge = "challenge"
+
+	// subnetMaskIPv4 is the size of the IPv4 subnets limited by SubnetLimit.
+	subnetMaskIPv4 = 24
+
+	// subnetMaskIPv6 is the size of the IPv6 subnets limited by SubnetLimit.
+	subnetMaskIPv6 = 64
+
+	// unknownClient is the limit key of the requests without a valid client IP.
+	unknownClient = "unknown"
+)
+
+// limiter limits the requests with a token bucket for each key, like a client IP.
+// A bucket holds up to capacity tokens and is refilled at rate tokens per second.
+type limiter struct {
+	capacity float64
+	rate     float64
+	window   time.Duration
+
+	// now returns the current time, it is replaced in the tests.
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// newLimiter returns a limiter allowing requests per window for each key.
+func newLimiter(requests int, window time.Duration) *limiter {
+	return &limiter{
+		capacity: float64(requests),
+		rate:     float64(requests) / window.Seconds(),
+		window:   window,
+		now:      time.Now,
+		buckets:  make(map[string]*bucket),
+	}
+}
+
+// allow takes a token from the bucket of key. It returns false and the time to
+// wait for the next token when the bucket is empty.
+func (l *limiter) allow(key string) (bool, time.Duration) {
+	return allowAll(limit{l, key})
+}
+
+// limit is the bucket of a key in a limiter.
+type limit struct {
+	limiter *limiter
+	key     string
+}
+
+// allowAll takes a token from the buckets of the limits only when each bucket has
+// one, so a request denied by one limit doesn't use the tokens of the others.
+// It returns false and the longest time to wait for the next tokens otherwise.
+// The limits must use different limiters.
+func allowAll(limits ...limit) (bool, time.Duration) {
+	for _, l := range limits {
+		l.limiter.mu.Lock()
+		defer l.limiter.mu.Unlock()
+	}
+
+	var (
+		buckets = make([]*bucket, len(limits))
+		denied  bool
+		wait    time.Duration
+	)
+	for i, l := range limits {
+		b := l.limiter.bucket(l.key)
+		if b.tokens < 1 {
+			denied = true
+			wait = max(wait, time.Duration((1-b.tokens)/l.limiter.rate*float64(time.Second)))
+		}
+		buckets[i] = b
+	}
+	if denied {
+		return false, wait
+	}
+
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return true, 0
+}
+
+// bucket returns the bucket of key refilled at the current time.
+// The limiter must be locked.
+func (l *limiter) bucket(key string) *bucket {
+	now := l.now()
+	l.prune(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.capacity, updated: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.updated = now
+	return b
+}
+
+// refill returns the tokens of the bucket at now.
+func (l *limiter) refill(b *bucket, now time.Time) float64 {
+	tokens := b.tokens + now.Sub(b.updated).Seconds()*l.rate
+	if tokens > l.capacity {
+		return l.capacity
+	}
+	return tokens
+}
+
+// prune removes the full buckets once per window, so the buckets of the
+// clients that stopped sending requests don't stay in memory.
+func (l *limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < l.window {
+		return
+	}
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.capacity {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastPrune = now
+}
+
+// clientIP returns the IP of the client of the request. When the request is sent by
+// a trusted proxy, the client IP is the last IP of the X-Forwarded-For header which
+// is not a trusted proxy, or the IP of the X-Real-IP header.
+func (f Faucet) clientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !f.isTrustedProxy(ip) {
+		return ip
+	}
+
+	// the proxies append the IP of their client to the header, so the IPs
+	// before the last untrusted one can be spoofed by the client.
+	if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		ips := strings.Split(strings.Join(forwarded, ","), ",")
+		for i := len(ips) - 1; i >= 0; i-- {
+			forwardedIP := net.ParseIP(strings.TrimSpace(ips[i]))
+			if forwardedIP == nil {
+				break
+			}
+			ip = forwardedIP
+			if !f.isTrustedProxy(ip) {
+				break
+			}
+		}
+		return ip
+	}
+	if realIP := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); realIP != nil {
+		return realIP
+	}
+	return ip
+}
+
+// isTrustedProxy checks if ip is in the networks of the trusted proxies.
+func (f Faucet) isTrustedProxy(ip net.IP) bool {
+	for _, network := range f.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// subnet returns the subnet of ip limited by SubnetLimit.
+func subnet(ip net.IP) string {
+	mask := net.CIDRMask(subnetMaskIPv6, 128)
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, mask = ip4, net.CIDRMask(subnetMaskIPv4, 32)
+	}
+	return (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()
+}
+
+// checkClient checks the request limits of the client IP and its subnet in scope,
+// the limits of each scope are independent. The requests without a valid client IP
+// share the same limits. It returns false and the time to wait before the next
+// request when a limit is reached.
+func (f Faucet) checkClient(r *http.Request, scope string) (bool, time.Duration) {
+	if f.ipLimit == nil && f.subnetLimit == nil {
+		return true, 0
+	}
+
+	ipKey, subnetKey := unknownClient, unknownClient
+	if ip := f.clientIP(r); ip != nil {
+		ipKey, subnetKey = ip.String(), subnet(ip)
+	}
+
+	var limits []limit
+	if f.ipLimit != nil {
+		limits = append(limits, limit{f.ipLimit, scope + "/" + ipKey})
+	}
+	if f.subnetLimit != nil {
+		limits = append(limits, limit{f.subnetLimit, scope + "/" + subnetKey})
+	}
+	return allowAll(limits...)
+}
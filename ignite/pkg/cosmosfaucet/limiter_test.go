@@ -0,0 +1,144 @@
+package cosmosfaucet
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newLimiter(2, time.Minute)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		ok, _ := l.allow("a")
+		require.True(t, ok)
+	}
+	ok, wait := l.allow("a")
+	require.False(t, ok)
+	require.Equal(t, 30*time.Second, wait)
+
+	// the buckets of the other keys are full.
+	ok, _ = l.allow("b")
+	require.True(t, ok)
+
+	now = now.Add(30 * time.Second)
+	ok, _ = l.allow("a")
+	require.True(t, ok)
+	ok, _ = l.allow("a")
+	require.False(t, ok)
+
+	// the full buckets are removed once per window.
+	now = now.Add(2 * time.Minute)
+	ok, _ = l.allow("c")
+	require.True(t, ok)
+	require.Len(t, l.buckets, 1)
+}
+
+func TestCheckClient(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := Faucet{
+		ipLimit:     newLimiter(1, time.Minute),
+		subnetLimit: newLimiter(2, time.Minute),
+	}
+	f.ipLimit.now = func() time.Time { return now }
+	f.subnetLimit.now = func() time.Time { return now }
+
+	request := func(remoteAddr string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.RemoteAddr = remoteAddr
+		return r
+	}
+
+	// a request denied by the IP limit doesn't use a token of the subnet limit.
+	ok, _ := f.checkClient(request("192.168.1.7:1234"), scopeTransfer)
+	require.True(t, ok)
+	ok, wait := f.checkClient(request("192.168.1.7:1234"), scopeTransfer)
+	require.False(t, ok)
+	require.Equal(t, time.Minute, wait)
+	ok, _ = f.checkClient(request("192.168.1.8:1234"), scopeTransfer)
+	require.True(t, ok)
+
+	// a request denied by the subnet limit doesn't use a token of the IP limit.
+	ok, wait = f.checkClient(request("192.168.1.9:1234"), scopeTransfer)
+	require.False(t, ok)
+	require.Equal(t, 30*time.Second, wait)
+	now = now.Add(30 * time.Second)
+	ok, _ = f.checkClient(request("192.168.1.9:1234"), scopeTransfer)
+	require.True(t, ok)
+
+	// the requests without a valid client IP share the same limits.
+	ok, _ = f.checkClient(request("invalid"), scopeTransfer)
+	require.True(t, ok)
+	ok, _ = f.checkClient(request("@"), scopeTransfer)
+	require.False(t, ok)
+}
+
+func TestSubnet(t *testing.T) {
+	require.Equal(t, "192.168.1.0/24", subnet(net.ParseIP("192.168.1.7")))
+	require.Equal(t, "2001:db8:1:2::/64", subnet(net.ParseIP("2001:db8:1:2:3::1")))
+}
+
+func TestClientIP(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	f := Faucet{trustedProxies: []*net.IPNet{proxies}}
+
+	cases := []struct {
+		name, remoteAddr, forwardedFor, realIP, want string
+	}{
+		{
+			name:       "remote address",
+			remoteAddr: "192.168.1.7:1234",
+			want:       "192.168.1.7",
+		},
+		{
+			name:         "headers of an untrusted client",
+			remoteAddr:   "192.168.1.7:1234",
+			forwardedFor: "1.2.3.4",
+			realIP:       "1.2.3.4",
+			want:         "192.168.1.7",
+		},
+		{
+			name:         "forwarded for a client",
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: "1.2.3.4",
+			want:         "1.2.3.4",
+		},
+		{
+			name:         "forwarded by many proxies",
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: "5.6.7.8, 1.2.3.4, 10.0.0.2",
+			want:         "1.2.3.4",
+		},
+		{
+			name:       "real IP",
+			remoteAddr: "10.0.0.1:1234",
+			realIP:     "1.2.3.4",
+			want:       "1.2.3.4",
+		},
+		{
+			name:       "proxy without headers",
+			remoteAddr: "10.0.0.1:1234",
+			want:       "10.0.0.1",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-IP", tt.realIP)
+			}
+			require.Equal(t, tt.want, f.clientIP(r).String())
+		})
+	}
+}
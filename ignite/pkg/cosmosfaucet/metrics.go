@@ -0,0 +1,151 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// metricsNamespace is the namespace of the faucet metrics.
+	metricsNamespace = "cosmosfaucet"
+
+	// balanceTimeout is the time to get the faucet balance when the metrics are collected.
+	balanceTimeout = 5 * time.Second
+)
+
+// Reasons of the denied transfer requests.
+const (
+	denialInvalidRequest    = "invalid_request"
+	denialRateLimited       = "rate_limited"
+	denialChallengeFailed   = "challenge_failed"
+	denialAddressNotAllowed = "address_not_allowed"
+	denialLimitReached      = "limit_reached"
+	denialPaused            = "paused"
+)
+
+// Balancer is implemented by the senders able to get the balance of the faucet
+// account. The balance is exported in the metrics and the admin API.
+type Balancer interface {
+	Balance(ctx context.Context) (sdk.Coins, error)
+}
+
+// metrics are the Prometheus metrics of a faucet.
+// The methods can be called on a nil metrics, which records nothing.
+type metrics struct {
+	registry  *prometheus.Registry
+	requests  prometheus.Counter
+	denials   *prometheus.CounterVec
+	dispensed *prometheus.CounterVec
+	feeGrants prometheus.Counter
+	broadcast prometheus.Histogram
+}
+
+func newMetrics(balancer Balancer) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "requests_total",
+			Help:      "Number of transfer requests received by the faucet.",
+		}),
+		denials: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "denials_total",
+			Help:      "Number of transfer requests denied by the faucet, by reason.",
+		}, []string{"reason"}),
+		dispensed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "dispensed_total",
+			Help:      "Amount of coins sent by the faucet, by denom.",
+		}, []string{"denom"}),
+		feeGrants: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "fee_grants_total",
+			Help:      "Number of fee allowances granted by the faucet.",
+		}),
+		broadcast: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "broadcast_duration_seconds",
+			Help:      "Time to send the coins of a transfer request.",
+			Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}),
+	}
+	m.registry.MustRegister(m.requests, m.denials, m.dispensed, m.feeGrants, m.broadcast)
+	if balancer != nil {
+		m.registry.MustRegister(balanceCollector{balancer})
+	}
+	return m
+}
+
+func (m *metrics) request() {
+	if m != nil {
+		m.requests.Inc()
+	}
+}
+
+func (m *metrics) deny(reason string) {
+	if m != nil {
+		m.denials.WithLabelValues(reason).Inc()
+	}
+}
+
+// sent records the coins sent by a transfer which took d to be sent.
+func (m *metrics) sent(coins sdk.Coins, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.broadcast.Observe(d.Seconds())
+	for _, c := range coins {
+		amount, _ := c.Amount.ToLegacyDec().Float64()
+		m.dispensed.WithLabelValues(c.Denom).Add(amount)
+	}
+}
+
+func (m *metrics) granted() {
+	if m != nil {
+		m.feeGrants.Inc()
+	}
+}
+
+func (m *metrics) handler() http.Handler {
+	if m == nil {
+		return http.NotFoundHandler()
+	}
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// balanceCollector collects the balance of the faucet account when the metrics are scraped.
+type balanceCollector struct {
+	balancer Balancer
+}
+
+var balanceDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(metricsNamespace, "", "balance"),
+	"Balance of the faucet account, by denom.",
+	[]string{"denom"},
+	nil,
+)
+
+func (c balanceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- balanceDesc
+}
+
+func (c balanceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), balanceTimeout)
+	defer cancel()
+
+	balance, err := c.balancer.Balance(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(balanceDesc, err)
+		return
+	}
+	for _, coin := range balance {
+		amount, _ := coin.Amount.ToLegacyDec().Float64()
+		ch <- prometheus.MustNewConstMetric(balanceDesc, prometheus.GaugeValue, amount, coin.Denom)
+	}
+}
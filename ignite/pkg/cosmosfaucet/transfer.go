@@ -2,12 +2,15 @@ package cosmosfaucet
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 
 	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
 	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
@@ -15,6 +18,10 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
 
+// ErrAddressNotAllowed is returned when the faucet doesn't send coins to an address,
+// like the address of a module account or an address rejected by the allow or deny lists.
+var ErrAddressNotAllowed = errors.New("the faucet doesn't send coins to this address")
+
 // transferMutex is a mutex used for keeping transfer requests in a queue so checking account balance and sending tokens is atomic.
 var transferMutex = &sync.Mutex{}
 
@@ -43,6 +50,7 @@ func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, de
 		}
 	}
 
+	resetTime := f.limitsResetTime(toAccountAddress)
 	totalAmount = sdkmath.NewInt(0)
 	for _, event := range events {
 		if event.Type == "transfer" {
@@ -54,7 +62,7 @@ func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, de
 					}
 
 					amount := coins.AmountOf(denom)
-					if amount.GT(sdkmath.NewInt(0)) && time.Since(event.Time) < f.limitRefreshWindow {
+					if amount.GT(sdkmath.NewInt(0)) && time.Since(event.Time) < f.limitRefreshWindow && event.Time.After(resetTime) {
 						totalAmount = totalAmount.Add(amount)
 					}
 				}
@@ -67,6 +75,37 @@ func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, de
 
 // Transfer transfers amount of tokens from the faucet account to toAccountAddress.
 func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) (string, error) {
+	if f.IsPaused() {
+		return "", ErrFaucetPaused
+	}
+
+	// the limits of the address are recorded with its normalized form.
+	toAccountAddress = normalizeAddress(toAccountAddress)
+	if err := f.checkAddressAllowed(toAccountAddress); err != nil {
+		return "", err
+	}
+
+	var (
+		start = time.Now()
+		hash  string
+		err   error
+	)
+	if f.sender != nil {
+		hash, err = f.transferWithBackend(ctx, toAccountAddress, coins)
+	} else {
+		hash, err = f.transferWithRunner(ctx, toAccountAddress, coins)
+	}
+	if err != nil {
+		return hash, err
+	}
+
+	f.metrics.sent(coins, time.Since(start))
+	f.recordRecentGrant(RecentGrant{Address: toAccountAddress, Coins: coins, Hash: hash, Time: start})
+	return hash, nil
+}
+
+// transferWithRunner checks the limits with the transactions history and sends the coins with the chain binary.
+func (f *Faucet) transferWithRunner(ctx context.Context, toAccountAddress string, coins sdk.Coins) (string, error) {
 	transferMutex.Lock()
 	defer transferMutex.Unlock()
 
@@ -82,23 +121,8 @@ func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sd
 		if err != nil {
 			return "", err
 		}
-		coinMax, found := f.coinsMax[c.Denom]
-		if found && !coinMax.IsNil() && !coinMax.Equal(sdkmath.NewInt(0)) {
-			if totalSent.GTE(coinMax) {
-				return "", errors.Errorf(
-					"account has reached to the max. allowed amount (%d) for %q denom",
-					coinMax,
-					c.Denom,
-				)
-			}
-
-			if (totalSent.Add(c.Amount)).GT(coinMax) {
-				return "", errors.Errorf(
-					`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
-					c.Denom,
-					coinMax,
-				)
-			}
+		if err := f.checkLimit(c, totalSent); err != nil {
+			return "", err
 		}
 
 		transfer = transfer.Add(c)
@@ -121,3 +145,135 @@ func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sd
 	// wait for send tx to be confirmed
 	return txHash, f.runner.WaitTx(ctx, txHash, time.Second, 30)
 }
+
+// transferWithBackend records the grant of the coins in the ledger when the limits allow it
+// and sends the coins with the backend. The grant is removed when the transfer fails.
+func (f *Faucet) transferWithBackend(ctx context.Context, toAccountAddress string, coins sdk.Coins) (string, error) {
+	// the transfers are sent in batches, check the address before queuing the transfer.
+	if err := f.validateAddress(toAccountAddress); err != nil {
+		return "", err
+	}
+	if checker, ok := f.sender.(AddressChecker); ok {
+		if err := checker.CheckAddress(ctx, toAccountAddress); err != nil {
+			return "", err
+		}
+	}
+
+	grant, err := f.recordGrant(toAccountAddress, coins)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := f.batcher.transfer(ctx, Transfer{Address: toAccountAddress, Coins: coins})
+	if err != nil && ctx.Err() == nil {
+		if rerr := f.removeGrant(toAccountAddress, grant); rerr != nil {
+			return "", errors.Join(err, rerr)
+		}
+	}
+	return hash, err
+}
+
+// checkAddressAllowed checks the address with the allow and deny lists.
+func (f Faucet) checkAddressAllowed(address string) error {
+	key := normalizeAddress(address)
+	if f.denyList[key] || (len(f.allowList) > 0 && !f.allowList[key]) {
+		return errors.Wrap(ErrAddressNotAllowed, address)
+	}
+	return nil
+}
+
+// normalizeAddress returns the lowercase form of a bech32 address. The addresses are
+// case-insensitive, so the same address can be written in lowercase or uppercase.
+func normalizeAddress(address string) string {
+	return strings.ToLower(address)
+}
+
+// validateAddress checks that the address uses the address prefix of the faucet account.
+func (f Faucet) validateAddress(address string) error {
+	prefix, _, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return errors.Errorf("invalid address %s: %w", address, err)
+	}
+	faucetPrefix, _, err := bech32.DecodeAndConvert(f.sender.Address())
+	if err != nil {
+		return err
+	}
+	if prefix != faucetPrefix {
+		return errors.Errorf("invalid address %s: the address prefix must be %s", address, faucetPrefix)
+	}
+	return nil
+}
+
+// recordGrant checks the limits of the address with the grants of the ledger
+// within the refresh window and records the grant of the coins.
+func (f *Faucet) recordGrant(address string, coins sdk.Coins) (Grant, error) {
+	f.ledgerMutex.Lock()
+	defer f.ledgerMutex.Unlock()
+
+	grants, err := f.ledger.Grants(address)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	now := time.Now()
+	totalSent := sdk.NewCoins()
+	active := grants[:0]
+	for _, g := range grants {
+		if now.Sub(g.Time) < f.limitRefreshWindow {
+			totalSent = totalSent.Add(g.Coins...)
+			active = append(active, g)
+		}
+	}
+
+	for _, c := range coins {
+		if err := f.checkLimit(c, totalSent.AmountOf(c.Denom)); err != nil {
+			return Grant{}, err
+		}
+	}
+
+	grant := Grant{Coins: coins, Time: now}
+	return grant, f.ledger.SetGrants(address, append(active, grant))
+}
+
+// removeGrant removes a grant of the address from the ledger.
+func (f *Faucet) removeGrant(address string, grant Grant) error {
+	f.ledgerMutex.Lock()
+	defer f.ledgerMutex.Unlock()
+
+	grants, err := f.ledger.Grants(address)
+	if err != nil {
+		return err
+	}
+	for i, g := range grants {
+		if g.Time.Equal(grant.Time) && g.Coins.Equal(grant.Coins) {
+			return f.ledger.SetGrants(address, append(grants[:i], grants[i+1:]...))
+		}
+	}
+	return nil
+}
+
+// checkLimit checks that the coin can be sent to an account which already received totalSent.
+func (f Faucet) checkLimit(c sdk.Coin, totalSent sdkmath.Int) error {
+	_, coinsMax := f.amounts()
+	coinMax, found := coinsMax[c.Denom]
+	if !found || coinMax.IsNil() || coinMax.IsZero() {
+		return nil
+	}
+
+	if totalSent.GTE(coinMax) {
+		return LimitError{fmt.Sprintf(
+			"account has reached to the max. allowed amount (%s) for %q denom",
+			coinMax,
+			c.Denom,
+		)}
+	}
+
+	if (totalSent.Add(c.Amount)).GT(coinMax) {
+		return LimitError{fmt.Sprintf(
+			`ask less amount for %q denom. account is reaching to the limit (%s) that faucet can tolerate`,
+			c.Denom,
+			coinMax,
+		)}
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+package cosmosgenesis
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// AuthModule is the name of the auth module.
+	AuthModule = "auth"
+
+	// baseAccountType is the type URL of the genesis accounts.
+	baseAccountType = "/cosmos.auth.v1beta1.BaseAccount"
+
+	// csvAddressHeader is the address column header of the CSV accounts files.
+	csvAddressHeader = "address"
+)
+
+type (
+	// Account is an account with its genesis balance.
+	Account struct {
+		Address string
+		Coins   sdk.Coins
+	}
+
+	// AccountReader reads accounts one by one.
+	// Next returns io.EOF when there are no more accounts.
+	AccountReader interface {
+		Next() (Account, error)
+	}
+
+	// AccountsSummary summarizes the accounts added to a genesis.
+	AccountsSummary struct {
+		// Accounts is the number of accounts added.
+		Accounts int
+
+		// Coins is the sum of the balances of the accounts added.
+		Coins sdk.Coins
+
+		// Supply is the total supply of the genesis after the accounts were added.
+		Supply sdk.Coins
+	}
+)
+
+// OpenAccountsFile opens a CSV or a JSON accounts file depending on its extension.
+// The CSV rows are an address and its coins, e.g. cosmos1...,"100stake,5token",
+// with an optional address,coins header. The JSON file is a list of objects with
+// an address and coins, either a list or a comma separated string.
+func OpenAccountsFile(path string) (AccountReader, io.Closer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		return NewCSVAccountReader(f), f, nil
+	case ".json":
+		return NewJSONAccountReader(f), f, nil
+	default:
+		f.Close()
+		return nil, nil, errors.Errorf("unsupported accounts file %s: use a .csv or .json file", path)
+	}
+}
+
+type csvAccountReader struct {
+	r     *csv.Reader
+	first bool
+}
+
+// NewCSVAccountReader returns a reader of CSV accounts.
+func NewCSVAccountReader(r io.Reader) AccountReader {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.Comment = '#'
+	cr.TrimLeadingSpace = true
+	cr.ReuseRecord = true
+	return &csvAccountReader{r: cr, first: true}
+}
+
+func (r *csvAccountReader) Next() (Account, error) {
+	for {
+		record, err := r.r.Read()
+		if err != nil {
+			return Account{}, err
+		}
+		line, _ := r.r.FieldPos(0)
+
+		first := r.first
+		r.first = false
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), csvAddressHeader) {
+			continue
+		}
+		if len(record) < 2 {
+			return Account{}, errors.Errorf("line %d: an address and coins are required", line)
+		}
+
+		// the coins can be a quoted list or the next columns.
+		account, err := newAccount(record[0], strings.Join(record[1:], ","))
+		if err != nil {
+			return Account{}, errors.Errorf("line %d: %w", line, err)
+		}
+		return account, nil
+	}
+}
+
+type jsonAccountReader struct {
+	d     *json.Decoder
+	index int
+}
+
+// NewJSONAccountReader returns a reader of JSON accounts.
+func NewJSONAccountReader(r io.Reader) AccountReader {
+	return &jsonAccountReader{d: json.NewDecoder(r), index: -1}
+}
+
+func (r *jsonAccountReader) Next() (Account, error) {
+	if r.index < 0 {
+		t, err := r.d.Token()
+		if err != nil {
+			return Account{}, err
+		}
+		if delim, ok := t.(json.Delim); !ok || delim != '[' {
+			return Account{}, errors.New("the accounts must be a JSON list")
+		}
+	}
+	r.index++
+
+	if !r.d.More() {
+		return Account{}, io.EOF
+	}
+
+	var item struct {
+		Address string          `json:"address"`
+		Coins   json.RawMessage `json:"coins"`
+	}
+	if err := r.d.Decode(&item); err != nil {
+		return Account{}, errors.Errorf("account #%d: %w", r.index+1, err)
+	}
+
+	var coins string
+	if err := json.Unmarshal(item.Coins, &coins); err != nil {
+		var list []string
+		if err := json.Unmarshal(item.Coins, &list); err != nil {
+			return Account{}, errors.Errorf("account #%d: coins must be a string or a list of strings", r.index+1)
+		}
+		coins = strings.Join(list, ",")
+	}
+
+	account, err := newAccount(item.Address, coins)
+	if err != nil {
+		return Account{}, errors.Errorf("account #%d: %w", r.index+1, err)
+	}
+	return account, nil
+}
+
+func newAccount(address, coins string) (Account, error) {
+	address = strings.TrimSpace(address)
+	parsed, err := sdk.ParseCoinsNormalized(strings.TrimSpace(coins))
+	if err != nil {
+		return Account{}, errors.Errorf("invalid coins of %s: %w", address, err)
+	}
+	return Account{Address: address, Coins: parsed}, nil
+}
+
+// AddAccounts adds the accounts and their balances to the auth and bank modules
+// of the genesis and adds their coins to the supply, like the add-genesis-account
+// command of the chains. The addresses must use the address prefix and must not
+// be in the genesis already.
+func (g Genesis) AddAccounts(r AccountReader, addressPrefix string) (AccountsSummary, error) {
+	var summary AccountsSummary
+
+	auth, err := g.moduleState(AuthModule)
+	if err != nil {
+		return summary, err
+	}
+	bank, err := g.moduleState(BankModule)
+	if err != nil {
+		return summary, err
+	}
+
+	accounts, _ := auth["accounts"].([]interface{})
+	balances, _ := bank["balances"].([]interface{})
+
+	supply := sdk.NewCoins()
+	supplyList, _ := bank["supply"].([]interface{})
+	for _, c := range supplyList {
+		denom, amount, err := parseCoin(c)
+		if err != nil {
+			return summary, errors.Wrap(err, "invalid supply")
+		}
+		supply = supply.Add(sdk.NewCoin(denom, math.NewIntFromBigInt(amount)))
+	}
+
+	existing := make(map[string]bool, len(accounts)+len(balances))
+	for _, items := range [][]interface{}{accounts, balances} {
+		for _, item := range items {
+			if m, ok := item.(map[string]interface{}); ok {
+				existing[accountAddress(m)] = true
+			}
+		}
+	}
+
+	summary.Coins = sdk.NewCoins()
+	for {
+		account, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return summary, err
+		}
+
+		prefix, _, err := bech32.DecodeAndConvert(account.Address)
+		if err != nil {
+			return summary, errors.Errorf("invalid address %s: %w", account.Address, err)
+		}
+		if prefix != addressPrefix {
+			return summary, errors.Errorf("address %s must use the %s prefix", account.Address, addressPrefix)
+		}
+		if existing[account.Address] {
+			return summary, errors.Errorf("account %s already exists in the genesis", account.Address)
+		}
+		existing[account.Address] = true
+
+		accounts = append(accounts, map[string]interface{}{
+			"@type":          baseAccountType,
+			"address":        account.Address,
+			"pub_key":        nil,
+			"account_number": "0",
+			"sequence":       "0",
+		})
+		balances = append(balances, map[string]interface{}{
+			"address": account.Address,
+			"coins":   coinsValue(account.Coins),
+		})
+
+		summary.Accounts++
+		summary.Coins = summary.Coins.Add(account.Coins...)
+	}
+
+	summary.Supply = supply.Add(summary.Coins...)
+	auth["accounts"] = accounts
+	bank["balances"] = balances
+	bank["supply"] = coinsValue(summary.Supply)
+	return summary, nil
+}
+
+// moduleState returns the state of a module of the genesis.
+func (g Genesis) moduleState(name string) (map[string]interface{}, error) {
+	state, ok := g.Module(name)
+	if !ok {
+		return nil, errors.Errorf("genesis has no %s module", name)
+	}
+	m, ok := state.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("invalid %s module genesis", name)
+	}
+	return m, nil
+}
+
+// coinsValue returns the genesis value of coins.
+func coinsValue(coins sdk.Coins) []interface{} {
+	value := make([]interface{}, len(coins))
+	for i, c := range coins {
+		value[i] = map[string]interface{}{"denom": c.Denom, "amount": c.Amount.String()}
+	}
+	return value
+}
@@ -0,0 +1,211 @@
+package cosmosgenesis_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosgenesis"
+)
+
+const (
+	addressAlice = "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e"
+	addressBob   = "cosmos1ruszzg3rysjjvfeg9y4zktpd9chnqvfje038ze"
+	addressCarol = "cosmos18cl5qs2zgdzy23j8fpy55j6vf48y75z395ggwe"
+	addressMars  = "mars1t4097crpvf3kgetxva5xj6ntd3kkummsp5v8ae"
+
+	accountsGenesis = `{
+  "chain_id": "mars-1",
+  "app_state": {
+    "auth": {"accounts": [{"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "` + addressAlice + `"}]},
+    "bank": {
+      "balances": [{"address": "` + addressAlice + `", "coins": [{"denom": "stake", "amount": "100"}]}],
+      "supply": [{"denom": "stake", "amount": "100"}]
+    }
+  }
+}`
+)
+
+func readAccounts(t *testing.T, r cosmosgenesis.AccountReader) ([]cosmosgenesis.Account, error) {
+	t.Helper()
+	var accounts []cosmosgenesis.Account
+	for {
+		account, err := r.Next()
+		if err == io.EOF {
+			return accounts, nil
+		}
+		if err != nil {
+			return accounts, err
+		}
+		accounts = append(accounts, account)
+	}
+}
+
+func TestCSVAccountReader(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []cosmosgenesis.Account
+		err      string
+	}{
+		{
+			name: "with header and comments",
+			content: `address,coins
+# team
+` + addressBob + `,"10stake,5token"
+` + addressCarol + `,20stake
+`,
+			expected: []cosmosgenesis.Account{
+				{Address: addressBob, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 5))},
+				{Address: addressCarol, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+			},
+		},
+		{
+			name:    "unquoted coins",
+			content: addressBob + ", 10stake, 5token\n",
+			expected: []cosmosgenesis.Account{
+				{Address: addressBob, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 5))},
+			},
+		},
+		{
+			name:    "missing coins",
+			content: addressBob + ",10stake\n" + addressCarol + "\n",
+			err:     "line 2: an address and coins are required",
+		},
+		{
+			name:    "invalid coins",
+			content: "address,coins\n" + addressBob + ",ten\n",
+			err:     "line 2: invalid coins of " + addressBob,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accounts, err := readAccounts(t, cosmosgenesis.NewCSVAccountReader(strings.NewReader(tt.content)))
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, accounts)
+		})
+	}
+}
+
+func TestJSONAccountReader(t *testing.T) {
+	content := `[
+  {"address": "` + addressBob + `", "coins": "10stake,5token"},
+  {"address": "` + addressCarol + `", "coins": ["20stake"]}
+]`
+	accounts, err := readAccounts(t, cosmosgenesis.NewJSONAccountReader(strings.NewReader(content)))
+	require.NoError(t, err)
+	require.Equal(t, []cosmosgenesis.Account{
+		{Address: addressBob, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 5))},
+		{Address: addressCarol, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+	}, accounts)
+
+	_, err = readAccounts(t, cosmosgenesis.NewJSONAccountReader(strings.NewReader(`{"address": "x"}`)))
+	require.ErrorContains(t, err, "the accounts must be a JSON list")
+
+	_, err = readAccounts(t, cosmosgenesis.NewJSONAccountReader(strings.NewReader(`[{"address": "x", "coins": 1}]`)))
+	require.ErrorContains(t, err, "account #1: coins must be a string or a list of strings")
+}
+
+func TestOpenAccountsFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "accounts.txt")
+	require.NoError(t, os.WriteFile(path, nil, 0o644))
+
+	_, _, err := cosmosgenesis.OpenAccountsFile(path)
+	require.ErrorContains(t, err, "use a .csv or .json file")
+
+	path = filepath.Join(dir, "accounts.CSV")
+	require.NoError(t, os.WriteFile(path, []byte(addressBob+",1stake\n"), 0o644))
+
+	r, closer, err := cosmosgenesis.OpenAccountsFile(path)
+	require.NoError(t, err)
+	defer closer.Close()
+
+	accounts, err := readAccounts(t, r)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+}
+
+func TestGenesisAddAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{
+			name:    "invalid prefix",
+			content: addressMars + ",1stake\n",
+			err:     "address " + addressMars + " must use the cosmos prefix",
+		},
+		{
+			name:    "invalid address",
+			content: "cosmos1invalid,1stake\n",
+			err:     "invalid address cosmos1invalid",
+		},
+		{
+			name:    "existing account",
+			content: addressAlice + ",1stake\n",
+			err:     "account " + addressAlice + " already exists in the genesis",
+		},
+		{
+			name:    "duplicated account",
+			content: addressBob + ",1stake\n" + addressBob + ",2stake\n",
+			err:     "account " + addressBob + " already exists in the genesis",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := cosmosgenesis.Parse([]byte(accountsGenesis))
+			require.NoError(t, err)
+
+			_, err = g.AddAccounts(cosmosgenesis.NewCSVAccountReader(strings.NewReader(tt.content)), "cosmos")
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	t.Run("add accounts", func(t *testing.T) {
+		g, err := cosmosgenesis.Parse([]byte(accountsGenesis))
+		require.NoError(t, err)
+
+		content := addressBob + ",\"10stake,5token\"\n" + addressCarol + ",20stake\n"
+		summary, err := g.AddAccounts(cosmosgenesis.NewCSVAccountReader(strings.NewReader(content)), "cosmos")
+		require.NoError(t, err)
+		require.Equal(t, 2, summary.Accounts)
+		require.Equal(t, "30stake,5token", summary.Coins.String())
+		require.Equal(t, "130stake,5token", summary.Supply.String())
+
+		auth, _ := g.Module(cosmosgenesis.AuthModule)
+		accounts := auth.(map[string]interface{})["accounts"].([]interface{})
+		require.Len(t, accounts, 3)
+		require.Equal(t, map[string]interface{}{
+			"@type":          "/cosmos.auth.v1beta1.BaseAccount",
+			"address":        addressCarol,
+			"pub_key":        nil,
+			"account_number": "0",
+			"sequence":       "0",
+		}, accounts[2])
+
+		summaries, err := g.BankSummary()
+		require.NoError(t, err)
+		require.Len(t, summaries, 2)
+		require.Equal(t, "stake", summaries[0].Denom)
+		require.Equal(t, 3, summaries[0].Holders)
+		require.Equal(t, "130", summaries[0].Balances.String())
+		require.Equal(t, "130", summaries[0].Supply.String())
+
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, g.WriteFile(path))
+		written, err := cosmosgenesis.ParseFile(path)
+		require.NoError(t, err)
+		require.Equal(t, g, written)
+	})
+}
@@ -0,0 +1,104 @@
+package cosmosgenesis
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// BankModule is the name of the bank module.
+const BankModule = "bank"
+
+// DenomSummary summarizes the balances and the supply of a denom in the bank module.
+type DenomSummary struct {
+	Denom string
+
+	// Holders is the number of accounts with a balance of the denom.
+	Holders int
+
+	// Balances is the sum of the account balances.
+	Balances *big.Int
+
+	// Supply is the total supply of the genesis or nil when the supply is not set,
+	// in which case the chain computes it from the balances.
+	Supply *big.Int
+}
+
+// BankSummary summarizes the balances and the supply per denom of the bank module sorted by denom.
+func (g Genesis) BankSummary() ([]DenomSummary, error) {
+	state, ok := g.Module(BankModule)
+	if !ok {
+		return nil, errors.Errorf("genesis has no %s module", BankModule)
+	}
+	bank, _ := state.(map[string]interface{})
+
+	summaries := make(map[string]*DenomSummary)
+	summary := func(denom string) *DenomSummary {
+		s, ok := summaries[denom]
+		if !ok {
+			s = &DenomSummary{Denom: denom, Balances: new(big.Int)}
+			summaries[denom] = s
+		}
+		return s
+	}
+
+	balances, _ := bank["balances"].([]interface{})
+	for _, b := range balances {
+		balance, _ := b.(map[string]interface{})
+		coins, _ := balance["coins"].([]interface{})
+		for _, c := range coins {
+			denom, amount, err := parseCoin(c)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid balance of %v", balance["address"])
+			}
+			s := summary(denom)
+			s.Holders++
+			s.Balances.Add(s.Balances, amount)
+		}
+	}
+
+	supply, _ := bank["supply"].([]interface{})
+	for _, c := range supply {
+		denom, amount, err := parseCoin(c)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid supply")
+		}
+		s := summary(denom)
+		if s.Supply == nil {
+			s.Supply = new(big.Int)
+		}
+		s.Supply.Add(s.Supply, amount)
+	}
+
+	denoms := make([]DenomSummary, 0, len(summaries))
+	for _, s := range summaries {
+		denoms = append(denoms, *s)
+	}
+	sort.Slice(denoms, func(i, j int) bool { return denoms[i].Denom < denoms[j].Denom })
+	return denoms, nil
+}
+
+// parseCoin returns the denom and the amount of a genesis coin.
+func parseCoin(c interface{}) (string, *big.Int, error) {
+	coin, _ := c.(map[string]interface{})
+	denom, _ := coin["denom"].(string)
+	if denom == "" {
+		return "", nil, errors.Errorf("coin without denom: %s", FormatValue(c))
+	}
+
+	// amounts are JSON strings but numbers are accepted too
+	var value string
+	switch v := coin["amount"].(type) {
+	case string:
+		value = v
+	case json.Number:
+		value = v.String()
+	}
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return "", nil, errors.Errorf("invalid amount of %s: %s", denom, FormatValue(coin["amount"]))
+	}
+	return denom, amount, nil
+}
@@ -0,0 +1,237 @@
+package cosmosgenesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ChangeKind is the kind of change of a genesis value.
+type ChangeKind string
+
+const (
+	Added    ChangeKind = "added"
+	Removed  ChangeKind = "removed"
+	Modified ChangeKind = "modified"
+)
+
+// identityKeys are the fields that identify the items of the genesis lists, like the
+// address of the balances or the denom of the coins, in order of preference.
+var identityKeys = []string{"address", "denom", "validator_address", "delegator_address", "name", "id"}
+
+// Change is a difference between two genesis values.
+type Change struct {
+	// Module is the name of the module of the value or empty for the genesis fields
+	// out of the app state, like the chain ID.
+	Module string
+
+	// Path is the path of the value in the module state or in the genesis. The list
+	// items are identified by their address, denom or name when they have one, e.g.
+	// balances[cosmos1...].coins[stake].amount, or by their index otherwise.
+	Path string
+
+	Kind ChangeKind
+
+	// Old and New are the values before and after the change, nil when the value was added or removed.
+	Old, New interface{}
+}
+
+func (c Change) String() string {
+	path := c.Path
+	if c.Module != "" {
+		path = joinPath(fmt.Sprintf("%s.%s", AppStateKey, c.Module), c.Path)
+	}
+
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", path, FormatValue(c.New))
+	case Removed:
+		return fmt.Sprintf("- %s: %s", path, FormatValue(c.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s → %s", path, FormatValue(c.Old), FormatValue(c.New))
+	}
+}
+
+// Diff returns the changes from the genesis a to the genesis b sorted by module and path.
+func Diff(a, b Genesis) []Change {
+	var changes []Change
+	for _, key := range unionKeys(a, b) {
+		if key == AppStateKey {
+			continue
+		}
+		changes = append(changes, diffValues("", key, a[key], b[key], keyExists(a, key), keyExists(b, key))...)
+	}
+
+	appStateA, _ := a[AppStateKey].(map[string]interface{})
+	appStateB, _ := b[AppStateKey].(map[string]interface{})
+	for _, module := range unionKeys(appStateA, appStateB) {
+		stateA, okA := appStateA[module]
+		stateB, okB := appStateB[module]
+		switch {
+		case !okA:
+			changes = append(changes, Change{Module: module, Kind: Added, New: stateB})
+		case !okB:
+			changes = append(changes, Change{Module: module, Kind: Removed, Old: stateA})
+		default:
+			changes = append(changes, diffValues(module, "", stateA, stateB, true, true)...)
+		}
+	}
+	return changes
+}
+
+// FilterModule returns the changes of a module.
+func FilterModule(changes []Change, module string) []Change {
+	var filtered []Change
+	for _, c := range changes {
+		if c.Module == module {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+func diffValues(module, path string, a, b interface{}, okA, okB bool) []Change {
+	switch {
+	case !okA:
+		return []Change{{Module: module, Path: path, Kind: Added, New: b}}
+	case !okB:
+		return []Change{{Module: module, Path: path, Kind: Removed, Old: a}}
+	}
+
+	switch va := a.(type) {
+	case map[string]interface{}:
+		vb, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+		var changes []Change
+		for _, key := range unionKeys(va, vb) {
+			changes = append(changes, diffValues(
+				module,
+				joinPath(path, key),
+				va[key],
+				vb[key],
+				keyExists(va, key),
+				keyExists(vb, key),
+			)...)
+		}
+		return changes
+	case []interface{}:
+		vb, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+		return diffLists(module, path, va, vb)
+	}
+
+	if equal(a, b) {
+		return nil
+	}
+	return []Change{{Module: module, Path: path, Kind: Modified, Old: a, New: b}}
+}
+
+// diffLists compares the lists items by their identity when they have one or by their index.
+func diffLists(module, path string, a, b []interface{}) []Change {
+	key := identityKey(a, b)
+	if key == "" {
+		var changes []Change
+		for i := 0; i < len(a) || i < len(b); i++ {
+			var itemA, itemB interface{}
+			if i < len(a) {
+				itemA = a[i]
+			}
+			if i < len(b) {
+				itemB = b[i]
+			}
+			changes = append(changes, diffValues(module, fmt.Sprintf("%s[%d]", path, i), itemA, itemB, i < len(a), i < len(b))...)
+		}
+		return changes
+	}
+
+	itemsA, itemsB := indexItems(a, key), indexItems(b, key)
+	var changes []Change
+	for _, id := range unionKeys(itemsA, itemsB) {
+		itemA, okA := itemsA[id]
+		itemB, okB := itemsB[id]
+		changes = append(changes, diffValues(module, fmt.Sprintf("%s[%s]", path, id), itemA, itemB, okA, okB)...)
+	}
+	return changes
+}
+
+// identityKey returns the key that uniquely identifies the items of both lists or an empty string.
+func identityKey(lists ...[]interface{}) string {
+	for _, key := range identityKeys {
+		if hasIdentity(key, lists...) {
+			return key
+		}
+	}
+	return ""
+}
+
+func hasIdentity(key string, lists ...[]interface{}) bool {
+	items := 0
+	for _, list := range lists {
+		ids := make(map[string]bool)
+		for _, item := range list {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			id, ok := m[key].(string)
+			if !ok || ids[id] {
+				return false
+			}
+			ids[id] = true
+			items++
+		}
+	}
+	return items > 0
+}
+
+func indexItems(list []interface{}, key string) map[string]interface{} {
+	items := make(map[string]interface{}, len(list))
+	for _, item := range list {
+		id := item.(map[string]interface{})[key].(string)
+		items[id] = item
+	}
+	return items
+}
+
+// FormatValue returns the compact JSON representation of a genesis value.
+func FormatValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+func equal(a, b interface{}) bool {
+	return FormatValue(a) == FormatValue(b)
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func keyExists[V any](m map[string]V, key string) bool {
+	_, ok := m[key]
+	return ok
+}
+
+func joinPath(path, key string) string {
+	if path == "" || key == "" {
+		return path + key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,98 @@
+// Package cosmosgenesis reads Cosmos SDK genesis files to inspect
+// their modules, compare them and add accounts to them.
+package cosmosgenesis
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// AppStateKey is the genesis field of the modules state.
+const AppStateKey = "app_state"
+
+// Genesis is a decoded genesis file. The numbers are decoded as json.Number to keep their precision.
+type Genesis map[string]interface{}
+
+// Parse decodes a genesis file.
+func Parse(data []byte) (Genesis, error) {
+	var g Genesis
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&g); err != nil {
+		return nil, errors.Wrap(err, "invalid genesis")
+	}
+	return g, nil
+}
+
+// ParseFile decodes the genesis file at path.
+func ParseFile(path string) (Genesis, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// WriteFile encodes the genesis to the file at path. The genesis is written to
+// a temporary file first, so the existing file is never left half written.
+func (g Genesis) WriteFile(path string) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	if err := e.Encode(g); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// FromMap returns the genesis of a generic map, like a genesis merged with config values.
+func FromMap(m map[string]interface{}) (Genesis, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Modules returns the sorted names of the genesis modules.
+func (g Genesis) Modules() []string {
+	appState, _ := g[AppStateKey].(map[string]interface{})
+	modules := make([]string, 0, len(appState))
+	for name := range appState {
+		modules = append(modules, name)
+	}
+	sort.Strings(modules)
+	return modules
+}
+
+// Module returns the genesis state of a module.
+func (g Genesis) Module(name string) (interface{}, bool) {
+	appState, _ := g[AppStateKey].(map[string]interface{})
+	state, ok := appState[name]
+	return state, ok
+}
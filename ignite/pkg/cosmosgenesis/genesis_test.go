@@ -0,0 +1,106 @@
+package cosmosgenesis_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosgenesis"
+)
+
+const (
+	genesisA = `{
+  "chain_id": "mars-1",
+  "initial_height": "1",
+  "app_state": {
+    "auth": {"params": {"max_memo_characters": "256"}},
+    "bank": {
+      "balances": [
+        {"address": "cosmos1alice", "coins": [{"denom": "stake", "amount": "100"}, {"denom": "token", "amount": "20"}]},
+        {"address": "cosmos1bob", "coins": [{"denom": "stake", "amount": "50"}]}
+      ],
+      "supply": []
+    },
+    "crisis": {"constant_fee": {"denom": "stake", "amount": "1000"}}
+  }
+}`
+	genesisB = `{
+  "chain_id": "mars-2",
+  "initial_height": "1",
+  "app_state": {
+    "auth": {"params": {"max_memo_characters": "512"}},
+    "bank": {
+      "balances": [
+        {"address": "cosmos1bob", "coins": [{"denom": "stake", "amount": "70"}]},
+        {"address": "cosmos1carol", "coins": [{"denom": "token", "amount": "5"}]}
+      ],
+      "supply": [{"denom": "stake", "amount": "70"}, {"denom": "token", "amount": "5"}]
+    },
+    "mint": {"params": {"mint_denom": "stake"}}
+  }
+}`
+)
+
+func TestDiff(t *testing.T) {
+	a, err := cosmosgenesis.Parse([]byte(genesisA))
+	require.NoError(t, err)
+	b, err := cosmosgenesis.Parse([]byte(genesisB))
+	require.NoError(t, err)
+
+	changes := cosmosgenesis.Diff(a, b)
+
+	got := make([]string, len(changes))
+	for i, c := range changes {
+		got[i] = c.String()
+	}
+	require.Equal(t, []string{
+		`~ chain_id: "mars-1" → "mars-2"`,
+		`~ app_state.auth.params.max_memo_characters: "256" → "512"`,
+		`- app_state.bank.balances[cosmos1alice]: {"address":"cosmos1alice","coins":[{"amount":"100","denom":"stake"},{"amount":"20","denom":"token"}]}`,
+		`~ app_state.bank.balances[cosmos1bob].coins[stake].amount: "50" → "70"`,
+		`+ app_state.bank.balances[cosmos1carol]: {"address":"cosmos1carol","coins":[{"amount":"5","denom":"token"}]}`,
+		`+ app_state.bank.supply[stake]: {"amount":"70","denom":"stake"}`,
+		`+ app_state.bank.supply[token]: {"amount":"5","denom":"token"}`,
+		`- app_state.crisis: {"constant_fee":{"amount":"1000","denom":"stake"}}`,
+		`+ app_state.mint: {"params":{"mint_denom":"stake"}}`,
+	}, got)
+
+	require.Len(t, cosmosgenesis.FilterModule(changes, "bank"), 5)
+	require.Empty(t, cosmosgenesis.Diff(a, a))
+}
+
+func TestModules(t *testing.T) {
+	g, err := cosmosgenesis.Parse([]byte(genesisA))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"auth", "bank", "crisis"}, g.Modules())
+	_, ok := g.Module("bank")
+	require.True(t, ok)
+	_, ok = g.Module("mint")
+	require.False(t, ok)
+}
+
+func TestBankSummary(t *testing.T) {
+	a, err := cosmosgenesis.Parse([]byte(genesisA))
+	require.NoError(t, err)
+	b, err := cosmosgenesis.Parse([]byte(genesisB))
+	require.NoError(t, err)
+
+	summary, err := a.BankSummary()
+	require.NoError(t, err)
+	require.Equal(t, []cosmosgenesis.DenomSummary{
+		{Denom: "stake", Holders: 2, Balances: big.NewInt(150)},
+		{Denom: "token", Holders: 1, Balances: big.NewInt(20)},
+	}, summary)
+
+	summary, err = b.BankSummary()
+	require.NoError(t, err)
+	require.Equal(t, []cosmosgenesis.DenomSummary{
+		{Denom: "stake", Holders: 1, Balances: big.NewInt(70), Supply: big.NewInt(70)},
+		{Denom: "token", Holders: 1, Balances: big.NewInt(5), Supply: big.NewInt(5)},
+	}, summary)
+
+	_, err = cosmosgenesis.Genesis{}.BankSummary()
+	require.EqualError(t, err, "genesis has no bank module")
+}
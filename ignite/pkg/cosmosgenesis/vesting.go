@@ -0,0 +1,196 @@
+package cosmosgenesis
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// GenesisTimeKey is the genesis field of the genesis time.
+const GenesisTimeKey = "genesis_time"
+
+const (
+	moduleAccountType            = "/cosmos.auth.v1beta1.ModuleAccount"
+	continuousVestingAccountType = "/cosmos.vesting.v1beta1.ContinuousVestingAccount"
+	delayedVestingAccountType    = "/cosmos.vesting.v1beta1.DelayedVestingAccount"
+	periodicVestingAccountType   = "/cosmos.vesting.v1beta1.PeriodicVestingAccount"
+)
+
+// VestingType is the type of a vesting account.
+type VestingType string
+
+const (
+	// VestingContinuous is a continuous vesting account.
+	VestingContinuous VestingType = "continuous"
+
+	// VestingDelayed is a delayed vesting account.
+	VestingDelayed VestingType = "delayed"
+
+	// VestingPeriodic is a periodic vesting account.
+	VestingPeriodic VestingType = "periodic"
+)
+
+type (
+	// VestingAccount is the vesting schedule of a genesis account.
+	VestingAccount struct {
+		Type            VestingType
+		OriginalVesting sdk.Coins
+
+		// StartTime is the start of the continuous and periodic vesting.
+		StartTime time.Time
+
+		// EndTime is the end of the continuous and delayed vesting.
+		// The periodic vesting ends with its last period.
+		EndTime time.Time
+
+		Periods []VestingPeriod
+	}
+
+	// VestingPeriod is a period of a periodic vesting account.
+	VestingPeriod struct {
+		Length time.Duration
+		Coins  sdk.Coins
+	}
+)
+
+// Time returns the genesis time.
+func (g Genesis) Time() (time.Time, error) {
+	value, _ := g[GenesisTimeKey].(string)
+	if value == "" {
+		return time.Time{}, errors.New("genesis has no genesis time")
+	}
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+// SetVestingAccount turns the base account of the address into a vesting account.
+func (g Genesis) SetVestingAccount(address string, v VestingAccount) error {
+	account, err := g.baseAccount(address)
+	if err != nil {
+		return err
+	}
+
+	endTime := v.EndTime
+	if v.Type == VestingPeriodic {
+		endTime = v.StartTime
+		for _, p := range v.Periods {
+			endTime = endTime.Add(p.Length)
+		}
+	}
+
+	base := map[string]interface{}{
+		"base_account":      account,
+		"original_vesting":  coinsValue(v.OriginalVesting),
+		"delegated_free":    []interface{}{},
+		"delegated_vesting": []interface{}{},
+		"end_time":          unixValue(endTime),
+	}
+	vesting := map[string]interface{}{"base_vesting_account": base}
+
+	switch v.Type {
+	case VestingContinuous:
+		vesting["@type"] = continuousVestingAccountType
+		vesting["start_time"] = unixValue(v.StartTime)
+	case VestingDelayed:
+		vesting["@type"] = delayedVestingAccountType
+	case VestingPeriodic:
+		periods := make([]interface{}, len(v.Periods))
+		for i, p := range v.Periods {
+			periods[i] = map[string]interface{}{
+				"length": strconv.FormatInt(int64(p.Length/time.Second), 10),
+				"amount": coinsValue(p.Coins),
+			}
+		}
+		vesting["@type"] = periodicVestingAccountType
+		vesting["start_time"] = unixValue(v.StartTime)
+		vesting["vesting_periods"] = periods
+	default:
+		return errors.Errorf("invalid vesting type %s", v.Type)
+	}
+
+	return g.replaceAccount(address, vesting)
+}
+
+// SetModuleAccount turns the base account of the address into a module account.
+func (g Genesis) SetModuleAccount(address, name string, permissions []string) error {
+	account, err := g.baseAccount(address)
+	if err != nil {
+		return err
+	}
+
+	perms := make([]interface{}, len(permissions))
+	for i, p := range permissions {
+		perms[i] = p
+	}
+	return g.replaceAccount(address, map[string]interface{}{
+		"@type":        moduleAccountType,
+		"base_account": account,
+		"name":         name,
+		"permissions":  perms,
+	})
+}
+
+// baseAccount returns the base account fields of the auth account of an address.
+func (g Genesis) baseAccount(address string) (map[string]interface{}, error) {
+	account, _, err := g.authAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if account["@type"] != baseAccountType {
+		return nil, errors.Errorf("account %s is not a base account", address)
+	}
+
+	base := make(map[string]interface{}, len(account))
+	for k, v := range account {
+		if k != "@type" {
+			base[k] = v
+		}
+	}
+	return base, nil
+}
+
+// replaceAccount replaces the auth account of an address.
+func (g Genesis) replaceAccount(address string, account map[string]interface{}) error {
+	_, i, err := g.authAccount(address)
+	if err != nil {
+		return err
+	}
+	auth, _ := g.moduleState(AuthModule)
+	auth["accounts"].([]interface{})[i] = account
+	return nil
+}
+
+// authAccount returns the auth account of an address and its index.
+func (g Genesis) authAccount(address string) (map[string]interface{}, int, error) {
+	auth, err := g.moduleState(AuthModule)
+	if err != nil {
+		return nil, 0, err
+	}
+	accounts, _ := auth["accounts"].([]interface{})
+	for i, item := range accounts {
+		account, ok := item.(map[string]interface{})
+		if ok && accountAddress(account) == address {
+			return account, i, nil
+		}
+	}
+	return nil, 0, errors.Errorf("account %s not found in the genesis", address)
+}
+
+// accountAddress returns the address of an auth account, including the
+// vesting and module accounts that nest their base account.
+func accountAddress(account map[string]interface{}) string {
+	if vesting, ok := account["base_vesting_account"].(map[string]interface{}); ok {
+		account = vesting
+	}
+	if base, ok := account["base_account"].(map[string]interface{}); ok {
+		account = base
+	}
+	address, _ := account["address"].(string)
+	return address
+}
+
+func unixValue(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
@@ -0,0 +1,141 @@
+package cosmosgenesis_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosgenesis"
+)
+
+func TestGenesisTime(t *testing.T) {
+	g, err := cosmosgenesis.Parse([]byte(`{"genesis_time": "2024-01-02T03:04:05.123456Z"}`))
+	require.NoError(t, err)
+	genesisTime, err := g.Time()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC), genesisTime)
+
+	_, err = cosmosgenesis.Genesis{}.Time()
+	require.ErrorContains(t, err, "genesis has no genesis time")
+}
+
+func TestGenesisSetVestingAccount(t *testing.T) {
+	var (
+		start = time.Unix(1700000000, 0)
+		end   = time.Unix(1800000000, 0)
+		coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+		base  = map[string]interface{}{"address": addressAlice}
+	)
+
+	tests := []struct {
+		name     string
+		vesting  cosmosgenesis.VestingAccount
+		expected map[string]interface{}
+	}{
+		{
+			name: "continuous",
+			vesting: cosmosgenesis.VestingAccount{
+				Type:            cosmosgenesis.VestingContinuous,
+				OriginalVesting: coins,
+				StartTime:       start,
+				EndTime:         end,
+			},
+			expected: map[string]interface{}{
+				"@type": "/cosmos.vesting.v1beta1.ContinuousVestingAccount",
+				"base_vesting_account": map[string]interface{}{
+					"base_account":      base,
+					"original_vesting":  []interface{}{map[string]interface{}{"denom": "stake", "amount": "100"}},
+					"delegated_free":    []interface{}{},
+					"delegated_vesting": []interface{}{},
+					"end_time":          "1800000000",
+				},
+				"start_time": "1700000000",
+			},
+		},
+		{
+			name: "delayed",
+			vesting: cosmosgenesis.VestingAccount{
+				Type:            cosmosgenesis.VestingDelayed,
+				OriginalVesting: coins,
+				EndTime:         end,
+			},
+			expected: map[string]interface{}{
+				"@type": "/cosmos.vesting.v1beta1.DelayedVestingAccount",
+				"base_vesting_account": map[string]interface{}{
+					"base_account":      base,
+					"original_vesting":  []interface{}{map[string]interface{}{"denom": "stake", "amount": "100"}},
+					"delegated_free":    []interface{}{},
+					"delegated_vesting": []interface{}{},
+					"end_time":          "1800000000",
+				},
+			},
+		},
+		{
+			name: "periodic",
+			vesting: cosmosgenesis.VestingAccount{
+				Type:            cosmosgenesis.VestingPeriodic,
+				OriginalVesting: coins,
+				StartTime:       start,
+				Periods: []cosmosgenesis.VestingPeriod{
+					{Length: time.Hour, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+					{Length: 2 * time.Hour, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+				},
+			},
+			expected: map[string]interface{}{
+				"@type": "/cosmos.vesting.v1beta1.PeriodicVestingAccount",
+				"base_vesting_account": map[string]interface{}{
+					"base_account":      base,
+					"original_vesting":  []interface{}{map[string]interface{}{"denom": "stake", "amount": "100"}},
+					"delegated_free":    []interface{}{},
+					"delegated_vesting": []interface{}{},
+					"end_time":          "1700010800",
+				},
+				"start_time": "1700000000",
+				"vesting_periods": []interface{}{
+					map[string]interface{}{"length": "3600", "amount": []interface{}{map[string]interface{}{"denom": "stake", "amount": "40"}}},
+					map[string]interface{}{"length": "7200", "amount": []interface{}{map[string]interface{}{"denom": "stake", "amount": "60"}}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := cosmosgenesis.Parse([]byte(accountsGenesis))
+			require.NoError(t, err)
+
+			require.NoError(t, g.SetVestingAccount(addressAlice, tt.vesting))
+
+			auth, _ := g.Module(cosmosgenesis.AuthModule)
+			accounts := auth.(map[string]interface{})["accounts"].([]interface{})
+			require.Equal(t, tt.expected, accounts[0])
+
+			// a vesting account is not a base account anymore
+			require.ErrorContains(t, g.SetVestingAccount(addressAlice, tt.vesting), "is not a base account")
+		})
+	}
+
+	g, err := cosmosgenesis.Parse([]byte(accountsGenesis))
+	require.NoError(t, err)
+	err = g.SetVestingAccount(addressBob, cosmosgenesis.VestingAccount{Type: cosmosgenesis.VestingDelayed})
+	require.ErrorContains(t, err, "account "+addressBob+" not found in the genesis")
+}
+
+func TestGenesisSetModuleAccount(t *testing.T) {
+	g, err := cosmosgenesis.Parse([]byte(accountsGenesis))
+	require.NoError(t, err)
+
+	require.NoError(t, g.SetModuleAccount(addressAlice, "treasury", []string{"minter", "burner"}))
+
+	auth, _ := g.Module(cosmosgenesis.AuthModule)
+	accounts := auth.(map[string]interface{})["accounts"].([]interface{})
+	require.Equal(t, map[string]interface{}{
+		"@type": "/cosmos.auth.v1beta1.ModuleAccount",
+		"base_account": map[string]interface{}{
+			"address": addressAlice,
+		},
+		"name":        "treasury",
+		"permissions": []interface{}{"minter", "burner"},
+	}, accounts[0])
+}
@@ -48,6 +48,8 @@ const (
 	// CommandTest represents go "test" command.
 	CommandTest = "test"
 
+	// EnvCGOEnabled represents CGO_ENABLED variable.
+	EnvCGOEnabled = "CGO_ENABLED"
 	// EnvGOARCH represents GOARCH variable.
 	EnvGOARCH = "GOARCH"
 	// EnvGOMOD represents GOMOD variable.
@@ -69,6 +71,8 @@ const (
 	FlagModValueReadOnly = "readonly"
 	// FlagOut represents out go flag.
 	FlagOut = "-o"
+	// FlagTrimpath represents trimpath go flag.
+	FlagTrimpath = "-trimpath"
 )
 
 // Env returns the value of `go env name`.
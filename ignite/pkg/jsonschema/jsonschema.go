@@ -0,0 +1,132 @@
+// Package jsonschema generates JSON Schemas from the YAML tags of Go types.
+package jsonschema
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Draft is the JSON Schema version of the generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSON Schema types.
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+)
+
+// Schema is a JSON Schema or a sub-schema.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	ID          string `json:"$id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+
+	// Properties are the properties of an object.
+	Properties map[string]*Schema `json:"properties,omitempty"`
+
+	// AdditionalProperties is either a *Schema or false when an object doesn't allow other properties.
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+
+	// Items is the schema of the array items.
+	Items *Schema `json:"items,omitempty"`
+
+	// Minimum is the minimum of a number.
+	Minimum *int `json:"minimum,omitempty"`
+}
+
+// Reflect returns the schema of the type of v. The structs properties are named
+// after the yaml tags of their fields and described by their doc tags. Structs
+// don't allow other properties than their fields.
+func Reflect(v interface{}) *Schema {
+	return reflectType(reflect.TypeOf(v))
+}
+
+func reflectType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		// structs with a custom unmarshaler can be decoded from any value.
+		if _, ok := reflect.PointerTo(t).MethodByName("UnmarshalYAML"); ok {
+			return &Schema{}
+		}
+		s := &Schema{
+			Type:                 TypeObject,
+			Properties:           make(map[string]*Schema),
+			AdditionalProperties: false,
+		}
+		addProperties(s, t)
+		return s
+	case reflect.Map:
+		s := &Schema{Type: TypeObject}
+		if elem := reflectType(t.Elem()); elem.Type != "" {
+			s.AdditionalProperties = elem
+		}
+		return s
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: TypeArray, Items: reflectType(t.Elem())}
+	case reflect.String:
+		return &Schema{Type: TypeString}
+	case reflect.Bool:
+		return &Schema{Type: TypeBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: TypeInteger}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		minimum := 0
+		return &Schema{Type: TypeInteger, Minimum: &minimum}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: TypeNumber}
+	default:
+		// interfaces and other types accept any value.
+		return &Schema{}
+	}
+}
+
+// addProperties adds the struct fields to the object properties.
+func addProperties(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+
+		tags := strings.Split(field.Tag.Get("yaml"), ",")
+		name := tags[0]
+		if name == "-" {
+			continue
+		}
+
+		inline := false
+		for _, tag := range tags[1:] {
+			inline = inline || tag == "inline"
+		}
+		if inline {
+			ft := field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addProperties(s, ft)
+			} else {
+				// inline maps allow any property.
+				s.AdditionalProperties = nil
+			}
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		property := reflectType(field.Type)
+		property.Description = field.Tag.Get("doc")
+		s.Properties[name] = property
+	}
+}
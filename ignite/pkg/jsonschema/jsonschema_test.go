@@ -0,0 +1,70 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/jsonschema"
+)
+
+type (
+	base struct {
+		Version uint `yaml:"version" doc:"Config version."`
+	}
+
+	account struct {
+		Name  string   `yaml:"name" doc:"Account name."`
+		Coins []string `yaml:"coins,omitempty" doc:"Account coins."`
+	}
+
+	config struct {
+		base `yaml:",inline"`
+
+		Accounts []account              `yaml:"accounts" doc:"Accounts."`
+		Genesis  map[string]interface{} `yaml:"genesis" doc:"Genesis."`
+		Ports    map[string]int         `yaml:"ports" doc:"Ports."`
+		Faucet   *account               `yaml:"faucet" doc:"Faucet."`
+		Ignored  string                 `yaml:"-"`
+		Enabled  bool
+	}
+)
+
+func TestReflect(t *testing.T) {
+	schema := jsonschema.Reflect(config{})
+
+	got, err := json.Marshal(schema)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"version": {"type": "integer", "minimum": 0, "description": "Config version."},
+			"accounts": {
+				"type": "array",
+				"description": "Accounts.",
+				"items": {
+					"type": "object",
+					"additionalProperties": false,
+					"properties": {
+						"name": {"type": "string", "description": "Account name."},
+						"coins": {"type": "array", "description": "Account coins.", "items": {"type": "string"}}
+					}
+				}
+			},
+			"genesis": {"type": "object", "description": "Genesis."},
+			"ports": {"type": "object", "description": "Ports.", "additionalProperties": {"type": "integer"}},
+			"faucet": {
+				"type": "object",
+				"description": "Faucet.",
+				"additionalProperties": false,
+				"properties": {
+					"name": {"type": "string", "description": "Account name."},
+					"coins": {"type": "array", "description": "Account coins.", "items": {"type": "string"}}
+				}
+			},
+			"enabled": {"type": "boolean"}
+		}
+	}`, string(got))
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/gobwas/glob"
 	wt "github.com/radovskyb/watcher"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
@@ -16,11 +18,17 @@ import (
 type watcher struct {
 	wt            *wt.Watcher
 	workdir       string
+	paths         []string
 	ignoreHidden  bool
 	ignoreFolders bool
 	ignoreExts    []string
-	onChange      func()
+	include       []string
+	exclude       []string
+	includeGlobs  []glob.Glob
+	excludeGlobs  []glob.Glob
+	onChange      func(paths []string)
 	interval      time.Duration
+	debounce      time.Duration
 	ctx           context.Context
 	done          *sync.WaitGroup
 }
@@ -37,11 +45,27 @@ func WatcherWorkdir(path string) WatcherOption {
 
 // WatcherOnChange sets a hook that executed on every change on filesystem.
 func WatcherOnChange(hook func()) WatcherOption {
+	return func(w *watcher) {
+		w.onChange = func([]string) { hook() }
+	}
+}
+
+// WatcherOnChangeFiles sets a hook that executed on every change on filesystem
+// with the changed paths, relative to the workdir.
+func WatcherOnChangeFiles(hook func(paths []string)) WatcherOption {
 	return func(w *watcher) {
 		w.onChange = hook
 	}
 }
 
+// WatcherDebounce waits for the filesystem to stop changing during d before
+// executing the change hook once with all the changed paths.
+func WatcherDebounce(d time.Duration) WatcherOption {
+	return func(w *watcher) {
+		w.debounce = d
+	}
+}
+
 // WatcherPollingInterval overwrites default polling interval to check filesystem changes.
 func WatcherPollingInterval(d time.Duration) WatcherOption {
 	return func(w *watcher) {
@@ -69,22 +93,55 @@ func WatcherIgnoreExt(exts ...string) WatcherOption {
 	}
 }
 
+// WatcherInclude watches the files matching the glob patterns in addition to the
+// watched paths. Patterns are relative to the workdir and use "/" as separator,
+// "*" doesn't match the separator while "**" does.
+func WatcherInclude(patterns ...string) WatcherOption {
+	return func(w *watcher) {
+		w.include = append(w.include, patterns...)
+	}
+}
+
+// WatcherExclude ignores the files matching the glob patterns.
+// Patterns follow the same rules as WatcherInclude.
+func WatcherExclude(patterns ...string) WatcherOption {
+	return func(w *watcher) {
+		w.exclude = append(w.exclude, patterns...)
+	}
+}
+
 // Watch starts watching changes on the paths. options are used to configure the
 // behaviour of watch operation.
 func Watch(ctx context.Context, paths []string, options ...WatcherOption) error {
 	w := &watcher{
 		wt:       wt.New(),
-		onChange: func() {},
+		onChange: func([]string) {},
 		interval: time.Millisecond * 300,
 		done:     &sync.WaitGroup{},
 		ctx:      ctx,
 	}
-	w.wt.SetMaxEvents(1)
 
 	for _, o := range options {
 		o(w)
 	}
 
+	// all the changes are needed when they are debounced,
+	// otherwise the hook is executed once per polling cycle.
+	if w.debounce == 0 {
+		w.wt.SetMaxEvents(1)
+	}
+
+	var err error
+	if w.includeGlobs, err = compileGlobs(w.include); err != nil {
+		return err
+	}
+	if w.excludeGlobs, err = compileGlobs(w.exclude); err != nil {
+		return err
+	}
+	for _, path := range paths {
+		w.paths = append(w.paths, w.absPath(path))
+	}
+
 	w.wt.AddFilterHook(func(info os.FileInfo, fullPath string) error {
 		if info.IsDir() && w.ignoreFolders {
 			return wt.ErrSkip
@@ -99,10 +156,27 @@ func Watch(ctx context.Context, paths []string, options ...WatcherOption) error
 	// ignore hidden paths.
 	w.wt.IgnoreHiddenFiles(w.ignoreHidden)
 
-	// add paths to watch
+	// add paths to watch, including the static part of the included patterns
 	if err := w.addPaths(paths...); err != nil {
 		return err
 	}
+	for _, pattern := range w.include {
+		base, recursive := globBase(pattern)
+		if w.isWatchedPath(w.absPath(base)) {
+			continue
+		}
+		if !recursive {
+			// the pattern only matches the files of its base directory, the
+			// subdirectories are not walked on every polling cycle.
+			if err := w.addDir(base); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := w.addPaths(base); err != nil {
+			return err
+		}
+	}
 
 	// start watching.
 	w.done.Add(1)
@@ -116,10 +190,27 @@ func Watch(ctx context.Context, paths []string, options ...WatcherOption) error
 
 func (w *watcher) listen() {
 	defer w.done.Done()
+
+	var (
+		changed  []string
+		debounce <-chan time.Time
+	)
 	for {
 		select {
-		case <-w.wt.Event:
-			w.onChange()
+		case e := <-w.wt.Event:
+			if path := w.relPath(e.Path); !slices.Contains(changed, path) {
+				changed = append(changed, path)
+			}
+			if w.debounce == 0 {
+				w.onChange(changed)
+				changed = nil
+				continue
+			}
+			debounce = time.After(w.debounce)
+		case <-debounce:
+			w.onChange(changed)
+			changed = nil
+			debounce = nil
 		case <-w.wt.Closed:
 			return
 		case <-w.ctx.Done():
@@ -130,9 +221,7 @@ func (w *watcher) listen() {
 
 func (w *watcher) addPaths(paths ...string) error {
 	for _, path := range paths {
-		if !filepath.IsAbs(path) {
-			path = filepath.Join(w.workdir, path)
-		}
+		path = w.absPath(path)
 
 		// Ignoring paths that don't exist
 		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
@@ -147,11 +236,103 @@ func (w *watcher) addPaths(paths ...string) error {
 	return nil
 }
 
+// addDir watches the files of a directory without its subdirectories.
+func (w *watcher) addDir(path string) error {
+	path = w.absPath(path)
+
+	// Ignoring paths that don't exist
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return w.wt.Add(path)
+}
+
+// isWatchedPath checks if path is one of the watched paths or inside one of them.
+func (w *watcher) isWatchedPath(path string) bool {
+	for _, p := range w.paths {
+		if path == p || strings.HasPrefix(path, p+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (w *watcher) isFileIgnored(path string) bool {
 	for _, ext := range w.ignoreExts {
 		if strings.HasSuffix(path, ext) {
 			return true
 		}
 	}
+
+	rel := w.relPath(path)
+	if matchGlobs(w.excludeGlobs, rel) {
+		return true
+	}
+
+	// files added only by the included patterns must match them
+	if w.isWatchedPath(path) {
+		return false
+	}
+	return !matchGlobs(w.includeGlobs, rel)
+}
+
+func (w *watcher) absPath(path string) string {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(w.workdir, path)
+	}
+	return filepath.Clean(path)
+}
+
+// relPath returns the slash separated path relative to the workdir.
+func (w *watcher) relPath(path string) string {
+	if rel, err := filepath.Rel(w.absPath("."), path); err == nil {
+		path = rel
+	}
+	return filepath.ToSlash(path)
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid glob pattern %q", pattern)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+func matchGlobs(globs []glob.Glob, path string) bool {
+	for _, g := range globs {
+		if g.Match(path) {
+			return true
+		}
+	}
 	return false
 }
+
+// globBase returns the leading directories of a glob pattern that don't contain
+// any special character, which is the directory to watch to find the matching files.
+// recursive is false when the pattern only matches the files of this directory.
+func globBase(pattern string) (base string, recursive bool) {
+	var (
+		dirs  []string
+		parts = strings.Split(pattern, "/")
+		rest  = parts
+	)
+	for i, part := range parts[:len(parts)-1] {
+		if strings.ContainsAny(part, `*?[]{}\!`) {
+			break
+		}
+		dirs = append(dirs, part)
+		rest = parts[i+1:]
+	}
+
+	recursive = len(rest) > 1 || strings.Contains(rest[0], "**")
+	if len(dirs) == 0 {
+		return ".", recursive
+	}
+	return filepath.FromSlash(strings.Join(dirs, "/")), recursive
+}
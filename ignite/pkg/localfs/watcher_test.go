@@ -0,0 +1,114 @@
+package localfs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobBase(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		want      string
+		recursive bool
+	}{
+		{pattern: "docs/**/*.md", want: "docs", recursive: true},
+		{pattern: "docs/static/openapi.yml", want: filepath.Join("docs", "static")},
+		{pattern: "*.md", want: "."},
+		{pattern: "**/*.go", want: ".", recursive: true},
+		{pattern: "docs/**", want: "docs", recursive: true},
+		{pattern: "web/{src,public}/*", want: "web", recursive: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			base, recursive := globBase(tt.pattern)
+			require.Equal(t, tt.want, base)
+			require.Equal(t, tt.recursive, recursive)
+		})
+	}
+}
+
+func TestWatcherIsFileIgnored(t *testing.T) {
+	workdir := t.TempDir()
+	w := &watcher{
+		workdir:    workdir,
+		paths:      []string{filepath.Join(workdir, "x")},
+		ignoreExts: []string{"pb.go"},
+	}
+	var err error
+	w.includeGlobs, err = compileGlobs([]string{"docs/**/*.md"})
+	require.NoError(t, err)
+	w.excludeGlobs, err = compileGlobs([]string{"x/**/mocks/*"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "x/mars/keeper/keeper.go", want: false},
+		{path: "x/mars/types/tx.pb.go", want: true},
+		{path: "x/mars/testutil/mocks/bank.go", want: true},
+		{path: "docs/guide/intro.md", want: false},
+		{path: "docs/static/openapi.yml", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			require.Equal(t, tt.want, w.isFileIgnored(filepath.Join(workdir, tt.path)))
+		})
+	}
+}
+
+func TestWatchDebounce(t *testing.T) {
+	workdir := setupGlobTests(t, []string{"x/a.go", "x/b.go", "x/c.txt", "docs/intro.md"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		changes [][]string
+	)
+	done := make(chan error)
+	go func() {
+		done <- Watch(
+			ctx,
+			[]string{"x"},
+			WatcherWorkdir(workdir),
+			WatcherPollingInterval(10*time.Millisecond),
+			WatcherDebounce(200*time.Millisecond),
+			WatcherInclude("docs/*.md"),
+			WatcherExclude("**/*.txt"),
+			WatcherOnChangeFiles(func(paths []string) {
+				mu.Lock()
+				defer mu.Unlock()
+				changes = append(changes, paths)
+			}),
+		)
+	}()
+
+	// wait for the watcher to list the files
+	time.Sleep(100 * time.Millisecond)
+	for _, file := range []string{"x/a.go", "x/b.go", "x/c.txt", "docs/intro.md"} {
+		require.NoError(t, os.WriteFile(filepath.Join(workdir, file), []byte("changed"), 0o644))
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(changes) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, changes, 1)
+	require.ElementsMatch(t, []string{"x/a.go", "x/b.go", "docs/intro.md"}, changes[0])
+}
@@ -0,0 +1,239 @@
+// Package minisign signs and verifies files with ed25519 keys using the minisign format,
+// so the signatures can be verified with the minisign and rsign tools.
+// See https://jedisct1.github.io/minisign for the format specification.
+package minisign
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	untrustedCommentPrefix = "untrusted comment: "
+	trustedCommentPrefix   = "trusted comment: "
+
+	// secretKeySize is the size of a decoded minisign secret key:
+	// algorithms (6), KDF salt and limits (48), key ID (8), secret key (64) and checksum (32).
+	secretKeySize = 158
+)
+
+var (
+	// algorithmEd25519 identifies ed25519 keys.
+	algorithmEd25519 = []byte("Ed")
+
+	// algorithmHashedEd25519 identifies signatures of the BLAKE2b-512 hash of the signed message.
+	algorithmHashedEd25519 = []byte("ED")
+
+	// algorithmBlake2b identifies BLAKE2b-256 secret key checksums.
+	algorithmBlake2b = []byte("B2")
+
+	// ErrEncryptedKey is returned when a minisign secret key is encrypted with a password.
+	ErrEncryptedKey = errors.New("encrypted minisign secret keys are not supported, create the key with \"minisign -G -W\"")
+
+	// ErrInvalidSignature is returned when a signature doesn't match the message or the public key.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+type (
+	// PrivateKey is an ed25519 private key identified by a key ID.
+	PrivateKey struct {
+		ID  [8]byte
+		Key ed25519.PrivateKey
+	}
+
+	// PublicKey is an ed25519 public key identified by a key ID.
+	PublicKey struct {
+		ID  [8]byte
+		Key ed25519.PublicKey
+	}
+)
+
+// ParsePrivateKey parses an unencrypted minisign secret key or an ed25519 private key
+// encoded in PKCS #8 PEM format, like the ones created by "openssl genpkey -algorithm ed25519".
+// The ID of PEM keys is derived from their public key.
+func ParsePrivateKey(data []byte) (PrivateKey, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		return parsePEMPrivateKey(block)
+	}
+
+	raw, err := decodeKeyFile(data)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	if len(raw) != secretKeySize {
+		return PrivateKey{}, errors.New("invalid minisign secret key size")
+	}
+
+	var (
+		algorithm = raw[0:2]
+		kdf       = raw[2:4]
+		checksum  = raw[4:6]
+		keyID     = raw[54:62]
+		key       = raw[62:126]
+		keySum    = raw[126:158]
+	)
+	if !bytes.Equal(algorithm, algorithmEd25519) || !bytes.Equal(checksum, algorithmBlake2b) {
+		return PrivateKey{}, errors.New("unsupported minisign secret key algorithm")
+	}
+	if !bytes.Equal(kdf, []byte{0, 0}) {
+		return PrivateKey{}, ErrEncryptedKey
+	}
+
+	h, err := blake2b.New256(nil)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	h.Write(algorithm)
+	h.Write(keyID)
+	h.Write(key)
+	if !bytes.Equal(h.Sum(nil), keySum) {
+		return PrivateKey{}, errors.New("invalid minisign secret key checksum")
+	}
+
+	k := PrivateKey{Key: ed25519.PrivateKey(bytes.Clone(key))}
+	copy(k.ID[:], keyID)
+	return k, nil
+}
+
+// ParsePublicKey parses a minisign public key file.
+func ParsePublicKey(data []byte) (PublicKey, error) {
+	raw, err := decodeKeyFile(data)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	if len(raw) != 2+8+ed25519.PublicKeySize || !bytes.Equal(raw[:2], algorithmEd25519) {
+		return PublicKey{}, errors.New("invalid minisign public key")
+	}
+
+	p := PublicKey{Key: ed25519.PublicKey(bytes.Clone(raw[10:]))}
+	copy(p.ID[:], raw[2:10])
+	return p, nil
+}
+
+// PublicKey returns the public key of the private key.
+func (k PrivateKey) PublicKey() PublicKey {
+	return PublicKey{
+		ID:  k.ID,
+		Key: k.Key.Public().(ed25519.PublicKey),
+	}
+}
+
+// Sign returns the minisign signature file of the message. The trusted comment is
+// signed with the message, minisign uses "timestamp:<unix time>\tfile:<file name>".
+// Signatures are deterministic, signing the same message with the same comment
+// always results in the same signature.
+func (k PrivateKey) Sign(message []byte, trustedComment string) []byte {
+	hash := blake2b.Sum512(message)
+	signature := ed25519.Sign(k.Key, hash[:])
+	globalSignature := ed25519.Sign(k.Key, append(bytes.Clone(signature), trustedComment...))
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%ssignature from minisign secret key %s\n", untrustedCommentPrefix, formatKeyID(k.ID))
+	b.WriteString(base64.StdEncoding.EncodeToString(concat(algorithmHashedEd25519, k.ID[:], signature)))
+	fmt.Fprintf(&b, "\n%s%s\n", trustedCommentPrefix, trustedComment)
+	b.WriteString(base64.StdEncoding.EncodeToString(globalSignature))
+	b.WriteString("\n")
+	return b.Bytes()
+}
+
+// Encode returns the minisign public key file of the key.
+func (p PublicKey) Encode() []byte {
+	return fmt.Appendf(
+		nil,
+		"%sminisign public key %s\n%s\n",
+		untrustedCommentPrefix,
+		formatKeyID(p.ID),
+		base64.StdEncoding.EncodeToString(concat(algorithmEd25519, p.ID[:], p.Key)),
+	)
+}
+
+// Verify verifies the minisign signature file of the message and returns its trusted comment.
+func (p PublicKey) Verify(message, signatureFile []byte) (trustedComment string, err error) {
+	lines := strings.Split(strings.TrimSpace(string(signatureFile)), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[2], trustedCommentPrefix) {
+		return "", errors.New("invalid minisign signature file")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[1]))
+	if err != nil || len(raw) != 2+8+ed25519.SignatureSize {
+		return "", errors.New("invalid minisign signature")
+	}
+	globalSignature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil {
+		return "", errors.New("invalid minisign global signature")
+	}
+
+	algorithm, keyID, signature := raw[:2], raw[2:10], raw[10:]
+	if !bytes.Equal(keyID, p.ID[:]) {
+		return "", errors.Errorf("%w: signed with key %X", ErrInvalidSignature, keyID)
+	}
+
+	switch {
+	case bytes.Equal(algorithm, algorithmHashedEd25519):
+		hash := blake2b.Sum512(message)
+		message = hash[:]
+	case !bytes.Equal(algorithm, algorithmEd25519):
+		return "", errors.New("unsupported minisign signature algorithm")
+	}
+	if !ed25519.Verify(p.Key, message, signature) {
+		return "", ErrInvalidSignature
+	}
+
+	trustedComment = strings.TrimPrefix(lines[2], trustedCommentPrefix)
+	if !ed25519.Verify(p.Key, append(bytes.Clone(signature), trustedComment...), globalSignature) {
+		return "", errors.Errorf("%w: invalid trusted comment", ErrInvalidSignature)
+	}
+	return trustedComment, nil
+}
+
+func parsePEMPrivateKey(block *pem.Block) (PrivateKey, error) {
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return PrivateKey{}, errors.Wrap(err, "invalid PEM private key")
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return PrivateKey{}, errors.Errorf("unsupported %T private key, an ed25519 key is required", key)
+	}
+
+	k := PrivateKey{Key: edKey}
+	id := blake2b.Sum256(edKey.Public().(ed25519.PublicKey))
+	copy(k.ID[:], id[:])
+	return k, nil
+}
+
+// decodeKeyFile decodes the base64 key of a minisign key file, after its untrusted comment.
+func decodeKeyFile(data []byte) ([]byte, error) {
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) == 2 && strings.HasPrefix(lines[0], untrustedCommentPrefix) {
+		lines = lines[1:]
+	}
+	if len(lines) != 1 {
+		return nil, errors.New("invalid minisign key file")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[0]))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid minisign key encoding")
+	}
+	return raw, nil
+}
+
+// formatKeyID formats a key ID like minisign, which reads it as a little endian integer.
+func formatKeyID(id [8]byte) string {
+	return fmt.Sprintf("%016X", binary.LittleEndian.Uint64(id[:]))
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
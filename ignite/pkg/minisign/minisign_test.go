@@ -0,0 +1,120 @@
+package minisign_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/ignite/cli/v29/ignite/pkg/minisign"
+)
+
+var keyID = []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+// minisignSecretKey returns a minisign secret key file, encrypted keys have random KDF parameters.
+func minisignSecretKey(t *testing.T, key ed25519.PrivateKey, kdf string) []byte {
+	t.Helper()
+
+	h, err := blake2b.New256(nil)
+	require.NoError(t, err)
+	h.Write([]byte("Ed"))
+	h.Write(keyID)
+	h.Write(key)
+
+	var raw bytes.Buffer
+	raw.WriteString("Ed")
+	raw.WriteString(kdf)
+	raw.WriteString("B2")
+	raw.Write(make([]byte, 48))
+	raw.Write(keyID)
+	raw.Write(key)
+	raw.Write(h.Sum(nil))
+
+	return []byte("untrusted comment: minisign encrypted secret key\n" + base64.StdEncoding.EncodeToString(raw.Bytes()) + "\n")
+}
+
+func pemPrivateKey(t *testing.T, key ed25519.PrivateKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestSignAndVerify(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		keyFile []byte
+		keyID   []byte
+	}{
+		{
+			name:    "minisign key",
+			keyFile: minisignSecretKey(t, key, "\x00\x00"),
+			keyID:   keyID,
+		},
+		{
+			name:    "PEM key",
+			keyFile: pemPrivateKey(t, key),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := minisign.ParsePrivateKey(tt.keyFile)
+			require.NoError(t, err)
+			require.Equal(t, key, k.Key)
+			if tt.keyID != nil {
+				require.Equal(t, tt.keyID, k.ID[:])
+			}
+
+			publicKeyFile := k.PublicKey().Encode()
+			require.True(t, strings.HasPrefix(string(publicKeyFile), "untrusted comment: minisign public key "))
+			pub, err := minisign.ParsePublicKey(publicKeyFile)
+			require.NoError(t, err)
+			require.Equal(t, k.PublicKey(), pub)
+
+			message := []byte("3a1f  mars_linux_amd64.tar.gz\n")
+			comment := "timestamp:1704164645\tfile:release_checksum"
+			signature := k.Sign(message, comment)
+			require.Equal(t, signature, k.Sign(message, comment), "signatures must be deterministic")
+			require.Len(t, strings.Split(strings.TrimSpace(string(signature)), "\n"), 4)
+
+			gotComment, err := pub.Verify(message, signature)
+			require.NoError(t, err)
+			require.Equal(t, comment, gotComment)
+
+			_, err = pub.Verify([]byte("tampered"), signature)
+			require.ErrorIs(t, err, minisign.ErrInvalidSignature)
+
+			tampered := bytes.Replace(signature, []byte("timestamp:1704164645"), []byte("timestamp:1704164646"), 1)
+			_, err = pub.Verify(message, tampered)
+			require.ErrorIs(t, err, minisign.ErrInvalidSignature)
+		})
+	}
+}
+
+func TestParsePrivateKeyErrors(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	_, err = minisign.ParsePrivateKey(minisignSecretKey(t, key, "Sc"))
+	require.ErrorIs(t, err, minisign.ErrEncryptedKey)
+
+	corrupted := minisignSecretKey(t, key, "\x00\x00")
+	raw, err := base64.StdEncoding.DecodeString(strings.Split(string(corrupted), "\n")[1])
+	require.NoError(t, err)
+	raw[len(raw)-1] ^= 0xff
+	_, err = minisign.ParsePrivateKey([]byte(base64.StdEncoding.EncodeToString(raw)))
+	require.ErrorContains(t, err, "invalid minisign secret key checksum")
+
+	_, err = minisign.ParsePrivateKey([]byte("not a key"))
+	require.Error(t, err)
+}
@@ -0,0 +1,310 @@
+// Package ociimage builds Linux container images from files, without a container
+// runtime, and writes them in OCI image layout archives.
+// See https://github.com/opencontainers/image-spec/blob/main/image-layout.md.
+package ociimage
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	xtarball "github.com/ignite/cli/v29/ignite/pkg/tarball"
+)
+
+const (
+	// OS is the operating system of the images.
+	OS = "linux"
+
+	// refNameAnnotation is the annotation of the image name in an image layout.
+	refNameAnnotation = "org.opencontainers.image.ref.name"
+)
+
+type (
+	// File is a file or a directory of an image layer.
+	File struct {
+		// Path is the absolute path of the file in the image.
+		Path string
+
+		// Content is the content of a regular file.
+		Content []byte
+
+		// Mode is the permission bits of the file.
+		Mode int64
+
+		// Dir is true for directories.
+		Dir bool
+
+		// UID and GID are the owner of the file.
+		UID, GID int
+	}
+
+	// Layer is a filesystem layer of an image.
+	// The missing parent directories of the files are added to the layer, with the
+	// mode and the owner of the directory in the lower layers when it exists.
+	Layer []File
+
+	// Image describes a Linux container image.
+	Image struct {
+		// Architecture is the CPU architecture of the image, using the GOARCH values.
+		Architecture string
+
+		// Layers are the filesystem layers of the image, from the bottom one.
+		Layers []Layer
+
+		// Entrypoint, Cmd, Env, User and WorkingDir configure the image containers.
+		Entrypoint []string
+		Cmd        []string
+		Env        []string
+		User       string
+		WorkingDir string
+
+		// ExposedPorts lists the ports exposed by the containers, e.g. "26657/tcp".
+		ExposedPorts []string
+
+		// Labels are the image labels.
+		Labels map[string]string
+
+		// Created is the creation time of the image and of its files.
+		Created time.Time
+	}
+)
+
+// DirFiles returns the files of a directory of the local filesystem to copy
+// them to the dst path of an image with the given owner.
+func DirFiles(dir, dst string, uid, gid int) ([]File, error) {
+	var files []File
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		f := File{
+			Path: path.Join(dst, filepath.ToSlash(rel)),
+			Mode: int64(info.Mode().Perm()),
+			Dir:  d.IsDir(),
+			UID:  uid,
+			GID:  gid,
+		}
+		switch {
+		case d.IsDir():
+		case info.Mode().IsRegular():
+			if f.Content, err = os.ReadFile(p); err != nil {
+				return err
+			}
+		default:
+			return errors.Errorf("%s is not a regular file", p)
+		}
+		files = append(files, f)
+		return nil
+	})
+	return files, err
+}
+
+// WriteArchive writes the images, one per architecture, in an OCI image layout tar archive
+// where they are named ref. A single image is added to the layout as is, many images are
+// added with an image index. The archive can be loaded with "docker load" or "podman load"
+// and pushed with tools like skopeo or crane.
+func WriteArchive(w io.Writer, ref string, images ...Image) error {
+	if len(images) == 0 {
+		return errors.New("no image to write")
+	}
+	if _, err := name.ParseReference(ref); err != nil {
+		return errors.Wrapf(err, "invalid image name %s", ref)
+	}
+
+	dir, err := os.MkdirTemp("", "ociimage")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	p, err := layout.Write(dir, empty.Index)
+	if err != nil {
+		return err
+	}
+	annotations := layout.WithAnnotations(map[string]string{refNameAnnotation: ref})
+
+	addenda := make([]mutate.IndexAddendum, 0, len(images))
+	for _, image := range images {
+		img, err := image.build()
+		if err != nil {
+			return err
+		}
+		addenda = append(addenda, mutate.IndexAddendum{
+			Add: img,
+			Descriptor: v1.Descriptor{
+				Platform: &v1.Platform{OS: OS, Architecture: image.Architecture},
+			},
+		})
+	}
+
+	if len(addenda) == 1 {
+		platform := layout.WithPlatform(*addenda[0].Descriptor.Platform)
+		if err := p.AppendImage(addenda[0].Add.(v1.Image), annotations, platform); err != nil {
+			return err
+		}
+	} else {
+		index := mutate.AppendManifests(mutate.IndexMediaType(empty.Index, types.OCIImageIndex), addenda...)
+		if err := p.AppendIndex(index, annotations); err != nil {
+			return err
+		}
+	}
+
+	return xtarball.Write(w, dir, created(images[0].Created))
+}
+
+// build returns the image with its layers.
+func (image Image) build() (v1.Image, error) {
+	img := mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	img = mutate.ConfigMediaType(img, types.OCIConfigJSON)
+
+	cf, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	cf = cf.DeepCopy()
+	cf.OS = OS
+	cf.Architecture = image.Architecture
+	cf.Created = v1.Time{Time: created(image.Created)}
+	cf.Config = v1.Config{
+		Entrypoint: image.Entrypoint,
+		Cmd:        image.Cmd,
+		Env:        image.Env,
+		User:       image.User,
+		WorkingDir: image.WorkingDir,
+		Labels:     image.Labels,
+	}
+	if len(image.ExposedPorts) > 0 {
+		cf.Config.ExposedPorts = make(map[string]struct{})
+		for _, port := range image.ExposedPorts {
+			cf.Config.ExposedPorts[port] = struct{}{}
+		}
+	}
+
+	if img, err = mutate.ConfigFile(img, cf); err != nil {
+		return nil, err
+	}
+
+	// dirs are the directories of the lower layers.
+	dirs := make(map[string]File)
+	for _, l := range image.Layers {
+		data, err := l.tar(created(image.Created), dirs)
+		if err != nil {
+			return nil, err
+		}
+		layer, err := tarball.LayerFromOpener(
+			func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil },
+			tarball.WithMediaType(types.OCILayer),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if img, err = mutate.Append(img, mutate.Addendum{
+			Layer:   layer,
+			History: v1.History{Created: cf.Created},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return img, nil
+}
+
+// tar returns the layer files in a tarball sorted by path with their parent directories.
+// The missing parent directories keep the attributes they have in dirs, the directories
+// of the lower layers, and the directories of the layer are added to dirs.
+func (l Layer) tar(modTime time.Time, dirs map[string]File) ([]byte, error) {
+	files := make(map[string]File)
+	for _, f := range l {
+		p := path.Clean(f.Path)
+		if !path.IsAbs(p) || p == "/" {
+			return nil, errors.Errorf("invalid image file path %q", f.Path)
+		}
+		f.Path = p
+		files[p] = f
+
+		for dir := path.Dir(p); dir != "/"; dir = path.Dir(dir) {
+			if _, ok := files[dir]; ok {
+				continue
+			}
+			if lower, ok := dirs[dir]; ok {
+				files[dir] = lower
+			} else {
+				files[dir] = File{Path: dir, Dir: true, Mode: 0o755}
+			}
+		}
+	}
+	for p, f := range files {
+		if f.Dir {
+			dirs[p] = f
+		}
+	}
+
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, p := range paths {
+		f := files[p]
+		header := &tar.Header{
+			Name:    strings.TrimPrefix(p, "/"),
+			Mode:    f.Mode,
+			Uid:     f.UID,
+			Gid:     f.GID,
+			ModTime: modTime,
+		}
+		if f.Dir {
+			header.Typeflag = tar.TypeDir
+			header.Name += "/"
+		} else {
+			header.Typeflag = tar.TypeReg
+			header.Size = int64(len(f.Content))
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(f.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// created returns the creation time of the images, the Unix epoch by default.
+func created(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Unix(0, 0).UTC()
+	}
+	return t.UTC()
+}
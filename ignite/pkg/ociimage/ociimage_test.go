@@ -0,0 +1,215 @@
+package ociimage_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/ociimage"
+)
+
+func TestWriteArchive(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	newImage := func(arch string) ociimage.Image {
+		return ociimage.Image{
+			Architecture: arch,
+			Layers: []ociimage.Layer{
+				{{Path: "/etc/passwd", Content: []byte("root:x:0:0:root:/root:/sbin/nologin\n"), Mode: 0o644}},
+				{{Path: "/usr/local/bin/marsd", Content: []byte("binary-" + arch), Mode: 0o755}},
+			},
+			Entrypoint:   []string{"/usr/local/bin/marsd"},
+			Cmd:          []string{"start"},
+			ExposedPorts: []string{"26657/tcp"},
+			Created:      created,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		images []ociimage.Image
+		err    string
+	}{
+		{
+			name:   "single image",
+			images: []ociimage.Image{newImage("amd64")},
+		},
+		{
+			name:   "multi platform image",
+			images: []ociimage.Image{newImage("amd64"), newImage("arm64")},
+		},
+		{
+			name: "no image",
+			err:  "no image to write",
+		},
+		{
+			name: "invalid file path",
+			images: []ociimage.Image{{
+				Architecture: "amd64",
+				Layers:       []ociimage.Layer{{{Path: "marsd"}}},
+			}},
+			err: `invalid image file path "marsd"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := ociimage.WriteArchive(&buf, "mars:v1.0.0", tt.images...)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// The archive must be reproducible.
+			var again bytes.Buffer
+			require.NoError(t, ociimage.WriteArchive(&again, "mars:v1.0.0", tt.images...))
+			require.Equal(t, buf.Bytes(), again.Bytes())
+
+			p, err := layout.FromPath(extract(t, &buf))
+			require.NoError(t, err)
+			index, err := p.ImageIndex()
+			require.NoError(t, err)
+			manifest, err := index.IndexManifest()
+			require.NoError(t, err)
+			require.Len(t, manifest.Manifests, 1)
+			require.Equal(t, "mars:v1.0.0", manifest.Manifests[0].Annotations["org.opencontainers.image.ref.name"])
+
+			if len(tt.images) > 1 {
+				index, err = index.ImageIndex(manifest.Manifests[0].Digest)
+				require.NoError(t, err)
+				manifest, err = index.IndexManifest()
+				require.NoError(t, err)
+				require.Len(t, manifest.Manifests, len(tt.images))
+			}
+
+			for i, image := range tt.images {
+				require.Equal(t, image.Architecture, manifest.Manifests[i].Platform.Architecture)
+
+				img, err := index.Image(manifest.Manifests[i].Digest)
+				require.NoError(t, err)
+				cf, err := img.ConfigFile()
+				require.NoError(t, err)
+				require.Equal(t, "linux", cf.OS)
+				require.Equal(t, image.Architecture, cf.Architecture)
+				require.Equal(t, image.Entrypoint, cf.Config.Entrypoint)
+				require.Equal(t, image.Cmd, cf.Config.Cmd)
+				require.Contains(t, cf.Config.ExposedPorts, "26657/tcp")
+				require.True(t, created.Equal(cf.Created.Time))
+
+				layers, err := img.Layers()
+				require.NoError(t, err)
+				require.Len(t, layers, 2)
+				rc, err := layers[1].Uncompressed()
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{
+					"usr/":                "",
+					"usr/local/":          "",
+					"usr/local/bin/":      "",
+					"usr/local/bin/marsd": "binary-" + image.Architecture,
+				}, readTar(t, rc))
+			}
+		})
+	}
+}
+
+func TestWriteArchiveParentDirOwner(t *testing.T) {
+	image := ociimage.Image{
+		Architecture: "amd64",
+		Layers: []ociimage.Layer{
+			{{Path: "/home/nonroot", Mode: 0o700, Dir: true, UID: 65532, GID: 65532}},
+			{{Path: "/home/nonroot/.mars/config/genesis.json", Content: []byte("{}"), Mode: 0o600, UID: 65532, GID: 65532}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, ociimage.WriteArchive(&buf, "mars:v1.0.0", image))
+	p, err := layout.FromPath(extract(t, &buf))
+	require.NoError(t, err)
+	index, err := p.ImageIndex()
+	require.NoError(t, err)
+	manifest, err := index.IndexManifest()
+	require.NoError(t, err)
+	img, err := index.Image(manifest.Manifests[0].Digest)
+	require.NoError(t, err)
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	rc, err := layers[1].Uncompressed()
+	require.NoError(t, err)
+
+	// the implicit parent directories keep the owner and the mode of the lower layer.
+	headers := make(map[string]*tar.Header)
+	tr := tar.NewReader(rc)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		headers[header.Name] = header
+	}
+	require.Equal(t, 0, headers["home/"].Uid)
+	require.EqualValues(t, 0o755, headers["home/"].Mode)
+	require.Equal(t, 65532, headers["home/nonroot/"].Uid)
+	require.Equal(t, 65532, headers["home/nonroot/"].Gid)
+	require.EqualValues(t, 0o700, headers["home/nonroot/"].Mode)
+}
+
+func TestDirFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Chmod(dir, 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "config"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config", "genesis.json"), []byte("{}"), 0o600))
+
+	files, err := ociimage.DirFiles(dir, "/home/nonroot/.mars", 65532, 65532)
+	require.NoError(t, err)
+	require.Equal(t, []ociimage.File{
+		{Path: "/home/nonroot/.mars", Mode: 0o755, Dir: true, UID: 65532, GID: 65532},
+		{Path: "/home/nonroot/.mars/config", Mode: 0o755, Dir: true, UID: 65532, GID: 65532},
+		{Path: "/home/nonroot/.mars/config/genesis.json", Content: []byte("{}"), Mode: 0o600, UID: 65532, GID: 65532},
+	}, files)
+}
+
+func extract(t *testing.T, r io.Reader) string {
+	t.Helper()
+	dir := t.TempDir()
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return dir
+		}
+		require.NoError(t, err)
+
+		target := filepath.Join(dir, header.Name)
+		if header.Typeflag == tar.TypeDir {
+			require.NoError(t, os.MkdirAll(target, 0o755))
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(target, data, 0o644))
+	}
+}
+
+func readTar(t *testing.T, r io.Reader) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(data)
+	}
+}
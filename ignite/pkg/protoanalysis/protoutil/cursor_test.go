@@ -561,3 +561,96 @@ func TestGetNextId(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 6, NextUniqueID(m))
 }
+
+func TestRemoveMessages(t *testing.T) {
+	f, err := parseStringProto(`syntax = "proto3"
+
+	message Hello {}
+	message World {}
+	message Again {}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, RemoveMessages(f, "Hello", "Again", "DoesNotExist"))
+	require.False(t, HasMessage(f, "Hello"))
+	require.True(t, HasMessage(f, "World"))
+	require.False(t, HasMessage(f, "Again"))
+}
+
+func TestRemoveRPCs(t *testing.T) {
+	f, err := parseStringProto(`syntax = "proto3"
+
+	service Msg {
+		rpc Foo(Bar) returns (Bar) {}
+		rpc Baz(Bar) returns (Bar) {}
+	}
+	`)
+	require.NoError(t, err)
+	s, err := GetServiceByName(f, "Msg")
+	require.NoError(t, err)
+	require.Equal(t, 1, RemoveRPCs(s, "Foo", "DoesNotExist"))
+	require.Len(t, s.Elements, 1)
+	require.Equal(t, "Baz", s.Elements[0].(*proto.RPC).Name)
+}
+
+func TestRemoveFields(t *testing.T) {
+	f, err := parseStringProto(`syntax = "proto3"
+
+	message Hello {
+		string foo = 1;
+		repeated string bar = 2;
+		uint64 baz = 3;
+	}
+	`)
+	require.NoError(t, err)
+	m, err := GetMessageByName(f, "Hello")
+	require.NoError(t, err)
+	require.Equal(t, 2, RemoveFields(m, "bar", "baz"))
+	require.Len(t, m.Elements, 1)
+	require.Equal(t, "foo", m.Elements[0].(*proto.NormalField).Name)
+}
+
+func TestRemoveImports(t *testing.T) {
+	f, err := parseStringProto(`syntax = "proto3"
+
+	import "this.proto";
+	import "that.proto";
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, RemoveImports(f, "this.proto"))
+	require.False(t, HasImport(f, "this.proto"))
+	require.True(t, HasImport(f, "that.proto"))
+}
+
+func TestReserveFields(t *testing.T) {
+	f, err := parseStringProto(`syntax = "proto3"
+
+	message Hello {
+		string foo = 1;
+		repeated string bar = 2;
+		uint64 baz = 3;
+	}
+	`)
+	require.NoError(t, err)
+	m, err := GetMessageByName(f, "Hello")
+	require.NoError(t, err)
+
+	baz, err := GetFieldByName(m, "baz")
+	require.NoError(t, err)
+	RemoveFields(m, "baz")
+	ReserveFields(m, baz)
+	require.Equal(t, 4, NextUniqueID(m))
+
+	bar, err := GetFieldByName(m, "bar")
+	require.NoError(t, err)
+	RemoveFields(m, "bar")
+	ReserveFields(m, bar)
+	require.Equal(t, 4, NextUniqueID(m))
+	require.Contains(t, Print(f), `reserved 3, 2;`)
+	require.Contains(t, Print(f), `reserved "baz", "bar";`)
+
+	UnreserveNames(m, "bar")
+	require.Contains(t, Print(f), `reserved "baz";`)
+	UnreserveNames(m, "baz")
+	require.NotContains(t, Print(f), `reserved "`)
+	require.Contains(t, Print(f), `reserved 3, 2;`)
+}
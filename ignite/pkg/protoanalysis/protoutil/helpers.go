@@ -1,6 +1,8 @@
 package protoutil
 
 import (
+	"slices"
+
 	"github.com/emicklei/proto"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
@@ -121,9 +123,9 @@ func AddImports(f *proto.Proto, fallback bool, imports ...*proto.Import) (err er
 	return errors.New("unable to add proto import, no import statements found")
 }
 
-// NextUniqueID goes through the fields of the given Message and returns
-// an id > max(fieldIds). It does not try to 'plug the holes' by selecting the
-// least available id.
+// NextUniqueID goes through the fields and the reserved numbers of the given
+// Message and returns an id > max(fieldIds, reservedIds). It does not try to
+// 'plug the holes' by selecting the least available id.
 //
 //	 // In 'example.proto' file
 //	 syntax = "proto3"
@@ -144,9 +146,15 @@ func NextUniqueID(m *proto.Message) int {
 	// if no elements exist => 1.
 	maximum := 0
 	for _, el := range m.Elements {
-		if f, ok := el.(*proto.NormalField); ok {
-			if f.Sequence > maximum {
-				maximum = f.Sequence
+		switch el := el.(type) {
+		case *proto.NormalField:
+			maximum = max(maximum, el.Sequence)
+		case *proto.Reserved:
+			// the numbers reserved up to max can't be exceeded anyway.
+			for _, r := range el.Ranges {
+				if !r.Max {
+					maximum = max(maximum, r.From, r.To)
+				}
 			}
 		}
 	}
@@ -341,3 +349,164 @@ func HasField(f *proto.Proto, messageName, field string) bool {
 	_, err = GetFieldByName(msg, field)
 	return err == nil
 }
+
+// RemoveMessages removes the messages with the given names from the given file
+// and returns the number of removed messages.
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	// removes message Foo { ... } from 'foo.proto'
+//	n := RemoveMessages(f, "Foo")
+func RemoveMessages(f *proto.Proto, names ...string) int {
+	toRemove := toSet(names)
+	removed := 0
+	elements := make([]proto.Visitee, 0, len(f.Elements))
+	for _, el := range f.Elements {
+		if m, ok := el.(*proto.Message); ok && toRemove[m.Name] {
+			removed++
+			continue
+		}
+		elements = append(elements, el)
+	}
+	f.Elements = elements
+	return removed
+}
+
+// RemoveRPCs removes the RPCs with the given names from the given service
+// and returns the number of removed RPCs.
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	s, _ := GetServiceByName(f, "FooSrv")
+//	// removes rpc Foo (...) returns (...) from FooSrv
+//	n := RemoveRPCs(s, "Foo")
+func RemoveRPCs(s *proto.Service, names ...string) int {
+	toRemove := toSet(names)
+	removed := 0
+	elements := make([]proto.Visitee, 0, len(s.Elements))
+	for _, el := range s.Elements {
+		if rpc, ok := el.(*proto.RPC); ok && toRemove[rpc.Name] {
+			removed++
+			continue
+		}
+		elements = append(elements, el)
+	}
+	s.Elements = elements
+	return removed
+}
+
+// RemoveFields removes the fields with the given names from the given message
+// and returns the number of removed fields.
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	m, _ := GetMessageByName(f, "Foo")
+//	// removes the field bar from message Foo { ... }
+//	n := RemoveFields(m, "bar")
+func RemoveFields(m *proto.Message, names ...string) int {
+	toRemove := toSet(names)
+	removed := 0
+	elements := make([]proto.Visitee, 0, len(m.Elements))
+	for _, el := range m.Elements {
+		if field, ok := el.(*proto.NormalField); ok && toRemove[field.Name] {
+			removed++
+			continue
+		}
+		elements = append(elements, el)
+	}
+	m.Elements = elements
+	return removed
+}
+
+// ReserveFields reserves the numbers and the names of the given fields in the
+// given message, so they are not reused by new fields. The numbers and the names
+// are appended to the first reserved statements of the message.
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	m, _ := GetMessageByName(f, "Foo")
+//	bar, _ := GetFieldByName(m, "bar")
+//	RemoveFields(m, "bar")
+//	// adds 'reserved 2;' and 'reserved "bar";' to message Foo { ... }
+//	ReserveFields(m, bar)
+func ReserveFields(m *proto.Message, fields ...*proto.NormalField) {
+	if len(fields) == 0 {
+		return
+	}
+
+	var numbers, names *proto.Reserved
+	for _, el := range m.Elements {
+		r, ok := el.(*proto.Reserved)
+		if !ok {
+			continue
+		}
+		if numbers == nil && len(r.Ranges) > 0 {
+			numbers = r
+		} else if names == nil && len(r.FieldNames) > 0 {
+			names = r
+		}
+	}
+	if numbers == nil {
+		numbers = &proto.Reserved{Parent: m}
+		m.Elements = append(m.Elements, numbers)
+	}
+	if names == nil {
+		names = &proto.Reserved{Parent: m}
+		m.Elements = append(m.Elements, names)
+	}
+
+	for _, f := range fields {
+		numbers.Ranges = append(numbers.Ranges, proto.Range{From: f.Sequence, To: f.Sequence})
+		if !slices.Contains(names.FieldNames, f.Name) {
+			names.FieldNames = append(names.FieldNames, f.Name)
+		}
+	}
+}
+
+// UnreserveNames removes the given names from the reserved names of the given
+// message, so they can be used again by new fields. The reserved statements
+// left without names are removed.
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	m, _ := GetMessageByName(f, "Foo")
+//	// removes "bar" from 'reserved "bar", "baz";' in message Foo { ... }
+//	UnreserveNames(m, "bar")
+func UnreserveNames(m *proto.Message, names ...string) {
+	toRemove := toSet(names)
+	elements := make([]proto.Visitee, 0, len(m.Elements))
+	for _, el := range m.Elements {
+		if r, ok := el.(*proto.Reserved); ok && len(r.FieldNames) > 0 {
+			r.FieldNames = slices.DeleteFunc(r.FieldNames, func(name string) bool { return toRemove[name] })
+			if len(r.FieldNames) == 0 {
+				continue
+			}
+		}
+		elements = append(elements, el)
+	}
+	m.Elements = elements
+}
+
+// RemoveImports removes the imports with the given paths from the given file
+// and returns the number of removed imports.
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	// removes import "other.proto" from 'foo.proto'
+//	n := RemoveImports(f, "other.proto")
+func RemoveImports(f *proto.Proto, paths ...string) int {
+	toRemove := toSet(paths)
+	removed := 0
+	elements := make([]proto.Visitee, 0, len(f.Elements))
+	for _, el := range f.Elements {
+		if i, ok := el.(*proto.Import); ok && toRemove[i.Filename] {
+			removed++
+			continue
+		}
+		elements = append(elements, el)
+	}
+	f.Elements = elements
+	return removed
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
@@ -42,6 +42,7 @@ func TestDetermineWithoutTag(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, v.Tag)
 	require.Equal(t, headHash, v.Hash)
+	require.True(t, v.Time.Equal(time.Unix(100, 0)))
 }
 
 func TestDetermineWithTagOnHead(t *testing.T) {
@@ -78,4 +79,5 @@ func TestDetermineWithOlderTagUsesSuffix(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "1.0.0-"+headHash[:8], v.Tag)
 	require.Equal(t, headHash, v.Hash)
+	require.True(t, v.Time.Equal(time.Unix(200, 0)))
 }
@@ -0,0 +1,120 @@
+// Package sbom generates software bills of materials for Go modules in the
+// CycloneDX format.
+package sbom
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/modfile"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodule"
+)
+
+const (
+	// Format is the format of the generated bills of materials.
+	Format = "CycloneDX"
+
+	// SpecVersion is the version of the CycloneDX specification of the generated bills of materials.
+	SpecVersion = "1.5"
+
+	// ComponentTypeApplication is the type of the component described by a bill of materials.
+	ComponentTypeApplication = "application"
+
+	// ComponentTypeLibrary is the type of the dependencies of a component.
+	ComponentTypeLibrary = "library"
+)
+
+type (
+	// BOM is a CycloneDX software bill of materials.
+	BOM struct {
+		BOMFormat   string      `json:"bomFormat"`
+		SpecVersion string      `json:"specVersion"`
+		Version     int         `json:"version"`
+		Metadata    Metadata    `json:"metadata"`
+		Components  []Component `json:"components"`
+	}
+
+	// Metadata describes the component of a bill of materials.
+	Metadata struct {
+		Timestamp string    `json:"timestamp"`
+		Component Component `json:"component"`
+	}
+
+	// Component is a software component.
+	Component struct {
+		Type    string `json:"type"`
+		BOMRef  string `json:"bom-ref,omitempty"`
+		Name    string `json:"name"`
+		Version string `json:"version,omitempty"`
+		PURL    string `json:"purl,omitempty"`
+	}
+)
+
+// FromGoMod returns the bill of materials of a Go module with all its dependencies,
+// including the indirect ones and their replacements. The version is the version of
+// the module and timestamp the time when the module was built.
+// The components are sorted so the same go.mod always results in the same bill of materials.
+func FromGoMod(f *modfile.File, version string, timestamp time.Time) (BOM, error) {
+	if f.Module == nil {
+		return BOM{}, errors.New("go.mod doesn't declare a module path")
+	}
+
+	deps, err := gomodule.ResolveDependencies(f, true)
+	if err != nil {
+		return BOM{}, err
+	}
+
+	components := make([]Component, 0, len(deps))
+	for _, dep := range deps {
+		components = append(components, newComponent(ComponentTypeLibrary, dep.Path, dep.Version))
+	}
+	slices.SortFunc(components, func(a, b Component) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Version, b.Version)
+	})
+	components = slices.Compact(components)
+
+	return BOM{
+		BOMFormat:   Format,
+		SpecVersion: SpecVersion,
+		Version:     1,
+		Metadata: Metadata{
+			Timestamp: timestamp.UTC().Format(time.RFC3339),
+			Component: newComponent(ComponentTypeApplication, f.Module.Mod.Path, version),
+		},
+		Components: components,
+	}, nil
+}
+
+// JSON returns the bill of materials encoded in JSON.
+func (b BOM) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// newComponent returns a Go module component identified by its package URL.
+// Modules replaced by a local directory don't have a version nor a package URL.
+func newComponent(componentType, path, version string) Component {
+	c := Component{
+		Type:    componentType,
+		Name:    path,
+		Version: version,
+	}
+	if version != "" || componentType == ComponentTypeApplication {
+		c.PURL = "pkg:golang/" + path
+		if version != "" {
+			c.PURL += "@" + version
+		}
+		c.BOMRef = c.PURL
+	}
+	return c
+}
@@ -0,0 +1,75 @@
+package sbom_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
+
+	"github.com/ignite/cli/v29/ignite/pkg/sbom"
+)
+
+func TestFromGoMod(t *testing.T) {
+	gomod := `module github.com/ignite/mars
+
+go 1.24
+
+require (
+	cosmossdk.io/core v0.11.3
+	github.com/cosmos/cosmos-sdk v0.53.6
+	github.com/spf13/cobra v1.9.1 // indirect
+)
+
+replace (
+	github.com/cosmos/cosmos-sdk => github.com/ignite/cosmos-sdk v0.53.7
+	cosmossdk.io/core => ../core
+)
+`
+	f, err := modfile.Parse("go.mod", []byte(gomod), nil)
+	require.NoError(t, err)
+
+	bom, err := sbom.FromGoMod(f, "v0.1.0", time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600)))
+	require.NoError(t, err)
+
+	got, err := bom.JSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "version": 1,
+  "metadata": {
+    "timestamp": "2024-01-02T02:04:05Z",
+    "component": {
+      "type": "application",
+      "bom-ref": "pkg:golang/github.com/ignite/mars@v0.1.0",
+      "name": "github.com/ignite/mars",
+      "version": "v0.1.0",
+      "purl": "pkg:golang/github.com/ignite/mars@v0.1.0"
+    }
+  },
+  "components": [
+    {
+      "type": "library",
+      "name": "../core"
+    },
+    {
+      "type": "library",
+      "bom-ref": "pkg:golang/github.com/ignite/cosmos-sdk@v0.53.7",
+      "name": "github.com/ignite/cosmos-sdk",
+      "version": "v0.53.7",
+      "purl": "pkg:golang/github.com/ignite/cosmos-sdk@v0.53.7"
+    },
+    {
+      "type": "library",
+      "bom-ref": "pkg:golang/github.com/spf13/cobra@v1.9.1",
+      "name": "github.com/spf13/cobra",
+      "version": "v1.9.1",
+      "purl": "pkg:golang/github.com/spf13/cobra@v1.9.1"
+    }
+  ]
+}`, string(got))
+
+	_, err = sbom.FromGoMod(&modfile.File{}, "", time.Time{})
+	require.Error(t, err)
+}
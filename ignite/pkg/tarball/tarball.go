@@ -4,8 +4,11 @@ import (
 	"archive/tar"
 	"compress/gzip"
 	"io"
+	"io/fs"
+	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
@@ -77,3 +80,85 @@ func isValidPath(filePath string) bool {
 	cleanPath := filepath.Clean(filePath)
 	return !strings.Contains(cleanPath, "..")
 }
+
+// Create writes the files of dir in a gzip tarball with reproducible metadata, see Write.
+func Create(out io.Writer, dir string, modTime time.Time) error {
+	gw, err := gzip.NewWriterLevel(out, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	gw.ModTime = modTime
+
+	if err := Write(gw, dir, modTime); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// Write writes the files of dir in a tarball with reproducible metadata.
+// The files are added in lexical order with paths relative to dir, the owner is
+// reset, the modification times are set to modTime and the permissions are
+// normalized to 0755 for directories and executables, and 0644 for other files.
+func Write(out io.Writer, dir string, modTime time.Time) error {
+	tw := tar.NewWriter(out)
+
+	// WalkDir visits the files in lexical order
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		header := &tar.Header{
+			Name:    filepath.ToSlash(name),
+			ModTime: modTime,
+		}
+		switch {
+		case info.IsDir():
+			header.Typeflag = tar.TypeDir
+			header.Name += "/"
+			header.Mode = 0o755
+		case info.Mode().IsRegular():
+			header.Typeflag = tar.TypeReg
+			header.Size = info.Size()
+			header.Mode = 0o644
+			if info.Mode()&0o111 != 0 {
+				header.Mode = 0o755
+			}
+		default:
+			return errors.Errorf("%w: %s is not a regular file", ErrInvalidFilePath, name)
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
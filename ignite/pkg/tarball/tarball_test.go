@@ -1,11 +1,18 @@
 package tarball
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
+	"io"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
 
 func TestExtractFile(t *testing.T) {
@@ -95,3 +102,61 @@ func TestExtractFile(t *testing.T) {
 		})
 	}
 }
+
+func TestCreate(t *testing.T) {
+	modTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	create := func(fileTime time.Time) []byte {
+		t.Helper()
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "bin"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "bin", "marsd"), []byte("binary"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0o600))
+		for _, name := range []string{"bin", "bin/marsd", "README.md"} {
+			require.NoError(t, os.Chtimes(filepath.Join(dir, name), fileTime, fileTime))
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, Create(&buf, dir, modTime))
+		return buf.Bytes()
+	}
+
+	got := create(time.Now())
+	require.Equal(t, got, create(time.Now().Add(time.Hour)), "tarballs must be reproducible")
+
+	gz, err := gzip.NewReader(bytes.NewReader(got))
+	require.NoError(t, err)
+	require.True(t, gz.ModTime.Equal(modTime))
+
+	var headers []*tar.Header
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		headers = append(headers, h)
+	}
+	require.Len(t, headers, 3)
+	for i, want := range []struct {
+		name string
+		mode int64
+	}{
+		{name: "README.md", mode: 0o644},
+		{name: "bin/", mode: 0o755},
+		{name: "bin/marsd", mode: 0o755},
+	} {
+		require.Equal(t, want.name, headers[i].Name)
+		require.Equal(t, want.mode, headers[i].Mode)
+		require.True(t, headers[i].ModTime.Equal(modTime))
+		require.Zero(t, headers[i].Uid)
+		require.Empty(t, headers[i].Uname)
+	}
+
+	var buf bytes.Buffer
+	path, err := ExtractFile(bytes.NewReader(got), &buf, "marsd")
+	require.NoError(t, err)
+	require.Equal(t, "bin/marsd", path)
+	require.Equal(t, "binary", buf.String())
+}
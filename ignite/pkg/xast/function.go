@@ -7,7 +7,9 @@ import (
 	"go/format"
 	"go/parser"
 	"go/token"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
@@ -17,6 +19,7 @@ type (
 	// functionOpts represent the options for functions.
 	functionOpts struct {
 		newParams      []functionParam  // Parameters to add to the function.
+		paramNames     []functionParam  // Parameters to name.
 		body           string           // New function body content.
 		newLines       []functionLine   // Lines to insert at specific positions.
 		insideCall     functionCalls    // Function calls to modify.
@@ -26,6 +29,9 @@ type (
 		returnVars     []string         // Return variables to modify.
 		appendSwitch   functionSwitches // Switch cases to append.
 		removeCalls    []string         // Function calls to remove.
+		removeStructs  functionStructs  // Struct literal fields to remove.
+		removeStmts    []string         // Identifiers of the statements to remove.
+		removeTests    []string         // Test cases to remove.
 	}
 
 	// FunctionOptions configures code generation.
@@ -155,6 +161,19 @@ func AppendFuncParams(name, varType string, index int) FunctionOptions {
 	}
 }
 
+// NameFuncParam names the parameter at the given index of a function, the usages of the
+// parameter inside the function body are renamed as well. For instance, the function
+// 'EndBlock(_ context.Context)' becomes 'EndBlock(ctx context.Context)' so the appended
+// code can use the context.
+func NameFuncParam(index int, name string) FunctionOptions {
+	return func(c *functionOpts) {
+		c.paramNames = append(c.paramNames, functionParam{
+			name:  name,
+			index: index,
+		})
+	}
+}
+
 // ReplaceFuncBody replaces the entire body of a function, the method will replace first and apply the other options after.
 func ReplaceFuncBody(body string) FunctionOptions {
 	return func(c *functionOpts) {
@@ -253,10 +272,41 @@ func RemoveFuncCall(callName string) FunctionOptions {
 	}
 }
 
+// RemoveFuncStruct removes a field from a struct literal. For instance,
+// the struct is 'Params{Param1: param1, Param2: param2}' and we want to remove
+// the param2 the result will be 'Params{Param1: param1}'.
+// The name parameter follows the same rules as in AppendFuncStruct.
+func RemoveFuncStruct(name, param string) FunctionOptions {
+	return func(c *functionOpts) {
+		c.removeStructs = append(c.removeStructs, functionStruct{
+			name:  name,
+			param: param,
+		})
+	}
+}
+
+// RemoveFuncCode removes the top-level statements of a function referencing any of the given identifiers.
+// When a removed statement assigns the "err" variable, the error check that immediately
+// follows it is removed as well. This reverts the code added with AppendFuncCode.
+func RemoveFuncCode(idents ...string) FunctionOptions {
+	return func(c *functionOpts) {
+		c.removeStmts = append(c.removeStmts, idents...)
+	}
+}
+
+// RemoveFuncTestCase removes the test cases described by the given name from a test function.
+// The test case is matched against its "desc" or "name" field.
+func RemoveFuncTestCase(desc string) FunctionOptions {
+	return func(c *functionOpts) {
+		c.removeTests = append(c.removeTests, desc)
+	}
+}
+
 // newFunctionOptions creates a new functionOpts with defaults.
 func newFunctionOptions() functionOpts {
 	return functionOpts{
 		newParams:      make([]functionParam, 0),
+		paramNames:     make([]functionParam, 0),
 		body:           "",
 		newLines:       make([]functionLine, 0),
 		insideCall:     make(functionCalls, 0),
@@ -265,6 +315,9 @@ func newFunctionOptions() functionOpts {
 		appendCode:     make([]string, 0),
 		returnVars:     make([]string, 0),
 		removeCalls:    make([]string, 0),
+		removeStructs:  make(functionStructs, 0),
+		removeStmts:    make([]string, 0),
+		removeTests:    make([]string, 0),
 	}
 }
 
@@ -405,6 +458,47 @@ func addParams(funcDecl *ast.FuncDecl, newParams []functionParam) error {
 	return nil
 }
 
+// nameParams names the parameters of a function declaration and renames their usages in the body.
+func nameParams(funcDecl *ast.FuncDecl, params []functionParam) error {
+	for _, p := range params {
+		ident, err := paramIdent(funcDecl, p.index)
+		if err != nil {
+			return err
+		}
+
+		oldName := ident.Name
+		ident.Name = p.name
+		if oldName == "_" || oldName == p.name || funcDecl.Body == nil {
+			continue
+		}
+
+		ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && id.Name == oldName {
+				id.Name = p.name
+			}
+			return true
+		})
+	}
+	return nil
+}
+
+// paramIdent returns the identifier of the parameter at the given index of a function declaration.
+func paramIdent(funcDecl *ast.FuncDecl, index int) (*ast.Ident, error) {
+	i := 0
+	for _, field := range funcDecl.Type.Params.List {
+		if len(field.Names) == 0 {
+			return nil, errors.Errorf("function %s has unnamed parameters", funcDecl.Name.Name)
+		}
+		for _, name := range field.Names {
+			if i == index {
+				return name, nil
+			}
+			i++
+		}
+	}
+	return nil, errors.Errorf("params index %d out of range", index)
+}
+
 // addNewLine inserts code at specific line numbers in a function body.
 func addNewLine(fileSet *token.FileSet, funcDecl *ast.FuncDecl, newLines []functionLine) error {
 	for _, newLine := range newLines {
@@ -669,6 +763,24 @@ func applyFunctionOptions(fileSet *token.FileSet, f *ast.FuncDecl, opts *functio
 		removeFunctionCalls(f, opts.removeCalls)
 	}
 
+	// struct fields are removed first, so the statements declaring
+	// the struct literals are not matched by the removed code.
+	if len(opts.removeStructs) > 0 {
+		removeStructs(fileSet, f, opts.removeStructs)
+	}
+
+	if len(opts.removeStmts) > 0 {
+		removeFunctionCode(fileSet, f, opts.removeStmts)
+	}
+
+	if len(opts.removeTests) > 0 {
+		removeTestCases(fileSet, f, opts.removeTests)
+	}
+
+	if err := nameParams(f, opts.paramNames); err != nil {
+		return err
+	}
+
 	if err := addParams(f, opts.newParams); err != nil {
 		return err
 	}
@@ -1033,3 +1145,238 @@ func removeFunctionCalls(f *ast.FuncDecl, callNames []string) {
 
 	f.Body.List = filterStmts(f.Body.List)
 }
+
+// removeFunctionCode removes the top-level statements referencing any of the identifiers from a function.
+func removeFunctionCode(fileSet *token.FileSet, f *ast.FuncDecl, idents []string) {
+	if f.Body == nil {
+		return
+	}
+
+	identMap := make(map[string]bool, len(idents))
+	for _, ident := range idents {
+		identMap[ident] = true
+	}
+
+	removed := make([]bool, len(f.Body.List))
+	for i := 0; i < len(f.Body.List); i++ {
+		stmt := f.Body.List[i]
+		if !hasIdent(stmt, identMap) {
+			continue
+		}
+		removed[i] = true
+
+		// skip the error check of the removed statement.
+		if assignsErr(stmt) && i+1 < len(f.Body.List) && isErrCheck(f.Body.List[i+1]) {
+			i++
+			removed[i] = true
+		}
+	}
+
+	removeNodeLines(fileSet.File(f.Pos()), f.Body.Lbrace, f.Body.Rbrace, f.Body.List, removed)
+	f.Body.List = filterRemoved(f.Body.List, removed)
+}
+
+// removeElts removes the elements of a composite literal.
+func removeElts(fileSet *token.FileSet, expr *ast.CompositeLit, removed []bool) {
+	if !slices.Contains(removed, true) {
+		return
+	}
+
+	file := fileSet.File(expr.Pos())
+	elts := filterRemoved(expr.Elts, removed)
+	if len(elts) == 0 {
+		// the emptied literal is printed on a single line.
+		mergeLines(file, file.Line(expr.Lbrace), file.Line(expr.Rbrace))
+		expr.Rbrace = expr.Lbrace + 1
+	} else {
+		removeNodeLines(file, expr.Lbrace, expr.Rbrace, expr.Elts, removed)
+	}
+	expr.Elts = elts
+}
+
+// filterRemoved returns the nodes which are not removed.
+func filterRemoved[T ast.Node](nodes []T, removed []bool) []T {
+	filtered := make([]T, 0, len(nodes))
+	for i, node := range nodes {
+		if !removed[i] {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
+
+// removeNodeLines removes the lines of the removed nodes of a list delimited by
+// lbrace and rbrace, so the printer doesn't leave blank lines in their place.
+// The blank lines around the removed nodes are kept, unless they start or end the list.
+func removeNodeLines[T ast.Node](file *token.File, lbrace, rbrace token.Pos, nodes []T, removed []bool) {
+	// the lines are removed from the end of the list, so the lines
+	// of the nodes not handled yet don't change.
+	for end := len(nodes); end > 0; end-- {
+		if !removed[end-1] {
+			continue
+		}
+		start := end - 1
+		for start > 0 && removed[start-1] {
+			start--
+		}
+
+		switch {
+		case end == len(nodes):
+			// no blank line is kept before the closing brace.
+			prevEnd := lbrace
+			if start > 0 {
+				prevEnd = nodes[start-1].End()
+			}
+			mergeLines(file, file.Line(prevEnd)+1, file.Line(rbrace))
+		case start == 0:
+			// no blank line is kept after the opening brace.
+			mergeLines(file, file.Line(lbrace)+1, file.Line(nodes[end].Pos()))
+		default:
+			mergeLines(file, file.Line(nodes[start].Pos()), file.Line(nodes[end-1].End())+1)
+		}
+		end = start + 1
+	}
+}
+
+// mergeLines merges the lines of a file from fromLine up to toLine,
+// the content of toLine ends up on fromLine.
+func mergeLines(file *token.File, fromLine, toLine int) {
+	for line := fromLine; line < toLine; line++ {
+		file.MergeLine(fromLine)
+	}
+}
+
+// hasIdent returns true if the node references any of the identifiers.
+func hasIdent(n ast.Node, identMap map[string]bool) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && identMap[ident.Name] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// assignsErr returns true if the statement assigns a value to the "err" variable.
+func assignsErr(stmt ast.Stmt) bool {
+	assignStmt, ok := stmt.(*ast.AssignStmt)
+	if !ok {
+		return false
+	}
+	for _, lhs := range assignStmt.Lhs {
+		if ident, ok := lhs.(*ast.Ident); ok && ident.Name == "err" {
+			return true
+		}
+	}
+	return false
+}
+
+// isErrCheck returns true if the statement is an if statement checking the "err" variable.
+func isErrCheck(stmt ast.Stmt) bool {
+	ifStmt, ok := stmt.(*ast.IfStmt)
+	if !ok || ifStmt.Init != nil || ifStmt.Else != nil {
+		return false
+	}
+	return hasIdent(ifStmt.Cond, map[string]bool{"err": true})
+}
+
+// removeStructs removes fields from the struct literals of a function.
+func removeStructs(fileSet *token.FileSet, f *ast.FuncDecl, structs functionStructs) {
+	structMap := structs.Map()
+	ast.Inspect(f, func(n ast.Node) bool {
+		expr, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		name, exist := exprName(expr.Type)
+		if !exist {
+			return true
+		}
+
+		toRemove := structMap[name]
+		if sel, isSel := expr.Type.(*ast.SelectorExpr); isSel {
+			toRemove = append(toRemove, structMap[sel.Sel.Name]...)
+		}
+		if len(toRemove) == 0 {
+			return true
+		}
+
+		params := make(map[string]bool, len(toRemove))
+		for _, s := range toRemove {
+			params[s.param] = true
+		}
+
+		removed := make([]bool, len(expr.Elts))
+		for i, elt := range expr.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok && params[key.Name] {
+					removed[i] = true
+				}
+			}
+		}
+		removeElts(fileSet, expr, removed)
+		return true
+	})
+}
+
+// removeTestCases removes test cases from the "tests" variable of a test function.
+func removeTestCases(fileSet *token.FileSet, funcDecl *ast.FuncDecl, testCases []string) {
+	descMap := make(map[string]bool, len(testCases))
+	for _, desc := range testCases {
+		descMap[desc] = true
+	}
+
+	for _, stmt := range funcDecl.Body.List {
+		assignStmt, ok := stmt.(*ast.AssignStmt)
+		if !ok || len(assignStmt.Lhs) == 0 {
+			continue
+		}
+
+		ident, ok := assignStmt.Lhs[0].(*ast.Ident)
+		if !ok || ident.Name != "tests" {
+			continue
+		}
+
+		compositeLit, ok := assignStmt.Rhs[0].(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+
+		removed := make([]bool, len(compositeLit.Elts))
+		for i, elt := range compositeLit.Elts {
+			if desc, ok := testCaseDesc(elt); ok && descMap[desc] {
+				removed[i] = true
+			}
+		}
+		removeElts(fileSet, compositeLit, removed)
+	}
+}
+
+// testCaseDesc returns the "desc" or "name" value of a test case literal.
+func testCaseDesc(expr ast.Expr) (string, bool) {
+	testCase, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return "", false
+	}
+	for _, elt := range testCase.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok || (key.Name != "desc" && key.Name != "name") {
+			continue
+		}
+		value, ok := kv.Value.(*ast.BasicLit)
+		if !ok || value.Kind != token.STRING {
+			continue
+		}
+		desc, err := strconv.Unquote(value.Value)
+		if err != nil {
+			return "", false
+		}
+		return desc, true
+	}
+	return "", false
+}
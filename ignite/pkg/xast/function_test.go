@@ -1485,3 +1485,257 @@ func process(values []int, anyValue interface{}) int {
 	require.Contains(t, got, "doKeep()")
 	require.Contains(t, got, "return 1")
 }
+
+func TestRemoveFuncCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		funcName string
+		idents   []string
+		expected string
+	}{
+		{
+			name: "remove statements and their error checks",
+			content: `package keeper
+
+func ExportGenesis(k Keeper) (*GenesisState, error) {
+	var err error
+
+	genesis := DefaultGenesis()
+	genesis.Params, err = k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Post.Walk(ctx, nil, func(_ uint64, val Post) (stop bool, err error) {
+		genesis.PostList = append(genesis.PostList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.PostCount, err = k.PostSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+`,
+			funcName: "ExportGenesis",
+			idents:   []string{"PostList", "PostSeq"},
+			expected: `package keeper
+
+func ExportGenesis(k Keeper) (*GenesisState, error) {
+	var err error
+
+	genesis := DefaultGenesis()
+	genesis.Params, err = k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}`,
+		},
+		{
+			name: "keep statements without the identifiers",
+			content: `package keeper
+
+func InitGenesis(k Keeper, genState GenesisState) error {
+	return k.Params.Set(ctx, genState.Params)
+}
+`,
+			funcName: "InitGenesis",
+			idents:   []string{"PostList"},
+			expected: `package keeper
+
+func InitGenesis(k Keeper, genState GenesisState) error {
+	return k.Params.Set(ctx, genState.Params)
+}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ModifyFunction(tt.content, tt.funcName, RemoveFuncCode(tt.idents...))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestRemoveFuncStruct(t *testing.T) {
+	content := `package keeper
+
+func TestGenesis(t *testing.T) {
+	genesisState := GenesisState{
+		Params:  DefaultParams(),
+		PostMap: []Post{{Index: "0"}},
+		UserMap: []User{{Index: "0"}},
+	}
+	require.ElementsMatch(t, genesisState.PostMap, got.PostMap)
+	require.ElementsMatch(t, genesisState.UserMap, got.UserMap)
+}
+`
+
+	result, err := ModifyFunction(
+		content,
+		"TestGenesis",
+		RemoveFuncStruct("GenesisState", "PostMap"),
+		RemoveFuncCode("PostMap"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, `package keeper
+
+func TestGenesis(t *testing.T) {
+	genesisState := GenesisState{
+		Params:  DefaultParams(),
+		UserMap: []User{{Index: "0"}},
+	}
+	require.ElementsMatch(t, genesisState.UserMap, got.UserMap)
+}`, result)
+}
+
+func TestRemoveFuncTestCase(t *testing.T) {
+	content := `package types
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "valid genesis state",
+			genState: &GenesisState{},
+			valid:    true,
+		},
+		{
+			desc:     "duplicated post",
+			genState: &GenesisState{},
+			valid:    false,
+		},
+		{
+			desc:     "duplicated user",
+			genState: &GenesisState{},
+			valid:    false,
+		},
+	}
+	_ = tests
+}
+`
+
+	result, err := ModifyFunction(content, "TestGenesisState_Validate", RemoveFuncTestCase("duplicated post"))
+	require.NoError(t, err)
+	require.NotContains(t, result, "duplicated post")
+	require.Contains(t, result, "valid genesis state")
+	require.Contains(t, result, "duplicated user")
+}
+
+func TestNameFuncParam(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		funcName string
+		index    int
+		param    string
+		code     string
+		expected string
+		err      error
+	}{
+		{
+			name: "name blank parameter",
+			content: `package module
+
+func EndBlock(_ context.Context) error {
+	return nil
+}
+`,
+			funcName: "EndBlock",
+			param:    "ctx",
+			code:     "if err := k.Process(ctx); err != nil {\n\treturn err\n}",
+			expected: `package module
+
+func EndBlock(ctx context.Context) error {
+	if err := k.Process(ctx); err != nil {
+		return err
+	}
+	return nil
+}`,
+		},
+		{
+			name: "rename parameter and its usages",
+			content: `package module
+
+func EndBlock(goCtx context.Context, height int64) error {
+	return k.Process(goCtx, height)
+}
+`,
+			funcName: "EndBlock",
+			param:    "ctx",
+			expected: `package module
+
+func EndBlock(ctx context.Context, height int64) error {
+	return k.Process(ctx, height)
+}`,
+		},
+		{
+			name: "name grouped parameter",
+			content: `package module
+
+func Add(a, _ int) int {
+	return a
+}
+`,
+			funcName: "Add",
+			index:    1,
+			param:    "b",
+			expected: `package module
+
+func Add(a, b int) int {
+	return a
+}`,
+		},
+		{
+			name: "unnamed parameters",
+			content: `package module
+
+func EndBlock(context.Context) error {
+	return nil
+}
+`,
+			funcName: "EndBlock",
+			param:    "ctx",
+			err:      errors.New("function EndBlock has unnamed parameters"),
+		},
+		{
+			name: "index out of range",
+			content: `package module
+
+func EndBlock(_ context.Context) error {
+	return nil
+}
+`,
+			funcName: "EndBlock",
+			index:    1,
+			param:    "ctx",
+			err:      errors.New("params index 1 out of range"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []FunctionOptions{NameFuncParam(tt.index, tt.param)}
+			if tt.code != "" {
+				opts = append(opts, AppendFuncCode(tt.code))
+			}
+			result, err := ModifyFunction(tt.content, tt.funcName, opts...)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.err.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"go/format"
 	"go/parser"
 	"go/token"
+	"slices"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
@@ -124,6 +125,87 @@ func InsertGlobal(fileContent string, globalType GlobalType, globals ...GlobalOp
 	return buf.String(), nil
 }
 
+// RemoveGlobal removes the global variables or constants with the given names from the Go source code content.
+// Declarations left without any value are removed as well.
+func RemoveGlobal(fileContent string, names ...string) (modifiedContent string, err error) {
+	if len(names) == 0 {
+		return fileContent, nil
+	}
+
+	toRemove := make(map[string]bool, len(names))
+	for _, name := range names {
+		toRemove[name] = true
+	}
+
+	fileSet := token.NewFileSet()
+
+	// Parse the Go source code content.
+	f, err := parser.ParseFile(fileSet, "", fileContent, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+	cmap := ast.NewCommentMap(fileSet, f, f.Comments)
+
+	decls := make([]ast.Decl, 0, len(f.Decls))
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || (genDecl.Tok != token.VAR && genDecl.Tok != token.CONST) {
+			decls = append(decls, decl)
+			continue
+		}
+
+		specs := make([]ast.Spec, 0, len(genDecl.Specs))
+		for _, spec := range genDecl.Specs {
+			valueSpec, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				specs = append(specs, spec)
+				continue
+			}
+
+			var (
+				names  []*ast.Ident
+				values []ast.Expr
+			)
+			for i, name := range valueSpec.Names {
+				if toRemove[name.Name] {
+					continue
+				}
+				names = append(names, name)
+				if i < len(valueSpec.Values) {
+					values = append(values, valueSpec.Values[i])
+				}
+			}
+			if len(names) == 0 {
+				delete(cmap, spec)
+				continue
+			}
+			valueSpec.Names = names
+			if len(valueSpec.Values) > 0 {
+				valueSpec.Values = values
+			}
+			specs = append(specs, valueSpec)
+		}
+
+		if len(specs) == 0 {
+			delete(cmap, decl)
+			continue
+		}
+		genDecl.Specs = specs
+		decls = append(decls, genDecl)
+	}
+	f.Decls = decls
+
+	f.Comments = cmap.Filter(f).Comments()
+
+	// Format the modified AST.
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fileSet, f); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
 // AppendFunction appends a new function to the end of the Go source code content.
 func AppendFunction(fileContent string, function string) (modifiedContent string, err error) {
 	fileSet := token.NewFileSet()
@@ -170,7 +252,8 @@ func AppendFunction(fileContent string, function string) (modifiedContent string
 type (
 	// structOpts represent the options for structs.
 	structOpts struct {
-		values []structValue
+		values       []structValue
+		removeValues []string
 	}
 
 	// StructOpts configures struct changes.
@@ -194,9 +277,19 @@ func AppendStructValue(value, valueType string) StructOpts {
 	}
 }
 
+// RemoveStructValue removes a value from a struct. For instances,
+// the struct have two fields 'test struct{ test1 string, test2 int }' and we want to remove
+// the `test2` the result will be 'test struct{ test1 string }'.
+func RemoveStructValue(value string) StructOpts {
+	return func(c *structOpts) {
+		c.removeValues = append(c.removeValues, value)
+	}
+}
+
 func newStructOptions() structOpts {
 	return structOpts{
-		values: make([]structValue, 0),
+		values:       make([]structValue, 0),
+		removeValues: make([]string, 0),
 	}
 }
 
@@ -249,6 +342,29 @@ func ModifyStruct(fileContent, structName string, options ...StructOpts) (string
 	if !found {
 		return "", errors.Errorf("struct %q not found in file content", structName)
 	}
+	if len(opts.removeValues) > 0 {
+		toRemove := make(map[string]bool, len(opts.removeValues))
+		for _, v := range opts.removeValues {
+			toRemove[v] = true
+		}
+
+		fields := make([]*ast.Field, 0, len(structType.Fields.List))
+		for _, field := range structType.Fields.List {
+			names := make([]*ast.Ident, 0, len(field.Names))
+			for _, name := range field.Names {
+				if !toRemove[name.Name] {
+					names = append(names, name)
+				}
+			}
+			if len(field.Names) > 0 && len(names) == 0 {
+				continue
+			}
+			field.Names = names
+			fields = append(fields, field)
+		}
+		structType.Fields.List = fields
+	}
+
 	for _, v := range opts.values {
 		structType.Fields.List = append(structType.Fields.List, &ast.Field{
 			Names: []*ast.Ident{ast.NewIdent(v.value)},
@@ -385,33 +501,33 @@ func newGlobalValueSpec(fileSet *token.FileSet, global global) (*ast.ValueSpec,
 	return spec, nil
 }
 
+// appendCompositeLiteralValues appends the values at the end of a composite literal. The values of a
+// literal spanning multiple lines are appended on their own lines, before the closing brace.
 func appendCompositeLiteralValues(fileSet *token.FileSet, compLit *ast.CompositeLit, values []string) {
 	file := fileSet.File(compLit.Pos())
-	maxOffset := file.Offset(compLit.Rbrace)
-	for _, elt := range compLit.Elts {
-		if pos := elt.End(); pos.IsValid() {
-			if offset := file.Offset(pos); offset > maxOffset {
-				maxOffset = offset
-			}
-		}
-	}
+	rbrace := file.Offset(compLit.Rbrace)
+	multiline := file.Line(compLit.Lbrace) != file.Line(compLit.Rbrace)
 
+	offset := func(i int) int {
+		return min(rbrace+i, file.Size())
+	}
 	for i, valueName := range values {
-		insertPos := file.Pos(maxOffset + i)
 		value := ast.NewIdent(valueName)
-		value.NamePos = insertPos
-
+		value.NamePos = file.Pos(offset(i))
 		compLit.Elts = append(compLit.Elts, value)
-		compLit.Rbrace += token.Pos(i + 1)
 	}
+	compLit.Rbrace = file.Pos(offset(len(values)))
 
-	if len(compLit.Elts) == 0 {
+	if !multiline {
 		return
 	}
 
-	last := compLit.Elts[len(compLit.Elts)-1]
-	if file.Line(compLit.Rbrace) == file.Line(last.End())-1 {
-		file.AddLine(file.Offset(compLit.Rbrace))
-		compLit.Rbrace += token.Pos(1)
+	// the first value takes the line of the closing brace, start a new line
+	// at each following value and at the closing brace.
+	lines := file.Lines()
+	for i := 1; i <= len(values) && offset(i) < file.Size(); i++ {
+		lines = append(lines, offset(i))
 	}
+	slices.Sort(lines)
+	file.SetLines(slices.Compact(lines))
 }
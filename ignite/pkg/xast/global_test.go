@@ -634,6 +634,49 @@ var (
 		nft.ModuleName,
 	}
 )
+`,
+		},
+		{
+			name: "Add fields to array followed by a function",
+			args: args{
+				fileContent: `package app
+
+var Upgrades = []upgrades.Upgrade{
+	v1.Upgrade,
+}
+
+func New() {}
+`,
+				globalName: "Upgrades",
+				options: []GlobalArrayOpts{
+					AppendGlobalArrayValue("v2.Upgrade"),
+					AppendGlobalArrayValue("v3.Upgrade"),
+				},
+			},
+			want: `package app
+
+var Upgrades = []upgrades.Upgrade{
+	v1.Upgrade,
+	v2.Upgrade,
+	v3.Upgrade,
+}
+
+func New() {}
+`,
+		},
+		{
+			name: "Add field to single line array",
+			args: args{
+				fileContent: `package app
+
+var stores = []string{"foo", "bar"}
+`,
+				globalName: "stores",
+				options:    []GlobalArrayOpts{AppendGlobalArrayValue(`"baz"`)},
+			},
+			want: `package app
+
+var stores = []string{"foo", "bar", "baz"}
 `,
 		},
 		{
@@ -750,3 +793,74 @@ func TestNewGlobalValueSpec(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestModifyStructRemoveValue(t *testing.T) {
+	content := `package keeper
+
+type Keeper struct {
+	Params  collections.Item[Params]
+	PostSeq collections.Sequence
+	Post    collections.Map[uint64, Post]
+}
+`
+
+	result, err := ModifyStruct(content, "Keeper", RemoveStructValue("PostSeq"), RemoveStructValue("Post"))
+	require.NoError(t, err)
+	require.Equal(t, `package keeper
+
+type Keeper struct {
+	Params collections.Item[Params]
+}
+`, result)
+}
+
+func TestRemoveGlobal(t *testing.T) {
+	content := `package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "mars"
+)
+
+var (
+	// PostKey is the prefix to retrieve all Post
+	PostKey = collections.NewPrefix("post/value/")
+	// PostCountKey is the prefix to retrieve the Post count
+	PostCountKey = collections.NewPrefix("post/count/")
+)
+
+// ParamsKey is the prefix to retrieve all Params
+var ParamsKey = collections.NewPrefix("p_mars")
+
+// UserKey is the prefix to retrieve all User
+var UserKey = collections.NewPrefix("user/value/")
+`
+
+	t.Run("remove globals and empty declarations", func(t *testing.T) {
+		result, err := RemoveGlobal(content, "PostKey", "PostCountKey", "UserKey")
+		require.NoError(t, err)
+		require.Equal(t, `package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "mars"
+)
+
+// ParamsKey is the prefix to retrieve all Params
+var ParamsKey = collections.NewPrefix("p_mars")
+`, result)
+	})
+
+	t.Run("remove a global from a declaration group", func(t *testing.T) {
+		result, err := RemoveGlobal(content, "PostCountKey")
+		require.NoError(t, err)
+		require.Contains(t, result, "PostKey")
+		require.NotContains(t, result, "PostCountKey")
+		require.NotContains(t, result, "the Post count")
+	})
+
+	t.Run("invalid source content", func(t *testing.T) {
+		_, err := RemoveGlobal("package main\nvar", "PostKey")
+		require.Error(t, err)
+	})
+}
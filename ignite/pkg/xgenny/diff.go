@@ -0,0 +1,232 @@
+package xgenny
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const devNull = "/dev/null"
+
+// DiffModifications returns the source modification and a unified diff of all modifications
+// from the temporary folder against the target path. The modifications are discarded afterward,
+// so the target path is never modified. The diff uses the git format and can be applied from
+// the target path with `git apply`.
+func (r *Runner) DiffModifications() (SourceModification, string, error) {
+	sm, err := r.sourceModification()
+	if err != nil {
+		return sm, "", err
+	}
+	defer os.RemoveAll(r.tmpPath)
+
+	root, err := filepath.Abs(r.Root)
+	if err != nil {
+		return sm, "", err
+	}
+
+	var (
+		changed = append(sm.CreatedFiles(), sm.ModifiedFiles()...)
+		removed = sm.RemovedFiles()
+		diffs   = make(map[string]string)
+	)
+	for _, fileName := range changed {
+		relPath, err := relativePath(root, fileName)
+		if err != nil {
+			return sm, "", err
+		}
+
+		staged, err := os.ReadFile(filepath.Join(r.tmpPath, relPath))
+		if err != nil {
+			return sm, "", errors.Errorf("failed to read the modified file %s: %w", relPath, err)
+		}
+
+		original, exists, err := readFile(filepath.Join(root, relPath))
+		if err != nil {
+			return sm, "", err
+		}
+
+		diffs[relPath] = unifiedDiff(relPath, original, string(staged), exists, true)
+	}
+
+	for _, fileName := range removed {
+		relPath, err := relativePath(root, fileName)
+		if err != nil {
+			return sm, "", err
+		}
+
+		original, _, err := readFile(filepath.Join(root, relPath))
+		if err != nil {
+			return sm, "", err
+		}
+
+		diffs[relPath] = unifiedDiff(relPath, original, "", true, false)
+	}
+
+	return sm, joinDiffs(diffs), nil
+}
+
+// DiffDir returns the source modification and a unified diff of the files of the source
+// directory against the same files in the target path, like the files generated in a
+// temporary directory to preview them. The diff uses the git format and can be applied
+// from the target path with `git apply`.
+func DiffDir(source, target string) (SourceModification, string, error) {
+	var (
+		sm    = NewSourceModification()
+		diffs = make(map[string]string)
+	)
+	err := filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		relPath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+
+		modified, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		targetPath := filepath.Join(target, relPath)
+		original, exists, err := readFile(targetPath)
+		if err != nil {
+			return err
+		}
+
+		diff := unifiedDiff(relPath, original, string(modified), exists, true)
+		switch {
+		case !exists:
+			sm.AppendCreatedFiles(targetPath)
+		case diff != "":
+			sm.AppendModifiedFiles(targetPath)
+		}
+		diffs[relPath] = diff
+		return nil
+	})
+	if err != nil {
+		return sm, "", err
+	}
+	return sm, joinDiffs(diffs), nil
+}
+
+// joinDiffs joins the diffs of the files ordered by path.
+func joinDiffs(diffs map[string]string) string {
+	paths := make([]string, 0, len(diffs))
+	for path := range diffs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var b strings.Builder
+	for _, path := range paths {
+		b.WriteString(diffs[path])
+	}
+	return b.String()
+}
+
+// unifiedDiff returns the git unified diff of a file.
+func unifiedDiff(path, original, modified string, exists, keep bool) string {
+	path = filepath.ToSlash(path)
+	var (
+		from = "a/" + path
+		to   = "b/" + path
+	)
+	edits := myers.ComputeEdits(span.URIFromPath(path), original, modified)
+	if len(edits) == 0 && exists && keep {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "diff --git %s %s\n", from, to)
+	switch {
+	case !exists:
+		from = devNull
+		b.WriteString("new file mode 100644\n")
+	case !keep:
+		to = devNull
+		b.WriteString("deleted file mode 100644\n")
+	}
+
+	// an empty file has no hunks, git only needs the header to create or remove it
+	if len(edits) == 0 {
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", from, to)
+	for _, hunk := range gotextdiff.ToUnified(from, to, original, edits).Hunks {
+		fromCount, toCount := 0, 0
+		for _, l := range hunk.Lines {
+			switch l.Kind {
+			case gotextdiff.Delete:
+				fromCount++
+			case gotextdiff.Insert:
+				toCount++
+			default:
+				fromCount++
+				toCount++
+			}
+		}
+
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(hunk.FromLine, fromCount), hunkRange(hunk.ToLine, toCount))
+		for _, l := range hunk.Lines {
+			switch l.Kind {
+			case gotextdiff.Delete:
+				b.WriteString("-")
+			case gotextdiff.Insert:
+				b.WriteString("+")
+			default:
+				b.WriteString(" ")
+			}
+			b.WriteString(l.Content)
+			if !strings.HasSuffix(l.Content, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+// hunkRange returns the range of a hunk in the unified format. Unlike gotextdiff,
+// an empty range starts at the line preceding the hunk, as expected by git and patch.
+func hunkRange(line, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", line-1)
+	case 1:
+		return strconv.Itoa(line)
+	default:
+		return fmt.Sprintf("%d,%d", line, count)
+	}
+}
+
+// relativePath returns the path of the file relative to the root.
+func relativePath(root, fileName string) (string, error) {
+	if !filepath.IsAbs(fileName) {
+		fileName = filepath.Join(root, fileName)
+	}
+	return filepath.Rel(root, fileName)
+}
+
+// readFile returns the file content and false if the file doesn't exist.
+func readFile(path string) (string, bool, error) {
+	content, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return "", false, nil
+	case err != nil:
+		return "", false, err
+	}
+	return string(content), true, nil
+}
@@ -0,0 +1,107 @@
+package xgenny_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+)
+
+func TestDiffModifications(t *testing.T) {
+	var (
+		appPath = t.TempDir()
+		runner  = xgenny.NewRunner(context.Background(), appPath)
+		gen     = genny.New()
+	)
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "modified.txt"), []byte("foo\nbar\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "unchanged.txt"), []byte("foo\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "removed.txt"), []byte("foo\n"), 0o644))
+
+	gen.RunFn(func(r *genny.Runner) error {
+		if err := r.File(genny.NewFileS("modified.txt", "foo\nbaz\n")); err != nil {
+			return err
+		}
+		if err := r.File(genny.NewFileS("unchanged.txt", "foo\n")); err != nil {
+			return err
+		}
+		if err := r.File(genny.NewFileS("dir/created.txt", "foo\n")); err != nil {
+			return err
+		}
+		return r.Delete("removed.txt")
+	})
+	require.NoError(t, runner.Run(gen))
+
+	_, patch, err := runner.DiffModifications()
+	require.NoError(t, err)
+	require.Equal(t, `diff --git a/dir/created.txt b/dir/created.txt
+new file mode 100644
+--- /dev/null
++++ b/dir/created.txt
+@@ -0,0 +1 @@
++foo
+diff --git a/modified.txt b/modified.txt
+--- a/modified.txt
++++ b/modified.txt
+@@ -1,2 +1,2 @@
+ foo
+-bar
++baz
+diff --git a/removed.txt b/removed.txt
+deleted file mode 100644
+--- a/removed.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-foo
+`, patch)
+
+	// the target path must not be modified
+	content, err := os.ReadFile(filepath.Join(appPath, "modified.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "foo\nbar\n", string(content))
+	require.FileExists(t, filepath.Join(appPath, "removed.txt"))
+	require.NoFileExists(t, filepath.Join(appPath, "dir/created.txt"))
+
+	// the modifications are discarded
+	sm, err := runner.ApplyModifications()
+	require.NoError(t, err)
+	require.Empty(t, sm.CreatedFiles())
+	require.Empty(t, sm.ModifiedFiles())
+	require.Empty(t, sm.RemovedFiles())
+}
+
+func TestDiffDir(t *testing.T) {
+	var (
+		source = t.TempDir()
+		target = t.TempDir()
+	)
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "dir"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "dir/created.txt"), []byte("foo\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "modified.txt"), []byte("foo\nbaz\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "unchanged.txt"), []byte("foo\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(target, "modified.txt"), []byte("foo\nbar\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(target, "unchanged.txt"), []byte("foo\n"), 0o644))
+
+	sm, patch, err := xgenny.DiffDir(source, target)
+	require.NoError(t, err)
+	require.Equal(t, `diff --git a/dir/created.txt b/dir/created.txt
+new file mode 100644
+--- /dev/null
++++ b/dir/created.txt
+@@ -0,0 +1 @@
++foo
+diff --git a/modified.txt b/modified.txt
+--- a/modified.txt
++++ b/modified.txt
+@@ -1,2 +1,2 @@
+ foo
+-bar
++baz
+`, patch)
+	require.Equal(t, []string{filepath.Join(target, "dir/created.txt")}, sm.CreatedFiles())
+	require.Equal(t, []string{filepath.Join(target, "modified.txt")}, sm.ModifiedFiles())
+}
@@ -16,6 +16,7 @@ type Runner struct {
 	*genny.Runner
 	ctx     context.Context
 	results []genny.File
+	removed []string
 	tmpPath string
 	root    string
 }
@@ -32,9 +33,11 @@ func NewRunner(ctx context.Context, root string) *Runner {
 		Runner:  runner,
 		tmpPath: tmpPath,
 		results: make([]genny.File, 0),
+		removed: make([]string, 0),
 		root:    root,
 	}
 	runner.FileFn = wetFileFn(r)
+	runner.DeleteFn = wetDeleteFn(r)
 	return r
 }
 
@@ -44,6 +47,7 @@ func (r *Runner) cleanup() {
 	runner := genny.WetRunner(r.ctx)
 	runner.Root = r.root
 	runner.FileFn = wetFileFn(r)
+	runner.DeleteFn = wetDeleteFn(r)
 	r.Runner = runner
 }
 
@@ -82,28 +86,23 @@ func (r *Runner) ApplyModifications(options ...ApplyOption) (SourceModification,
 	}
 
 	// fetch the source modification
-	sm := NewSourceModification()
-	for _, file := range r.results {
-		fileName := file.Name()
-		_, err := os.Stat(fileName)
-		switch {
-		case os.IsNotExist(err):
-			sm.AppendCreatedFiles(fileName) // if the file doesn't exist in the source, it means it has been created by the runner
-		case err != nil:
-			return sm, err
-		default:
-			sm.AppendModifiedFiles(fileName) // the file has been modified by the runner
-		}
+	sm, err := r.sourceModification()
+	if err != nil {
+		return sm, err
 	}
-	r.results = make([]genny.File, 0)
 
-	if _, err := os.Stat(r.tmpPath); os.IsNotExist(err) {
+	_, err = os.Stat(r.tmpPath)
+	hasTmp := !os.IsNotExist(err)
+	if !hasTmp && len(sm.RemovedFiles()) == 0 {
 		return sm, nil
 	}
 
-	duplicatedFiles, err := xos.ValidateFolderCopy(r.tmpPath, r.Root, sm.ModifiedFiles()...)
-	if err != nil {
-		return sm, err
+	var duplicatedFiles []string
+	if hasTmp {
+		duplicatedFiles, err = xos.ValidateFolderCopy(r.tmpPath, r.Root, sm.ModifiedFiles()...)
+		if err != nil {
+			return sm, err
+		}
 	}
 
 	if opts.preRun != nil {
@@ -117,12 +116,20 @@ func (r *Runner) ApplyModifications(options ...ApplyOption) (SourceModification,
 		return sm, err
 	}
 
-	if err := xos.CopyFolder(r.tmpPath, r.Root); err != nil {
-		return sm, err
+	if hasTmp {
+		if err := xos.CopyFolder(r.tmpPath, r.Root); err != nil {
+			return sm, err
+		}
+
+		if err := os.RemoveAll(r.tmpPath); err != nil {
+			return sm, err
+		}
 	}
 
-	if err := os.RemoveAll(r.tmpPath); err != nil {
-		return sm, err
+	for _, fileName := range sm.RemovedFiles() {
+		if err := os.RemoveAll(fileName); err != nil {
+			return sm, err
+		}
 	}
 
 	if opts.postRun != nil {
@@ -133,6 +140,41 @@ func (r *Runner) ApplyModifications(options ...ApplyOption) (SourceModification,
 	return sm, nil
 }
 
+// sourceModification returns the files created, modified and removed by the runner,
+// and resets the runner results.
+func (r *Runner) sourceModification() (SourceModification, error) {
+	sm := NewSourceModification()
+	for _, file := range r.results {
+		fileName := file.Name()
+		_, err := os.Stat(fileName)
+		switch {
+		case os.IsNotExist(err):
+			sm.AppendCreatedFiles(fileName) // if the file doesn't exist in the source, it means it has been created by the runner
+		case err != nil:
+			return sm, err
+		default:
+			sm.AppendModifiedFiles(fileName) // the file has been modified by the runner
+		}
+	}
+	r.results = make([]genny.File, 0)
+
+	// files removed by the runner are only deleted from the source when they exist
+	for _, fileName := range r.removed {
+		_, err := os.Stat(fileName)
+		switch {
+		case os.IsNotExist(err):
+			continue
+		case err != nil:
+			return sm, err
+		default:
+			sm.AppendRemovedFiles(fileName)
+		}
+	}
+	r.removed = make([]string, 0)
+
+	return sm, nil
+}
+
 // RunAndApply run the generators and apply the modifications to the target path.
 func (r *Runner) RunAndApply(gens *genny.Generator, options ...ApplyOption) (SourceModification, error) {
 	if err := r.Run(gens); err != nil {
@@ -206,3 +248,29 @@ func wetFileFn(runner *Runner) func(genny.File) (genny.File, error) {
 		return f, nil
 	}
 }
+
+// wetDeleteFn records the files removed by the generators, so they can be deleted
+// from the target path when the modifications are applied.
+func wetDeleteFn(runner *Runner) func(string) error {
+	return func(name string) error {
+		root, err := filepath.Abs(runner.Root)
+		if err != nil {
+			return err
+		}
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(root, name)
+		}
+
+		// make sure a stale copy of the file is not written back from the temporary folder
+		relPath, err := filepath.Rel(root, name)
+		if err != nil {
+			return err
+		}
+		if err := os.RemoveAll(filepath.Join(runner.tmpPath, relPath)); err != nil {
+			return err
+		}
+
+		runner.removed = append(runner.removed, name)
+		return nil
+	}
+}
@@ -2,6 +2,8 @@ package xgenny_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/gobuffalo/genny/v2"
@@ -33,3 +35,29 @@ func TestMultipleGen(t *testing.T) {
 	require.Equal(t, 1, firstRunCount, "first generator should run only once")
 	require.Equal(t, 1, secondRunCount, "second generator should run only once")
 }
+
+func TestRunnerDelete(t *testing.T) {
+	var (
+		appPath  = t.TempDir()
+		runner   = xgenny.NewRunner(context.Background(), appPath)
+		gen      = genny.New()
+		toRemove = filepath.Join(appPath, "foo.go")
+	)
+	require.NoError(t, os.WriteFile(toRemove, []byte("package foo"), 0o644))
+
+	gen.RunFn(func(r *genny.Runner) error {
+		if err := r.Delete("foo.go"); err != nil {
+			return err
+		}
+		// files that don't exist are not reported
+		return r.Delete("bar.go")
+	})
+
+	require.NoError(t, runner.Run(gen))
+	require.FileExists(t, toRemove, "file must only be removed when the modifications are applied")
+
+	sm, err := runner.ApplyModifications()
+	require.NoError(t, err)
+	require.Equal(t, []string{toRemove}, sm.RemovedFiles())
+	require.NoFileExists(t, toRemove)
+}
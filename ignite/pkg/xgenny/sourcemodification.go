@@ -12,21 +12,26 @@ import (
 var (
 	modifyPrefix = colors.Modified("modify ")
 	createPrefix = colors.Success("create ")
+	deletePrefix = colors.Error("remove ")
 	removePrefix = func(s string) string {
-		return strings.TrimPrefix(strings.TrimPrefix(s, modifyPrefix), createPrefix)
+		s = strings.TrimPrefix(s, modifyPrefix)
+		s = strings.TrimPrefix(s, createPrefix)
+		return strings.TrimPrefix(s, deletePrefix)
 	}
 )
 
-// SourceModification describes modified and created files in the source code after a run.
+// SourceModification describes modified, created and removed files in the source code after a run.
 type SourceModification struct {
 	modified map[string]struct{}
 	created  map[string]struct{}
+	removed  map[string]struct{}
 }
 
 func NewSourceModification() SourceModification {
 	return SourceModification{
 		make(map[string]struct{}),
 		make(map[string]struct{}),
+		make(map[string]struct{}),
 	}
 }
 
@@ -46,6 +51,14 @@ func (sm SourceModification) CreatedFiles() (createdFiles []string) {
 	return
 }
 
+// RemovedFiles returns the removed files of the source modification.
+func (sm SourceModification) RemovedFiles() (removedFiles []string) {
+	for removed := range sm.removed {
+		removedFiles = append(removedFiles, removed)
+	}
+	return
+}
+
 // AppendModifiedFiles appends modified files in the source modification that are not already documented.
 func (sm *SourceModification) AppendModifiedFiles(modifiedFiles ...string) {
 	for _, modifiedFile := range modifiedFiles {
@@ -68,10 +81,21 @@ func (sm *SourceModification) AppendCreatedFiles(createdFiles ...string) {
 	}
 }
 
+// AppendRemovedFiles appends removed files in the source modification that are not already documented.
+// A removed file is no longer considered as modified or created.
+func (sm *SourceModification) AppendRemovedFiles(removedFiles ...string) {
+	for _, removedFile := range removedFiles {
+		delete(sm.modified, removedFile)
+		delete(sm.created, removedFile)
+		sm.removed[removedFile] = struct{}{}
+	}
+}
+
 // Merge merges a new source modification to an existing one.
 func (sm *SourceModification) Merge(newSm SourceModification) {
 	sm.AppendModifiedFiles(newSm.ModifiedFiles()...)
 	sm.AppendCreatedFiles(newSm.CreatedFiles()...)
+	sm.AppendRemovedFiles(newSm.RemovedFiles()...)
 }
 
 // String convert to string value.
@@ -102,7 +126,13 @@ func (sm *SourceModification) String() (string, error) {
 		return "", err
 	}
 
+	removed, err := appendPrefix(sm.RemovedFiles(), deletePrefix)
+	if err != nil {
+		return "", err
+	}
+
 	files = append(files, modified...)
+	files = append(files, removed...)
 
 	// sort filenames without a prefix
 	sort.Slice(files, func(i, j int) bool {
@@ -81,3 +81,18 @@ func TestMerge(t *testing.T) {
 	require.Subset(t, sm1.ModifiedFiles(), []string{"foo1", "foo2", "foo3", "foo4", "foo5"})
 	require.Subset(t, sm1.CreatedFiles(), []string{"bar1", "bar2", "bar3"})
 }
+
+func TestAppendRemovedFiles(t *testing.T) {
+	sm := sourceModificationExample()
+	sm.AppendRemovedFiles("mfoo", "cfoo", "foo1")
+	require.Len(t, sm.RemovedFiles(), 3)
+	require.Subset(t, sm.RemovedFiles(), []string{"mfoo", "cfoo", "foo1"})
+	require.NotContains(t, sm.ModifiedFiles(), "mfoo")
+	require.NotContains(t, sm.CreatedFiles(), "cfoo")
+
+	sm2 := xgenny.NewSourceModification()
+	sm2.AppendRemovedFiles("bar1")
+	sm.Merge(sm2)
+	require.Len(t, sm.RemovedFiles(), 4)
+	require.Contains(t, sm.RemovedFiles(), "bar1")
+}
@@ -0,0 +1,178 @@
+package xyaml
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// mergeKey is the YAML merge key.
+const mergeKey = "<<"
+
+var (
+	unmarshalerType         = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	obsoleteUnmarshalerType = reflect.TypeOf((*interface {
+		UnmarshalYAML(func(interface{}) error) error
+	})(nil)).Elem()
+)
+
+type (
+	// UnknownField is a YAML field that doesn't exist in the decoded type.
+	UnknownField struct {
+		// Path is the path of the field, e.g. accounts[0].coin.
+		Path string
+
+		// Line and Column are the position of the field in the YAML document.
+		Line, Column int
+	}
+
+	// UnknownFieldsError is returned when YAML fields don't exist in the decoded type.
+	UnknownFieldsError struct {
+		Fields []UnknownField
+	}
+)
+
+func (f UnknownField) String() string {
+	return fmt.Sprintf("line %d, column %d: unknown field %q", f.Line, f.Column, f.Path)
+}
+
+func (e UnknownFieldsError) Error() string {
+	fields := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		fields[i] = f.String()
+	}
+	return strings.Join(fields, "\n")
+}
+
+// CheckKnownFields checks that the fields of the YAML node exist in the type of v,
+// using the yaml tags of the struct fields. The values decoded by a custom YAML
+// unmarshaler are not checked. It returns an UnknownFieldsError with the position
+// of each unknown field.
+func CheckKnownFields(n *yaml.Node, v interface{}) error {
+	var fields []UnknownField
+	checkKnownFields(n, reflect.TypeOf(v), "", &fields)
+	if len(fields) > 0 {
+		return &UnknownFieldsError{fields}
+	}
+	return nil
+}
+
+func checkKnownFields(n *yaml.Node, t reflect.Type, path string, unknown *[]UnknownField) {
+	if n == nil || t == nil {
+		return
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if isUnmarshaler(t) {
+		return
+	}
+
+	switch n.Kind {
+	case yaml.DocumentNode:
+		for _, c := range n.Content {
+			checkKnownFields(c, t, path, unknown)
+		}
+	case yaml.AliasNode:
+		checkKnownFields(n.Alias, t, path, unknown)
+	case yaml.SequenceNode:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for i, c := range n.Content {
+			checkKnownFields(c, t.Elem(), fmt.Sprintf("%s[%d]", path, i), unknown)
+		}
+	case yaml.MappingNode:
+		switch t.Kind() {
+		case reflect.Map:
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				checkKnownFields(n.Content[i+1], t.Elem(), joinPath(path, n.Content[i].Value), unknown)
+			}
+		case reflect.Struct:
+			fields, ok := structFields(t)
+			if !ok {
+				return
+			}
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				key, value := n.Content[i], n.Content[i+1]
+				if key.Value == mergeKey {
+					checkKnownFields(value, t, path, unknown)
+					continue
+				}
+
+				fieldPath := joinPath(path, key.Value)
+				fieldType, ok := fields[key.Value]
+				if !ok {
+					*unknown = append(*unknown, UnknownField{Path: fieldPath, Line: key.Line, Column: key.Column})
+					continue
+				}
+				checkKnownFields(value, fieldType, fieldPath, unknown)
+			}
+		}
+	}
+}
+
+// structFields returns the types of the struct fields by their YAML name.
+// It returns false when the struct accepts any field with an inline map.
+func structFields(t reflect.Type) (map[string]reflect.Type, bool) {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+
+		tags := strings.Split(field.Tag.Get("yaml"), ",")
+		name := tags[0]
+		if name == "-" {
+			continue
+		}
+
+		inline := false
+		for _, tag := range tags[1:] {
+			inline = inline || tag == "inline"
+		}
+		if inline {
+			ft := field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() != reflect.Struct {
+				return nil, false
+			}
+			inlineFields, ok := structFields(ft)
+			if !ok {
+				return nil, false
+			}
+			for k, v := range inlineFields {
+				fields[k] = v
+			}
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		fields[name] = field.Type
+	}
+	return fields, true
+}
+
+// isUnmarshaler returns true when the type has a custom YAML unmarshaler
+// or accepts any value.
+func isUnmarshaler(t reflect.Type) bool {
+	if t.Kind() == reflect.Interface {
+		return true
+	}
+	pt := reflect.PointerTo(t)
+	return pt.Implements(unmarshalerType) || pt.Implements(obsoleteUnmarshalerType)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
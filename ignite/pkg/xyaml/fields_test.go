@@ -0,0 +1,102 @@
+package xyaml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+type (
+	testBase struct {
+		Version int `yaml:"version"`
+	}
+
+	testAccount struct {
+		Name  string   `yaml:"name"`
+		Coins []string `yaml:"coins,omitempty"`
+	}
+
+	testConfig struct {
+		testBase `yaml:",inline"`
+
+		Accounts []testAccount          `yaml:"accounts"`
+		Genesis  xyaml.Map              `yaml:"genesis"`
+		Servers  map[string]testAccount `yaml:"servers"`
+		Ignored  string                 `yaml:"-"`
+	}
+)
+
+func TestCheckKnownFields(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want []xyaml.UnknownField
+	}{
+		{
+			name: "known fields",
+			yaml: `
+version: 1
+accounts:
+  - name: alice
+    coins: ["1token"]
+genesis:
+  any:
+    field: 1
+servers:
+  rpc:
+    name: rpc
+`,
+		},
+		{
+			name: "unknown fields",
+			yaml: `
+version: 1
+accounts:
+  - name: alice
+    coin: ["1token"]
+servers:
+  rpc:
+    nam: rpc
+Ignored: true
+`,
+			want: []xyaml.UnknownField{
+				{Path: "accounts[0].coin", Line: 5, Column: 5},
+				{Path: "servers.rpc.nam", Line: 8, Column: 5},
+				{Path: "Ignored", Line: 9, Column: 1},
+			},
+		},
+		{
+			name: "unknown fields in merge key",
+			yaml: `
+base: &base
+  name: alice
+  coin: ["1token"]
+accounts:
+  - <<: *base
+`,
+			want: []xyaml.UnknownField{
+				{Path: "base", Line: 2, Column: 1},
+				{Path: "accounts[0].coin", Line: 4, Column: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n yaml.Node
+			require.NoError(t, yaml.Unmarshal([]byte(tt.yaml), &n))
+
+			err := xyaml.CheckKnownFields(&n, testConfig{})
+			if tt.want == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var fieldsErr *xyaml.UnknownFieldsError
+			require.ErrorAs(t, err, &fieldsErr)
+			require.Equal(t, tt.want, fieldsErr.Fields)
+		})
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ignite/cli/v29/ignite/pkg/archive"
 	"github.com/ignite/cli/v29/ignite/pkg/cache"
@@ -19,12 +21,20 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/events"
 	"github.com/ignite/cli/v29/ignite/pkg/goanalysis"
 	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodule"
+	"github.com/ignite/cli/v29/ignite/pkg/minisign"
+	"github.com/ignite/cli/v29/ignite/pkg/sbom"
+	"github.com/ignite/cli/v29/ignite/pkg/tarball"
 	"github.com/ignite/cli/v29/ignite/pkg/xstrings"
 )
 
 const (
 	releaseDir                   = "release"
 	releaseChecksumKey           = "release_checksum"
+	releaseSignatureExt          = ".minisig"
+	releasePublicKeyFile         = "minisign.pub"
+	releaseSBOMSuffix            = "_sbom.cdx.json"
+	envSourceDateEpoch           = "SOURCE_DATE_EPOCH"
 	modChecksumKey               = "go_mod_checksum"
 	buildDirchangeCacheNamespace = "build.dirchange"
 	consumerDevel                = "consumer_devel"
@@ -105,6 +115,34 @@ func (c *Chain) build(
 	return gocmd.BuildPath(ctx, output, binary, path, buildFlags)
 }
 
+type (
+	// ReleaseOption configures the release build.
+	ReleaseOption func(*releaseOptions)
+
+	releaseOptions struct {
+		reproducible bool
+		signingKey   string
+	}
+)
+
+// ReleaseReproducible builds the release reproducibly: binaries are built without local paths,
+// tarballs have fixed metadata and their timestamps are set to SOURCE_DATE_EPOCH, which defaults
+// to the time of the last commit. A CycloneDX SBOM of the Go dependencies is added to the release.
+func ReleaseReproducible() ReleaseOption {
+	return func(o *releaseOptions) {
+		o.reproducible = true
+	}
+}
+
+// ReleaseSigningKey signs the release checksum file with the ed25519 private key file at path.
+// The key is either an unencrypted minisign secret key or a PKCS #8 PEM key, the signature
+// and the public key are saved in the minisign format.
+func ReleaseSigningKey(path string) ReleaseOption {
+	return func(o *releaseOptions) {
+		o.signingKey = path
+	}
+}
+
 // BuildRelease builds binaries for a release. targets is a list
 // of GOOS:GOARCH when provided. It defaults to your system when no targets provided.
 // prefix is used as prefix to tarballs containing each target.
@@ -113,8 +151,14 @@ func (c *Chain) BuildRelease(
 	cacheStorage cache.Storage,
 	buildParams []string,
 	output, prefix string,
-	targets ...string,
+	targets []string,
+	options ...ReleaseOption,
 ) (releasePath string, err error) {
+	var releaseOptions releaseOptions
+	for _, apply := range options {
+		apply(&releaseOptions)
+	}
+
 	if prefix == "" {
 		prefix = c.app.Name
 	}
@@ -132,6 +176,14 @@ func (c *Chain) BuildRelease(
 		return "", err
 	}
 
+	sourceDate := time.Now()
+	if releaseOptions.reproducible {
+		if sourceDate, err = c.sourceDateEpoch(); err != nil {
+			return "", err
+		}
+		buildFlags = append(buildFlags, gocmd.FlagTrimpath)
+	}
+
 	binary, err := c.Binary()
 	if err != nil {
 		return "", err
@@ -168,11 +220,15 @@ func (c *Chain) BuildRelease(
 		}
 		defer os.RemoveAll(out)
 
+		env := []string{
+			cmdrunner.Env(gocmd.EnvGOOS, goos),
+			cmdrunner.Env(gocmd.EnvGOARCH, goarch),
+		}
+		if releaseOptions.reproducible {
+			env = append(env, cmdrunner.Env(envSourceDateEpoch, strconv.FormatInt(sourceDate.Unix(), 10)))
+		}
 		buildOptions := []exec.Option{
-			exec.StepOption(step.Env(
-				cmdrunner.Env(gocmd.EnvGOOS, goos),
-				cmdrunner.Env(gocmd.EnvGOARCH, goarch),
-			)),
+			exec.StepOption(step.Env(env...)),
 		}
 
 		if err := gocmd.BuildPath(ctx, out, binary, mainPath, buildFlags, buildOptions...); err != nil {
@@ -188,15 +244,104 @@ func (c *Chain) BuildRelease(
 		}
 		defer tarf.Close()
 
-		if err := archive.CreateArchive(out, tarf); err != nil {
+		if releaseOptions.reproducible {
+			err = tarball.Create(tarf, out, sourceDate)
+		} else {
+			err = archive.CreateArchive(out, tarf)
+		}
+		if err != nil {
 			return "", errors.Errorf("error creating release archive: %w", err)
 		}
 	}
 
-	checksumPath := filepath.Join(releasePath, releaseChecksumKey)
+	if releaseOptions.reproducible {
+		if err := c.writeReleaseSBOM(filepath.Join(releasePath, prefix+releaseSBOMSuffix), sourceDate); err != nil {
+			return "", err
+		}
+	}
 
 	// create a checksum.txt and return with the path to release dir.
-	return releasePath, checksum.Sum(releasePath, checksumPath)
+	checksumPath := filepath.Join(releasePath, releaseChecksumKey)
+	if err := checksum.Sum(releasePath, checksumPath); err != nil {
+		return "", err
+	}
+
+	if releaseOptions.signingKey != "" {
+		if err := signReleaseChecksum(checksumPath, releaseOptions.signingKey, sourceDate); err != nil {
+			return "", err
+		}
+	}
+
+	return releasePath, nil
+}
+
+// sourceDateEpoch returns the time used for reproducible builds, which is the
+// SOURCE_DATE_EPOCH environment variable when set or the time of the last commit.
+// See https://reproducible-builds.org/specs/source-date-epoch.
+func (c *Chain) sourceDateEpoch() (time.Time, error) {
+	if epoch := os.Getenv(envSourceDateEpoch); epoch != "" {
+		sec, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return time.Time{}, errors.Errorf("invalid %s %q: %w", envSourceDateEpoch, epoch, err)
+		}
+		return time.Unix(sec, 0).UTC(), nil
+	}
+
+	if c.sourceVersion.time.IsZero() {
+		return time.Time{}, errors.Errorf(
+			"cannot determine the time of the last commit for a reproducible build, set %s",
+			envSourceDateEpoch,
+		)
+	}
+	return c.sourceVersion.time.UTC(), nil
+}
+
+// writeReleaseSBOM writes the CycloneDX SBOM of the app Go dependencies.
+func (c *Chain) writeReleaseSBOM(path string, timestamp time.Time) error {
+	c.ev.Send("Generating the SBOM...", events.ProgressUpdate())
+
+	modFile, err := gomodule.ParseAt(c.app.Path)
+	if err != nil {
+		return err
+	}
+
+	bom, err := sbom.FromGoMod(modFile, c.sourceVersion.tag, timestamp)
+	if err != nil {
+		return err
+	}
+
+	data, err := bom.JSON()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// signReleaseChecksum signs the checksum file with the private key file and saves the
+// signature and the public key next to the checksum file in the minisign format.
+func signReleaseChecksum(checksumPath, keyPath string, timestamp time.Time) error {
+	keyFile, err := os.ReadFile(keyPath)
+	if err != nil {
+		return errors.Wrap(err, "cannot read the release signing key")
+	}
+
+	key, err := minisign.ParsePrivateKey(keyFile)
+	if err != nil {
+		return errors.Wrapf(err, "invalid release signing key %s", keyPath)
+	}
+
+	checksums, err := os.ReadFile(checksumPath)
+	if err != nil {
+		return err
+	}
+
+	comment := fmt.Sprintf("timestamp:%d\tfile:%s\thashed", timestamp.Unix(), filepath.Base(checksumPath))
+	if err := os.WriteFile(checksumPath+releaseSignatureExt, key.Sign(checksums, comment), 0o644); err != nil {
+		return err
+	}
+
+	publicKeyPath := filepath.Join(filepath.Dir(checksumPath), releasePublicKeyFile)
+	return os.WriteFile(publicKeyPath, key.PublicKey().Encode(), 0o644)
 }
 
 func (c *Chain) preBuild(
@@ -0,0 +1,77 @@
+package chain
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/minisign"
+)
+
+func TestSourceDateEpoch(t *testing.T) {
+	commitTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	c := &Chain{sourceVersion: version{time: commitTime}}
+
+	t.Run("commit time", func(t *testing.T) {
+		t.Setenv(envSourceDateEpoch, "")
+		got, err := c.sourceDateEpoch()
+		require.NoError(t, err)
+		require.Equal(t, commitTime.UTC(), got)
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		t.Setenv(envSourceDateEpoch, "1700000000")
+		got, err := c.sourceDateEpoch()
+		require.NoError(t, err)
+		require.Equal(t, time.Unix(1700000000, 0).UTC(), got)
+	})
+
+	t.Run("invalid environment variable", func(t *testing.T) {
+		t.Setenv(envSourceDateEpoch, "yesterday")
+		_, err := c.sourceDateEpoch()
+		require.ErrorContains(t, err, "invalid SOURCE_DATE_EPOCH")
+	})
+
+	t.Run("no commit", func(t *testing.T) {
+		t.Setenv(envSourceDateEpoch, "")
+		_, err := (&Chain{}).sourceDateEpoch()
+		require.ErrorContains(t, err, "set SOURCE_DATE_EPOCH")
+	})
+}
+
+func TestSignReleaseChecksum(t *testing.T) {
+	dir := t.TempDir()
+
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "release.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	checksums := []byte("3a1f  mars_linux_amd64.tar.gz\n")
+	checksumPath := filepath.Join(dir, releaseChecksumKey)
+	require.NoError(t, os.WriteFile(checksumPath, checksums, 0o600))
+
+	require.NoError(t, signReleaseChecksum(checksumPath, keyPath, time.Unix(1700000000, 0)))
+
+	publicKeyFile, err := os.ReadFile(filepath.Join(dir, releasePublicKeyFile))
+	require.NoError(t, err)
+	publicKey, err := minisign.ParsePublicKey(publicKeyFile)
+	require.NoError(t, err)
+
+	signature, err := os.ReadFile(checksumPath + releaseSignatureExt)
+	require.NoError(t, err)
+	comment, err := publicKey.Verify(checksums, signature)
+	require.NoError(t, err)
+	require.Equal(t, "timestamp:1700000000\tfile:release_checksum\thashed", comment)
+
+	err = signReleaseChecksum(checksumPath, filepath.Join(dir, "missing.key"), time.Now())
+	require.ErrorContains(t, err, "cannot read the release signing key")
+}
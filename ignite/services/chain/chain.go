@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/spf13/cobra"
@@ -29,8 +30,9 @@ import (
 )
 
 const (
-	flagPath = "path"
-	flagHome = "home"
+	flagPath    = "path"
+	flagHome    = "home"
+	flagProfile = "profile"
 )
 
 type (
@@ -45,7 +47,7 @@ type (
 
 		sourceVersion  version
 		serveCancel    context.CancelFunc
-		serveRefresher chan struct{}
+		serveRefresher chan chainconfigv1.WatchAction
 		served         bool
 
 		ev          events.Bus
@@ -76,11 +78,19 @@ type (
 
 		// path of a custom config file
 		ConfigFile string
+
+		// configProfile is the name of the config profile to apply.
+		configProfile string
+
+		// binaryPath is the path of the binary run by the commands, when it is
+		// not the binary installed by the build.
+		binaryPath string
 	}
 
 	version struct {
 		tag  string
 		hash string
+		time time.Time
 	}
 
 	// Option configures Chain.
@@ -115,6 +125,13 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// ConfigProfile applies the config profile with the given name to the chain config.
+func ConfigProfile(name string) Option {
+	return func(c *Chain) {
+		c.options.configProfile = name
+	}
+}
+
 // WithOutputer sets the CLI outputer for the chain.
 func WithOutputer(s uilog.Outputer) Option {
 	return func(c *Chain) {
@@ -162,7 +179,7 @@ func New(path string, options ...Option) (*Chain, error) {
 
 	c := &Chain{
 		app:            app,
-		serveRefresher: make(chan struct{}, 1),
+		serveRefresher: make(chan chainconfigv1.WatchAction, 1),
 	}
 
 	// Apply the options
@@ -193,6 +210,7 @@ func NewWithHomeFlags(cmd *cobra.Command, chainOption ...Option) (*Chain, error)
 	var (
 		home, _    = cmd.Flags().GetString(flagHome)
 		appPath, _ = cmd.Flags().GetString(flagPath)
+		profile, _ = cmd.Flags().GetString(flagProfile)
 	)
 
 	absPath, err := filepath.Abs(appPath)
@@ -204,6 +222,11 @@ func NewWithHomeFlags(cmd *cobra.Command, chainOption ...Option) (*Chain, error)
 	if home != "" {
 		chainOption = append(chainOption, HomePath(home))
 	}
+
+	// Check if a config profile is provided
+	if profile != "" {
+		chainOption = append(chainOption, ConfigProfile(profile))
+	}
 	return New(absPath, chainOption...)
 }
 
@@ -215,6 +238,7 @@ func (c *Chain) appVersion() (v version, err error) {
 
 	v.hash = ver.Hash
 	v.tag = ver.Tag
+	v.time = ver.Time
 
 	return v, nil
 }
@@ -253,13 +277,15 @@ func (c *Chain) ConfigPath() string {
 	return path
 }
 
-// Config returns the config of the chain.
-func (c *Chain) Config() (*chainconfig.Config, error) {
+// Config returns the config of the chain with the selected profile applied.
+// The options customize how the config file is parsed.
+func (c *Chain) Config(options ...chainconfig.ParseOption) (*chainconfig.Config, error) {
 	configPath := c.ConfigPath()
 	if configPath == "" {
 		return chainconfig.DefaultChainConfig(), nil
 	}
-	return chainconfig.ParseFile(configPath)
+	options = append([]chainconfig.ParseOption{chainconfig.WithProfile(c.options.configProfile)}, options...)
+	return chainconfig.ParseFile(configPath, options...)
 }
 
 // ID returns the chain's id.
@@ -481,6 +507,9 @@ func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
 	// find the binary path when the Go bin path is not part
 	// of the PATH environment variable.
 	binary = xexec.TryResolveAbsPath(binary)
+	if c.options.binaryPath != "" {
+		binary = c.options.binaryPath
+	}
 
 	backend, err := c.KeyringBackend()
 	if err != nil {
@@ -3,8 +3,11 @@ package chain
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -12,8 +15,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet/clientbackend"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/xurl"
 	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
@@ -52,7 +60,8 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		return cosmosfaucet.Faucet{}, ErrFaucetIsNotEnabled
 	}
 
-	if _, err := commands.ShowAccount(ctx, *conf.Faucet.Name); err != nil {
+	account, err := commands.ShowAccount(ctx, *conf.Faucet.Name)
+	if err != nil {
 		if errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
 			return cosmosfaucet.Faucet{}, ErrFaucetAccountDoesNotExist
 		}
@@ -88,11 +97,34 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 	}
 
 	// check if indexer is enabled or not.
-	if indexerDisabled(validator.Config) {
+	noIndexer := indexerDisabled(validator.Config)
+	if noIndexer {
 		faucetOptions = append(faucetOptions, cosmosfaucet.IndexerDisabled())
 		c.ev.Send("⚠️ CometBFT indexer disabled. Faucet can't check account limits or verify transaction status.")
 	}
 
+	switch conf.Faucet.Backend {
+	case "", base.FaucetBackendBinary:
+	case base.FaucetBackendClient:
+		sender, err := c.faucetSender(account.Address, *conf.Faucet.Name, servers.RPC.Address, conf.Faucet.TxFee, noIndexer)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, err
+		}
+
+		home, err := c.Home()
+		if err != nil {
+			return cosmosfaucet.Faucet{}, err
+		}
+
+		backendOptions, err := faucetBackendOptions(conf.Faucet, home, sender)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, err
+		}
+		faucetOptions = append(faucetOptions, backendOptions...)
+	default:
+		return cosmosfaucet.Faucet{}, errors.Errorf("unknown faucet backend '%s'", conf.Faucet.Backend)
+	}
+
 	// parse coins to pass to the faucet as coins.
 	for _, coin := range conf.Faucet.Coins {
 		parsedCoin, err := sdk.ParseCoinNormalized(coin)
@@ -136,10 +168,254 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		faucetOptions = append(faucetOptions, cosmosfaucet.RefreshWindow(rateLimitWindow))
 	}
 
+	if conf.Faucet.AdminToken != "" {
+		faucetOptions = append(faucetOptions, cosmosfaucet.Admin(conf.Faucet.AdminToken))
+	}
+
+	if conf.Faucet.FeeGrant != nil {
+		allowance, err := faucetFeeAllowance(*conf.Faucet.FeeGrant)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, err
+		}
+		faucetOptions = append(faucetOptions, cosmosfaucet.FeeGrant(allowance))
+	}
+
+	protectionOptions, err := faucetProtectionOptions(conf.Faucet)
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+	faucetOptions = append(faucetOptions, protectionOptions...)
+
 	// init the faucet with options and return.
 	return cosmosfaucet.New(ctx, commands, faucetOptions...)
 }
 
+// faucetBackendOptions returns the faucet options sending the coins with sender.
+// The grants are kept in the ledger file, so the limits of the addresses are kept
+// when the faucet restarts.
+func faucetBackendOptions(conf base.Faucet, home string, sender cosmosfaucet.Sender) ([]cosmosfaucet.Option, error) {
+	ledgerPath := conf.Ledger
+	if ledgerPath == "" {
+		ledgerPath = base.FaucetLedger
+	}
+	if !filepath.IsAbs(ledgerPath) {
+		ledgerPath = filepath.Join(home, ledgerPath)
+	}
+
+	storage, err := cache.NewStorage(ledgerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []cosmosfaucet.Option{
+		cosmosfaucet.Backend(sender),
+		cosmosfaucet.Grants(cosmosfaucet.NewCacheLedger(storage)),
+	}
+	if conf.BatchSize > 0 {
+		options = append(options, cosmosfaucet.BatchSize(conf.BatchSize))
+	}
+	return options, nil
+}
+
+// faucetProtectionOptions returns the faucet options protecting the faucet from abuse.
+func faucetProtectionOptions(conf base.Faucet) ([]cosmosfaucet.Option, error) {
+	var options []cosmosfaucet.Option
+
+	if len(conf.AllowList) > 0 {
+		options = append(options, cosmosfaucet.AllowList(conf.AllowList...))
+	}
+	if len(conf.DenyList) > 0 {
+		options = append(options, cosmosfaucet.DenyList(conf.DenyList...))
+	}
+
+	if conf.IPLimit != nil {
+		window, err := time.ParseDuration(conf.IPLimit.Window)
+		if err != nil {
+			return nil, errors.Errorf("%w: %s", err, conf.IPLimit.Window)
+		}
+		options = append(options, cosmosfaucet.IPLimit(conf.IPLimit.Requests, window))
+	}
+	if conf.SubnetLimit != nil {
+		window, err := time.ParseDuration(conf.SubnetLimit.Window)
+		if err != nil {
+			return nil, errors.Errorf("%w: %s", err, conf.SubnetLimit.Window)
+		}
+		options = append(options, cosmosfaucet.SubnetLimit(conf.SubnetLimit.Requests, window))
+	}
+	if len(conf.TrustedProxies) > 0 {
+		proxies := make([]*net.IPNet, len(conf.TrustedProxies))
+		for i, proxy := range conf.TrustedProxies {
+			_, network, err := net.ParseCIDR(proxy)
+			if err != nil {
+				return nil, errors.Errorf("invalid faucet trusted proxy: %w", err)
+			}
+			proxies[i] = network
+		}
+		options = append(options, cosmosfaucet.TrustedProxies(proxies...))
+	}
+
+	if challenge := conf.Challenge; challenge != nil {
+		switch challenge.Type {
+		case base.FaucetChallengeProofOfWork:
+			var ttl time.Duration
+			if challenge.TTL != "" {
+				var err error
+				if ttl, err = time.ParseDuration(challenge.TTL); err != nil {
+					return nil, errors.Errorf("%w: %s", err, challenge.TTL)
+				}
+			}
+			options = append(options, cosmosfaucet.RequireChallenge(cosmosfaucet.NewProofOfWork(challenge.Difficulty, ttl)))
+		case base.FaucetChallengeCaptcha:
+			captcha := cosmosfaucet.NewCaptcha(challenge.VerifyURL, challenge.SiteKey, challenge.Secret)
+			options = append(options, cosmosfaucet.RequireChallenge(captcha))
+		default:
+			return nil, errors.Errorf("unknown faucet challenge type '%s'", challenge.Type)
+		}
+	}
+
+	return options, nil
+}
+
+// faucetFeeAllowance returns the fee allowance granted by the faucet.
+func faucetFeeAllowance(conf base.FaucetFeeGrant) (cosmosfaucet.FeeAllowance, error) {
+	allowance := cosmosfaucet.FeeAllowance{AllowedMessages: conf.AllowedMessages}
+
+	var err error
+	if allowance.SpendLimit, err = sdk.ParseCoinsNormalized(strings.Join(conf.SpendLimit, ",")); err != nil {
+		return cosmosfaucet.FeeAllowance{}, errors.Errorf("%w: %s", err, conf.SpendLimit)
+	}
+	if allowance.PeriodLimit, err = sdk.ParseCoinsNormalized(strings.Join(conf.PeriodLimit, ",")); err != nil {
+		return cosmosfaucet.FeeAllowance{}, errors.Errorf("%w: %s", err, conf.PeriodLimit)
+	}
+	if conf.Expiration != "" {
+		if allowance.Expiration, err = time.ParseDuration(conf.Expiration); err != nil {
+			return cosmosfaucet.FeeAllowance{}, errors.Errorf("%w: %s", err, conf.Expiration)
+		}
+	}
+	if conf.Period != "" {
+		if allowance.Period, err = time.ParseDuration(conf.Period); err != nil {
+			return cosmosfaucet.FeeAllowance{}, errors.Errorf("%w: %s", err, conf.Period)
+		}
+	}
+
+	return allowance, nil
+}
+
+// faucetSender returns the faucet backend signing and broadcasting the transactions in-process
+// with the faucet account of the chain keyring.
+func (c *Chain) faucetSender(address, accountName, rpcAddress, fees string, skipConfirmation bool) (*lazySender, error) {
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+	keyringBackend, err := c.KeyringBackend()
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := c.Bech32Prefix()
+	if err != nil {
+		return nil, err
+	}
+	nodeAddress, err := xurl.HTTP(rpcAddress)
+	if err != nil {
+		return nil, errors.Errorf("invalid rpc address format: %w", err)
+	}
+
+	clientOptions := []cosmosclient.Option{
+		cosmosclient.WithNodeAddress(nodeAddress),
+		cosmosclient.WithHome(home),
+		cosmosclient.WithKeyringDir(home),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(keyringBackend)),
+		cosmosclient.WithBech32Prefix(prefix),
+		cosmosclient.WithGas(cosmosclient.GasAuto),
+	}
+
+	var backendOptions []clientbackend.Option
+	if fees != "" {
+		backendOptions = append(backendOptions, clientbackend.Fees(fees))
+	}
+	if skipConfirmation {
+		backendOptions = append(backendOptions, clientbackend.SkipTxConfirmation())
+	}
+
+	return &lazySender{
+		address: address,
+		newBackend: func(ctx context.Context) (*clientbackend.Backend, error) {
+			client, err := cosmosclient.New(ctx, clientOptions...)
+			if err != nil {
+				return nil, err
+			}
+			return clientbackend.New(client, accountName, backendOptions...)
+		},
+	}, nil
+}
+
+// lazySender is a faucet backend created when the first coins are sent,
+// because the faucet is created before the node is started.
+type lazySender struct {
+	address    string
+	newBackend func(context.Context) (*clientbackend.Backend, error)
+
+	mu      sync.Mutex
+	backend *clientbackend.Backend
+}
+
+// Address returns the address of the faucet account.
+func (s *lazySender) Address() string {
+	return s.address
+}
+
+// Send sends the transfers with the backend.
+func (s *lazySender) Send(ctx context.Context, transfers []cosmosfaucet.Transfer) (string, error) {
+	backend, err := s.getBackend(ctx)
+	if err != nil {
+		return "", err
+	}
+	return backend.Send(ctx, transfers)
+}
+
+// CheckAddress checks that the address can receive coins with the backend.
+func (s *lazySender) CheckAddress(ctx context.Context, address string) error {
+	backend, err := s.getBackend(ctx)
+	if err != nil {
+		return err
+	}
+	return backend.CheckAddress(ctx, address)
+}
+
+// GrantFees grants the fee allowance with the backend.
+func (s *lazySender) GrantFees(ctx context.Context, grantee string, allowance cosmosfaucet.FeeAllowance) (string, error) {
+	backend, err := s.getBackend(ctx)
+	if err != nil {
+		return "", err
+	}
+	return backend.GrantFees(ctx, grantee, allowance)
+}
+
+// Balance returns the balance of the faucet account.
+func (s *lazySender) Balance(ctx context.Context) (sdk.Coins, error) {
+	backend, err := s.getBackend(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return backend.Balance(ctx)
+}
+
+// getBackend returns the backend, which is created first if needed.
+func (s *lazySender) getBackend(ctx context.Context) (*clientbackend.Backend, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.backend == nil {
+		backend, err := s.newBackend(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.backend = backend
+	}
+	return s.backend, nil
+}
+
 // indexerDisabled checks if the indexer is disabled in the config.yml.
 // More specifically, it checks if a kv indexer is used (psql indexer is not supported).
 func indexerDisabled(valCfg xyaml.Map) bool {
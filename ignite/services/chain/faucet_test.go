@@ -0,0 +1,69 @@
+package chain
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+)
+
+// faucetSender sends the faucet coins without a chain.
+type faucetSender struct{}
+
+func (s *faucetSender) Address() string {
+	return "cosmos1h2ame0d7hlqvrskrcnzud37ge89vhnxdutce3s"
+}
+
+func (s *faucetSender) Send(context.Context, []cosmosfaucet.Transfer) (string, error) {
+	return "hash", nil
+}
+
+func TestFaucetBackendOptions(t *testing.T) {
+	const address = "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e"
+
+	var (
+		ctx   = context.Background()
+		home  = t.TempDir()
+		conf  = base.Faucet{BatchSize: 2}
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+	)
+
+	newFaucet := func() cosmosfaucet.Faucet {
+		options, err := faucetBackendOptions(conf, home, &faucetSender{})
+		require.NoError(t, err)
+		options = append(options,
+			cosmosfaucet.ChainID("mars"),
+			cosmosfaucet.Coin(sdkmath.NewInt(10), sdkmath.NewInt(20), "token"),
+		)
+		f, err := cosmosfaucet.New(ctx, chaincmdrunner.Runner{}, options...)
+		require.NoError(t, err)
+		return f
+	}
+
+	f := newFaucet()
+	_, err := f.Transfer(ctx, address, coins)
+	require.NoError(t, err)
+	_, err = f.Transfer(ctx, address, coins)
+	require.NoError(t, err)
+
+	// the grants are kept in the ledger file of the chain home,
+	// so the limits of the addresses are kept when the faucet restarts.
+	require.FileExists(t, filepath.Join(home, base.FaucetLedger))
+	f = newFaucet()
+	_, err = f.Transfer(ctx, address, coins)
+	require.ErrorContains(t, err, "reached to the max. allowed amount")
+
+	// the ledger file can be changed
+	conf.Ledger = "faucet/ledger.db"
+	f = newFaucet()
+	_, err = f.Transfer(ctx, address, coins)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(home, "faucet", "ledger.db"))
+}
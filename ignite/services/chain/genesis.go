@@ -0,0 +1,271 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"dario.cat/mergo"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosgenesis"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+)
+
+const (
+	// GenesisDefault is the default genesis of the app, created by the init command of the chain binary.
+	GenesisDefault = "default"
+
+	// GenesisConfig is the default genesis with the genesis values of the config applied.
+	GenesisConfig = "config"
+
+	// GenesisHome is the genesis of the chain home.
+	GenesisHome = "home"
+
+	// GenesisExported is the genesis exported when serve stops.
+	GenesisExported = "exported"
+)
+
+// Genesis returns the genesis of a source: GenesisDefault, GenesisConfig,
+// GenesisHome, GenesisExported or the path of a genesis file.
+// The default and config genesis require the chain binary to be installed.
+func (c *Chain) Genesis(ctx context.Context, source string) (cosmosgenesis.Genesis, error) {
+	switch source {
+	case GenesisDefault:
+		return c.defaultGenesis(ctx)
+	case GenesisConfig:
+		return c.configGenesis(ctx)
+	case GenesisHome:
+		path, err := c.GenesisPath()
+		if err != nil {
+			return nil, err
+		}
+		return parseGenesisFile(path, "the chain is not initialized, run \"ignite chain init\"")
+	case GenesisExported:
+		path, err := c.exportedGenesisPath()
+		if err != nil {
+			return nil, err
+		}
+		return parseGenesisFile(path, "no genesis was exported, the genesis is exported when \"ignite chain serve\" stops")
+	default:
+		return parseGenesisFile(source, "")
+	}
+}
+
+// defaultGenesis initializes the chain in a temporary home and returns its genesis.
+func (c *Chain) defaultGenesis(ctx context.Context) (cosmosgenesis.Genesis, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	home, err := os.MkdirTemp("", "")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+
+	c.ev.Send("Creating the default genesis...", events.ProgressUpdate())
+
+	// initialize a copy of the chain to keep the chain home unchanged.
+	dc := *c
+	dc.SetHome(home)
+
+	commands, err := dc.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := commands.Init(ctx, moniker, initOptions(conf)...); err != nil {
+		return nil, errors.Wrap(err, "cannot create the default genesis, make sure the chain is built")
+	}
+
+	path, err := dc.GenesisPath()
+	if err != nil {
+		return nil, err
+	}
+	return cosmosgenesis.ParseFile(path)
+}
+
+// configGenesis returns the default genesis updated with the genesis values
+// of the config, like the chain init command does.
+func (c *Chain) configGenesis(ctx context.Context) (cosmosgenesis.Genesis, error) {
+	genesis, err := c.defaultGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+	if conf.Genesis == nil {
+		return genesis, nil
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return nil, err
+	}
+	conf.Genesis["chain_id"] = chainID
+
+	data := map[string]interface{}(genesis)
+	if err := mergo.Merge(&data, map[string]interface{}(conf.Genesis), mergo.WithOverride); err != nil {
+		return nil, err
+	}
+	return cosmosgenesis.FromMap(data)
+}
+
+// AddGenesisAccounts adds the accounts of a CSV or JSON file to the genesis of the chain home.
+// The accounts are streamed into the genesis at once instead of running the add-genesis-account
+// command of the chain binary for each of them, which is slow for large lists of accounts.
+func (c *Chain) AddGenesisAccounts(ctx context.Context, accountsPath string) (cosmosgenesis.AccountsSummary, error) {
+	var summary cosmosgenesis.AccountsSummary
+
+	prefix, err := c.Bech32Prefix()
+	if err != nil {
+		return summary, err
+	}
+
+	path, err := c.GenesisPath()
+	if err != nil {
+		return summary, err
+	}
+	genesis, err := parseGenesisFile(path, "the chain is not initialized, run \"ignite chain init\"")
+	if err != nil {
+		return summary, err
+	}
+
+	r, closer, err := cosmosgenesis.OpenAccountsFile(accountsPath)
+	if err != nil {
+		return summary, err
+	}
+	defer closer.Close()
+
+	c.ev.Send(fmt.Sprintf("Adding the accounts of %s to the genesis...", accountsPath), events.ProgressUpdate())
+
+	summary, err = genesis.AddAccounts(r, prefix)
+	if err != nil {
+		return summary, errors.Wrapf(err, "cannot add the accounts of %s", accountsPath)
+	}
+	if err := ctx.Err(); err != nil {
+		return summary, err
+	}
+	return summary, genesis.WriteFile(path)
+}
+
+// moduleAddress returns the address of the account of a module.
+func (c *Chain) moduleAddress(module string) (string, error) {
+	prefix, err := c.Bech32Prefix()
+	if err != nil {
+		return "", err
+	}
+	return bech32.ConvertAndEncode(prefix, address.Module(module))
+}
+
+// setGenesisAccountTypes turns the base accounts of the genesis of the chain home
+// into the module and vesting accounts of the config, by address.
+func (c *Chain) setGenesisAccountTypes(accounts map[string]chainconfig.Account) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	path, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	genesis, err := cosmosgenesis.ParseFile(path)
+	if err != nil {
+		return err
+	}
+
+	for addr, account := range accounts {
+		if account.IsModule() {
+			if err := genesis.SetModuleAccount(addr, account.Module, account.Permissions); err != nil {
+				return err
+			}
+			continue
+		}
+
+		vesting, err := genesisVestingAccount(genesis, account)
+		if err != nil {
+			return errors.Wrapf(err, "account %s", account.Name)
+		}
+		if err := genesis.SetVestingAccount(addr, vesting); err != nil {
+			return err
+		}
+	}
+
+	return genesis.WriteFile(path)
+}
+
+// genesisVestingAccount returns the genesis vesting account of the vesting of an account.
+// The vesting starts at the genesis time when the start time is not set.
+func genesisVestingAccount(genesis cosmosgenesis.Genesis, account chainconfig.Account) (cosmosgenesis.VestingAccount, error) {
+	v := cosmosgenesis.VestingAccount{Type: cosmosgenesis.VestingType(account.Vesting.Type)}
+
+	var err error
+	if v.OriginalVesting, err = account.OriginalVesting(); err != nil {
+		return v, err
+	}
+	if v.EndTime, err = account.Vesting.End(); err != nil {
+		return v, err
+	}
+	if v.StartTime, err = account.Vesting.Start(); err != nil {
+		return v, err
+	}
+	if v.StartTime.IsZero() && v.Type != cosmosgenesis.VestingDelayed {
+		if v.StartTime, err = genesis.Time(); err != nil {
+			return v, err
+		}
+		if !v.EndTime.IsZero() && !v.EndTime.After(v.StartTime) {
+			return v, errors.Errorf("vesting end time %s must be after the genesis time", account.Vesting.EndTime)
+		}
+	}
+
+	for _, p := range account.Vesting.Periods {
+		length, err := p.Duration()
+		if err != nil {
+			return v, err
+		}
+		coins, err := p.Amount()
+		if err != nil {
+			return v, err
+		}
+		v.Periods = append(v.Periods, cosmosgenesis.VestingPeriod{Length: length, Coins: coins})
+	}
+	return v, nil
+}
+
+// AccountsFile returns the path of the accounts file of the config, empty when there is none.
+func (c *Chain) AccountsFile() (string, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+	return c.accountsFilePath(conf), nil
+}
+
+// accountsFilePath returns the path of the accounts file of the config.
+// A relative path is relative to the directory of the config file.
+func (c *Chain) accountsFilePath(conf *chainconfig.Config) string {
+	path := conf.AccountsFile
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	if configPath := c.ConfigPath(); configPath != "" {
+		return filepath.Join(filepath.Dir(configPath), path)
+	}
+	return filepath.Join(c.AppPath(), path)
+}
+
+func parseGenesisFile(path, notFoundHint string) (cosmosgenesis.Genesis, error) {
+	genesis, err := cosmosgenesis.ParseFile(path)
+	if errors.Is(err, os.ErrNotExist) && notFoundHint != "" {
+		return nil, errors.Errorf("genesis not found at %s: %s", path, notFoundHint)
+	}
+	return genesis, err
+}
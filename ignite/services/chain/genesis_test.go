@@ -0,0 +1,159 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
+)
+
+func TestGenesis(t *testing.T) {
+	savePath := starportSavePath
+	starportSavePath = xfilepath.Path(t.TempDir())
+	t.Cleanup(func() { starportSavePath = savePath })
+
+	dir, err := tempSourceWithApp(t)
+	require.NoError(t, err)
+	home := filepath.Join(t.TempDir(), "home")
+	c, err := New(dir, HomePath(home), ID("mars"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = c.Genesis(ctx, GenesisHome)
+	require.ErrorContains(t, err, "the chain is not initialized")
+	_, err = c.Genesis(ctx, GenesisExported)
+	require.ErrorContains(t, err, "no genesis was exported")
+
+	homeGenesis := filepath.Join(home, "config", "genesis.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(homeGenesis), 0o755))
+	require.NoError(t, os.WriteFile(homeGenesis, []byte(`{"chain_id":"mars","app_state":{"bank":{}}}`), 0o644))
+
+	genesis, err := c.Genesis(ctx, GenesisHome)
+	require.NoError(t, err)
+	require.Equal(t, "mars", genesis["chain_id"])
+	require.Equal(t, []string{"bank"}, genesis.Modules())
+
+	exportedPath, err := c.exportedGenesisPath()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(exportedPath), 0o755))
+	require.NoError(t, os.WriteFile(exportedPath, []byte(`{"chain_id":"mars-exported"}`), 0o644))
+
+	genesis, err = c.Genesis(ctx, GenesisExported)
+	require.NoError(t, err)
+	require.Equal(t, "mars-exported", genesis["chain_id"])
+
+	genesis, err = c.Genesis(ctx, homeGenesis)
+	require.NoError(t, err)
+	require.Equal(t, "mars", genesis["chain_id"])
+}
+
+func TestAddGenesisAccounts(t *testing.T) {
+	savePath := starportSavePath
+	starportSavePath = xfilepath.Path(t.TempDir())
+	t.Cleanup(func() { starportSavePath = savePath })
+
+	dir, err := tempSourceWithApp(t)
+	require.NoError(t, err)
+	home := filepath.Join(t.TempDir(), "home")
+	c, err := New(dir, HomePath(home), ID("mars"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	accountsPath := filepath.Join(dir, "accounts.csv")
+	require.NoError(t, os.WriteFile(accountsPath, []byte(`address,coins
+cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e,"10stake,5token"
+cosmos1ruszzg3rysjjvfeg9y4zktpd9chnqvfje038ze,20stake
+`), 0o644))
+
+	_, err = c.AddGenesisAccounts(ctx, accountsPath)
+	require.ErrorContains(t, err, "the chain is not initialized")
+
+	homeGenesis := filepath.Join(home, "config", "genesis.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(homeGenesis), 0o755))
+	require.NoError(t, os.WriteFile(homeGenesis, []byte(`{"chain_id":"mars","app_state":{"auth":{"accounts":[]},"bank":{"balances":[],"supply":[]}}}`), 0o644))
+
+	summary, err := c.AddGenesisAccounts(ctx, accountsPath)
+	require.NoError(t, err)
+	require.Equal(t, 2, summary.Accounts)
+	require.Equal(t, "30stake,5token", summary.Supply.String())
+
+	genesis, err := c.Genesis(ctx, GenesisHome)
+	require.NoError(t, err)
+	summaries, err := genesis.BankSummary()
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	require.Equal(t, 2, summaries[0].Holders)
+
+	_, err = c.AddGenesisAccounts(ctx, accountsPath)
+	require.ErrorContains(t, err, "already exists in the genesis")
+}
+
+func TestSetGenesisAccountTypes(t *testing.T) {
+	savePath := starportSavePath
+	starportSavePath = xfilepath.Path(t.TempDir())
+	t.Cleanup(func() { starportSavePath = savePath })
+
+	dir, err := tempSourceWithApp(t)
+	require.NoError(t, err)
+	home := filepath.Join(t.TempDir(), "home")
+	c, err := New(dir, HomePath(home), ID("mars"))
+	require.NoError(t, err)
+
+	moduleAddress, err := c.moduleAddress("distribution")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl", moduleAddress)
+
+	const vestingAddress = "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e"
+	homeGenesis := filepath.Join(home, "config", "genesis.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(homeGenesis), 0o755))
+	require.NoError(t, os.WriteFile(homeGenesis, []byte(`{
+  "genesis_time": "2024-01-01T00:00:00Z",
+  "chain_id": "mars",
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "`+vestingAddress+`", "pub_key": null, "account_number": "0", "sequence": "0"},
+        {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "`+moduleAddress+`", "pub_key": null, "account_number": "0", "sequence": "0"}
+      ]
+    }
+  }
+}`), 0o644))
+
+	err = c.setGenesisAccountTypes(map[string]chainconfig.Account{
+		vestingAddress: {
+			Name:  "bob",
+			Coins: []string{"100stake"},
+			Vesting: &base.Vesting{
+				Type:    base.VestingContinuous,
+				EndTime: "2025-01-01T00:00:00Z",
+			},
+		},
+		moduleAddress: {
+			Name:   "distribution",
+			Module: "distribution",
+		},
+	})
+	require.NoError(t, err)
+
+	genesis, err := c.Genesis(context.Background(), GenesisHome)
+	require.NoError(t, err)
+	auth, _ := genesis.Module("auth")
+	accounts := auth.(map[string]interface{})["accounts"].([]interface{})
+
+	vesting := accounts[0].(map[string]interface{})
+	require.Equal(t, "/cosmos.vesting.v1beta1.ContinuousVestingAccount", vesting["@type"])
+	require.Equal(t, "1704067200", vesting["start_time"])
+	require.Equal(t, "1735689600", vesting["base_vesting_account"].(map[string]interface{})["end_time"])
+
+	module := accounts[1].(map[string]interface{})
+	require.Equal(t, "/cosmos.auth.v1beta1.ModuleAccount", module["@type"])
+	require.Equal(t, "distribution", module["name"])
+	require.Equal(t, []interface{}{}, module["permissions"])
+}
@@ -0,0 +1,312 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	"github.com/ignite/cli/v29/ignite/pkg/ociimage"
+)
+
+const (
+	imageUser    = "nonroot"
+	imageUID     = 65532
+	imageHome    = "/home/nonroot"
+	imageBinDir  = "/usr/local/bin"
+	imageEnvPath = "PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+	imageExt     = ".tar"
+)
+
+// imagePorts are the default P2P, RPC, API and gRPC ports of the chain.
+var imagePorts = []string{"26656/tcp", "26657/tcp", "1317/tcp", "9090/tcp"}
+
+// imageCACertificates are the CA certificate bundle paths of the common Linux distributions,
+// the first one is the path of the bundle in the images.
+var imageCACertificates = []string{
+	"/etc/ssl/certs/ca-certificates.crt",
+	"/etc/pki/tls/certs/ca-bundle.crt",
+	"/etc/ssl/cert.pem",
+}
+
+type (
+	// ImageOption configures the image build.
+	ImageOption func(*imageOptions)
+
+	imageOptions struct {
+		initHome       bool
+		reproducible   bool
+		caCertificates bool
+	}
+)
+
+// ImageInitHome adds to the image the chain home initialized with the chain init
+// command, so the containers can start the chain without any setup.
+func ImageInitHome() ImageOption {
+	return func(o *imageOptions) {
+		o.initHome = true
+	}
+}
+
+// ImageReproducible builds the image reproducibly: the binaries are built without
+// local paths and the image creation time is set to SOURCE_DATE_EPOCH, which defaults
+// to the time of the last commit.
+func ImageReproducible() ImageOption {
+	return func(o *imageOptions) {
+		o.reproducible = true
+	}
+}
+
+// ImageHostCACertificates adds the CA certificate bundle of the host to the image,
+// for the chains connecting to TLS services. The image then depends on the host
+// it is built on, even when it is built reproducibly.
+func ImageHostCACertificates() ImageOption {
+	return func(o *imageOptions) {
+		o.caCertificates = true
+	}
+}
+
+// BuildImage builds a container image of the chain named ref, without a container runtime,
+// and saves it in an OCI image layout tarball. targets is a list of linux:GOARCH, one image
+// is built for each target and the tarball contains a multi-platform image when there are
+// many. It defaults to the architecture of your system when no targets are provided.
+// The images run "<appd> start" with the nonroot user and an optional initialized home.
+func (c *Chain) BuildImage(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	buildTags []string,
+	output, ref string,
+	targets []string,
+	options ...ImageOption,
+) (imagePath string, err error) {
+	var imageOptions imageOptions
+	for _, apply := range options {
+		apply(&imageOptions)
+	}
+
+	if len(targets) == 0 {
+		targets = []string{gocmd.BuildTarget(ociimage.OS, runtime.GOARCH)}
+	}
+
+	// prepare for build.
+	if err := c.setup(); err != nil {
+		return "", err
+	}
+
+	var home ociimage.Layer
+	if imageOptions.initHome {
+		if home, err = c.imageHome(ctx, cacheStorage, buildTags); err != nil {
+			return "", err
+		}
+	}
+
+	buildFlags, err := c.preBuild(ctx, cacheStorage, buildTags...)
+	if err != nil {
+		return "", err
+	}
+	buildFlags = append(buildFlags, gocmd.FlagTrimpath)
+
+	created := time.Now()
+	if imageOptions.reproducible {
+		if created, err = c.sourceDateEpoch(); err != nil {
+			return "", err
+		}
+	}
+
+	binary, err := c.Binary()
+	if err != nil {
+		return "", err
+	}
+
+	mainPath, err := c.discoverMain(c.app.Path)
+	if err != nil {
+		return "", err
+	}
+
+	base, err := imageBase(imageOptions.caCertificates)
+	if err != nil {
+		return "", err
+	}
+
+	images := make([]ociimage.Image, 0, len(targets))
+	for _, t := range targets {
+		goos, goarch, err := gocmd.ParseTarget(t)
+		if err != nil {
+			return "", err
+		}
+		if goos != ociimage.OS {
+			return "", errors.Errorf("invalid image target %s: only %s targets are supported", t, ociimage.OS)
+		}
+
+		out, err := os.MkdirTemp("", "")
+		if err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(out)
+
+		// build a static binary, the image doesn't have a C library.
+		env := []string{
+			cmdrunner.Env(gocmd.EnvGOOS, goos),
+			cmdrunner.Env(gocmd.EnvGOARCH, goarch),
+			cmdrunner.Env(gocmd.EnvCGOEnabled, "0"),
+		}
+		if imageOptions.reproducible {
+			env = append(env, cmdrunner.Env(envSourceDateEpoch, strconv.FormatInt(created.Unix(), 10)))
+		}
+		buildOptions := []exec.Option{
+			exec.StepOption(step.Env(env...)),
+		}
+
+		if err := gocmd.BuildPath(ctx, out, binary, mainPath, buildFlags, buildOptions...); err != nil {
+			return "", err
+		}
+
+		content, err := os.ReadFile(filepath.Join(out, binary))
+		if err != nil {
+			return "", err
+		}
+
+		layers := []ociimage.Layer{base, {{Path: path.Join(imageBinDir, binary), Content: content, Mode: 0o755}}}
+		if home != nil {
+			layers = append(layers, home)
+		}
+
+		images = append(images, ociimage.Image{
+			Architecture: goarch,
+			Layers:       layers,
+			Entrypoint:   []string{path.Join(imageBinDir, binary)},
+			Cmd:          []string{"start"},
+			Env:          []string{imageEnvPath, "HOME=" + imageHome},
+			User:         fmt.Sprintf("%d:%d", imageUID, imageUID),
+			WorkingDir:   imageHome,
+			ExposedPorts: imagePorts,
+			Labels: map[string]string{
+				"org.opencontainers.image.title":    c.app.Name,
+				"org.opencontainers.image.version":  c.sourceVersion.tag,
+				"org.opencontainers.image.revision": c.sourceVersion.hash,
+			},
+			Created: created,
+		})
+	}
+
+	imageDir := output
+	if imageDir == "" {
+		imageDir = filepath.Join(c.app.Path, releaseDir)
+	}
+	if err := os.MkdirAll(imageDir, 0o755); err != nil {
+		return "", err
+	}
+
+	c.ev.Send("Writing the image...", events.ProgressUpdate())
+
+	imagePath = filepath.Join(imageDir, imageFileName(ref))
+	f, err := os.Create(imagePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if err := ociimage.WriteArchive(f, ref, images...); err != nil {
+		return "", errors.Errorf("error creating the image archive: %w", err)
+	}
+	return imagePath, f.Close()
+}
+
+// imageHome builds the chain binary in a temporary directory and initializes the
+// chain in a temporary home, returning the home files to copy to the image user home.
+// The installed chain binary is left unchanged.
+func (c *Chain) imageHome(ctx context.Context, cacheStorage cache.Storage, buildTags []string) (ociimage.Layer, error) {
+	binDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(binDir)
+
+	if err := c.build(ctx, cacheStorage, buildTags, binDir, true, false, false); err != nil {
+		return nil, err
+	}
+
+	binary, err := c.Binary()
+	if err != nil {
+		return nil, err
+	}
+
+	defaultHome, err := c.DefaultHome()
+	if err != nil {
+		return nil, err
+	}
+
+	tmpHome, err := os.MkdirTemp("", "")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpHome)
+
+	c.ev.Send("Initializing the image home...", events.ProgressUpdate())
+
+	// initialize a copy of the chain to keep the chain home unchanged.
+	ic := *c
+	ic.SetHome(tmpHome)
+	ic.options.binaryPath = filepath.Join(binDir, binary)
+	if err := ic.Init(ctx, InitArgsAll); err != nil {
+		return nil, err
+	}
+
+	return ociimage.DirFiles(tmpHome, path.Join(imageHome, filepath.Base(defaultHome)), imageUID, imageUID)
+}
+
+// imageBase returns the minimal base layer of the images, with the nonroot user.
+// The host CA certificates are added when caCertificates is true and they are found.
+func imageBase(caCertificates bool) (ociimage.Layer, error) {
+	layer := ociimage.Layer{
+		{
+			Path: "/etc/passwd",
+			Content: []byte(fmt.Sprintf(
+				"root:x:0:0:root:/root:/sbin/nologin\n%[1]s:x:%[2]d:%[2]d:%[1]s:%[3]s:/sbin/nologin\n",
+				imageUser, imageUID, imageHome,
+			)),
+			Mode: 0o644,
+		},
+		{
+			Path:    "/etc/group",
+			Content: []byte(fmt.Sprintf("root:x:0:\n%[1]s:x:%[2]d:\n", imageUser, imageUID)),
+			Mode:    0o644,
+		},
+		{Path: "/root", Dir: true, Mode: 0o700},
+		{Path: "/tmp", Dir: true, Mode: 0o1777},
+		{Path: imageHome, Dir: true, Mode: 0o755, UID: imageUID, GID: imageUID},
+	}
+
+	if !caCertificates {
+		return layer, nil
+	}
+	for _, p := range imageCACertificates {
+		content, err := os.ReadFile(p)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		layer = append(layer, ociimage.File{Path: imageCACertificates[0], Content: content, Mode: 0o644})
+		break
+	}
+	return layer, nil
+}
+
+// imageFileName returns the tarball file name of an image reference.
+func imageFileName(ref string) string {
+	return strings.NewReplacer("/", "_", ":", "_", "@", "_").Replace(ref) + imageExt
+}
@@ -0,0 +1,29 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageFileName(t *testing.T) {
+	require.Equal(t, "mars_v1.0.0.tar", imageFileName("mars:v1.0.0"))
+	require.Equal(t, "ghcr.io_ignite_mars_latest.tar", imageFileName("ghcr.io/ignite/mars:latest"))
+}
+
+func TestImageBase(t *testing.T) {
+	layer, err := imageBase(false)
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, f := range layer {
+		files[f.Path] = string(f.Content)
+	}
+	require.Contains(t, files["/etc/passwd"], "nonroot:x:65532:65532:nonroot:/home/nonroot:/sbin/nologin\n")
+	require.Equal(t, "root:x:0:\nnonroot:x:65532:\n", files["/etc/group"])
+	require.Contains(t, files, "/tmp")
+	require.Contains(t, files, "/home/nonroot")
+
+	// the host CA certificates are only added on demand, so the image doesn't depend on the host.
+	require.NotContains(t, files, "/etc/ssl/certs/ca-certificates.crt")
+}
@@ -95,14 +95,7 @@ func (c *Chain) InitChain(ctx context.Context, initConfiguration, initGenesis bo
 	}
 
 	// init node.
-	var initOptions []string
-	if denom := conf.DefaultDenom; len(denom) > 0 {
-		initOptions = append(initOptions,
-			fmt.Sprintf("--default-denom=%s", denom),
-		)
-	}
-
-	if err := commands.Init(ctx, moniker, initOptions...); err != nil {
+	if err := commands.Init(ctx, moniker, initOptions(conf)...); err != nil {
 		return err
 	}
 
@@ -137,13 +130,23 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 
 	c.ev.Send("Initializing accounts...", events.ProgressUpdate())
 
-	var accounts accountview.Accounts
+	var (
+		accounts        accountview.Accounts
+		specialAccounts = make(map[string]chainconfig.Account)
+	)
 
 	// add accounts from config into genesis
 	for _, account := range cfg.Accounts {
 		var generatedAccount chaincmdrunner.Account
 		accountAddress := account.Address
 
+		// Module accounts addresses are derived from the module name
+		if account.IsModule() {
+			if accountAddress, err = c.moduleAddress(account.Module); err != nil {
+				return err
+			}
+		}
+
 		// If the account doesn't provide an address, we create one
 		if accountAddress == "" {
 			generatedAccount, err = commands.AddAccount(
@@ -165,7 +168,11 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 			return err
 		}
 
-		if account.Address == "" {
+		if account.IsModule() || account.Vesting != nil {
+			specialAccounts[accountAddress] = account
+		}
+
+		if account.Address == "" && !account.IsModule() {
 			accounts = accounts.Append(accountview.NewAccount(
 				generatedAccount.Name,
 				accountAddress,
@@ -176,8 +183,25 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 		}
 	}
 
+	// turn the module and vesting accounts from config into their genesis types
+	if err := c.setGenesisAccountTypes(specialAccounts); err != nil {
+		return err
+	}
+
 	c.ev.SendView(accounts, events.ProgressFinish())
 
+	// add accounts from the accounts file into genesis
+	if path := c.accountsFilePath(cfg); path != "" {
+		summary, err := c.AddGenesisAccounts(ctx, path)
+		if err != nil {
+			return err
+		}
+		c.ev.Send(
+			fmt.Sprintf("Added %d accounts of %s to the genesis", summary.Accounts, cfg.AccountsFile),
+			events.ProgressFinish(),
+		)
+	}
+
 	// 0 length validator set when using network config
 	if len(cfg.Validators) == 0 {
 		return nil
@@ -243,6 +267,17 @@ func (c *Chain) IsInitialized() (bool, error) {
 	return true, nil
 }
 
+// initOptions returns the options of the chain init command.
+func initOptions(conf *chainconfig.Config) (options []string) {
+	if conf == nil {
+		return nil
+	}
+	if denom := conf.DefaultDenom; len(denom) > 0 {
+		options = append(options, fmt.Sprintf("--default-denom=%s", denom))
+	}
+	return options
+}
+
 // UpdateGenesisFile updates the chain genesis with a generic map of data.
 // Updates are made using an override merge strategy.
 func (c Chain) UpdateGenesisFile(data map[string]interface{}) error {
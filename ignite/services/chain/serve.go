@@ -1,13 +1,20 @@
 package chain
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,6 +24,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/config"
 	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
 	"github.com/ignite/cli/v29/ignite/config/chain/defaults"
+	chainconfigv1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
 	"github.com/ignite/cli/v29/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
@@ -55,6 +63,14 @@ const (
 )
 
 var (
+	// appHashMismatchRe matches the errors of CometBFT when the app hash computed by the app
+	// differs from the one stored by the node: the panic of the handshake replay and the
+	// error of the block validation.
+	appHashMismatchRe = regexp.MustCompile(
+		`(?m)(^|: )panic: (block|state)\.AppHash does not match AppHash after replay\. Got\s*[0-9A-F]*, expected [0-9A-F]+\.` +
+			`|\bwrong Block\.Header\.AppHash\.\s+Expected [0-9A-F]+, got [0-9A-Fa-f]+`,
+	)
+
 	// ignoredExts holds a list of ignored files from watching.
 	ignoredExts = []string{"pb.go", "pb.gw.go"}
 
@@ -73,6 +89,8 @@ type serveOptions struct {
 	quitOnFail      bool
 	generateClients bool
 	buildTags       []string
+	fromSnapshot    string
+	hotReload       bool
 }
 
 func newServeOption() serveOptions {
@@ -99,6 +117,21 @@ func ServeResetOnce() ServeOption {
 	}
 }
 
+// ServeFromSnapshot restores the chain home from a named snapshot before the chain is served.
+func ServeFromSnapshot(name string) ServeOption {
+	return func(c *serveOptions) {
+		c.fromSnapshot = name
+	}
+}
+
+// ServeHotReload restarts the rebuilt app on its existing state when the proto files and the
+// consensus versions of the modules didn't change, instead of importing the exported genesis in a new state.
+func ServeHotReload() ServeOption {
+	return func(c *serveOptions) {
+		c.hotReload = true
+	}
+}
+
 // QuitOnFail exits the serve immediately if an error occurs.
 func QuitOnFail() ServeOption {
 	return func(c *serveOptions) {
@@ -163,7 +196,8 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 
 	// blockchain node routine
 	g.Go(func() error {
-		c.refreshServe()
+		// serve the app a first time rebuilding it only if its source changed
+		c.refreshServe("")
 
 		for {
 			if ctx.Err() != nil {
@@ -174,7 +208,7 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 			case <-ctx.Done():
 				return ctx.Err()
 
-			case <-c.serveRefresher:
+			case action := <-c.serveRefresher:
 				commands, err := c.Commands(ctx)
 				if err != nil {
 					return err
@@ -189,20 +223,43 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 
 				serveCtx, c.serveCancel = context.WithCancel(ctx)
 
+				// the code is generated here so it never runs concurrently with a build,
+				// the app is then restarted without being rebuilt.
+				if action == chainconfigv1.WatchActionProto {
+					c.regenerateProto(serveCtx, cacheStorage, serveOptions)
+					action = chainconfigv1.WatchActionRestart
+				}
+
 				// determine if the chain should reset the state
 				shouldReset := serveOptions.forceReset || serveOptions.resetOnce
 
+				// restore the snapshot the first time the app is served
+				fromSnapshot := serveOptions.fromSnapshot != ""
+				if fromSnapshot {
+					c.ev.Send(
+						fmt.Sprintf("Restoring the %s snapshot...", serveOptions.fromSnapshot),
+						events.ProgressUpdate(),
+					)
+					if _, err := c.LoadSnapshot(serveOptions.fromSnapshot); err != nil {
+						return err
+					}
+				}
+
 				// serve the app.
 				err = c.serve(
 					serveCtx,
 					cacheStorage,
 					serveOptions.buildTags,
+					action,
 					shouldReset,
+					fromSnapshot,
+					serveOptions.hotReload,
 					serveOptions.skipProto,
 					serveOptions.skipBuild,
 					serveOptions.generateClients,
 				)
 				serveOptions.resetOnce = false
+				serveOptions.fromSnapshot = ""
 
 				switch {
 				case err == nil:
@@ -275,7 +332,7 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 
 	// routine to watch back-end
 	g.Go(func() error {
-		return c.watchAppBackend(ctx)
+		return c.watchAppBackend(ctx, cacheStorage, serveOptions)
 	})
 
 	return g.Wait()
@@ -297,52 +354,229 @@ func (c *Chain) checkSystem() error {
 	return nil
 }
 
-func (c *Chain) refreshServe() {
+// refreshServe stops the running app and serves it again performing the action.
+func (c *Chain) refreshServe(action chainconfigv1.WatchAction) {
 	if c.serveCancel != nil {
 		c.serveCancel()
 	}
 	// send event changes detected
-	c.serveRefresher <- struct{}{}
+	c.serveRefresher <- action
 }
 
-func (c *Chain) watchAppBackend(ctx context.Context) error {
-	watchPaths := appBackendSourceWatchPaths(defaults.ProtoDir)
-
+func (c *Chain) watchAppBackend(ctx context.Context, cacheStorage cache.Storage, serveOptions serveOptions) error {
+	var (
+		watchPaths = appBackendSourceWatchPaths(defaults.ProtoDir)
+		watch      chainconfigv1.Watch
+	)
 	if c.ConfigPath() != "" {
 		conf, err := c.Config()
 		if err != nil {
 			return err
 		}
 		watchPaths = append(appBackendSourceWatchPaths(conf.Build.Proto.Path), c.ConfigPath())
+		watch = conf.Serve.Watch
+	}
+
+	debounce, err := watch.DebounceDuration()
+	if err != nil {
+		return err
+	}
+	rules, err := newWatchRules(watch.Actions)
+	if err != nil {
+		return err
+	}
+
+	onChange := func(paths []string) {
+		actions := rules.actions(paths)
+		if slices.Contains(actions, chainconfigv1.WatchActionRebuild) {
+			c.refreshServe(chainconfigv1.WatchActionRebuild)
+			return
+		}
+		if slices.Contains(actions, chainconfigv1.WatchActionProto) {
+			c.refreshServe(chainconfigv1.WatchActionProto)
+			return
+		}
+		if slices.Contains(actions, chainconfigv1.WatchActionRestart) {
+			c.refreshServe(chainconfigv1.WatchActionRestart)
+		}
 	}
 
 	return localfs.Watch(
 		ctx,
 		watchPaths,
 		localfs.WatcherWorkdir(c.app.Path),
-		localfs.WatcherOnChange(c.refreshServe),
+		localfs.WatcherOnChangeFiles(onChange),
 		localfs.WatcherIgnoreHidden(),
 		localfs.WatcherIgnoreFolders(),
 		localfs.WatcherIgnoreExt(ignoredExts...),
+		localfs.WatcherInclude(watch.Include...),
+		localfs.WatcherExclude(watch.Exclude...),
+		localfs.WatcherDebounce(debounce),
 	)
 }
 
+// sourceChecksum returns the checksum of the app source: the checksum of the
+// definitions of the app state, see stateChecksum, followed by the checksum of
+// the other source files.
+func (c *Chain) sourceChecksum(protoDir string) ([]byte, error) {
+	checksum, err := c.stateChecksum(protoDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, path := range appBackendSourceWatchPaths(protoDir) {
+		if path != protoDir {
+			paths = append(paths, path)
+		}
+	}
+	sum, err := dirchange.ChecksumFromPaths(c.app.Path, paths...)
+	if errors.Is(err, dirchange.ErrNoFile) {
+		sum = make([]byte, sha256.Size)
+	} else if err != nil {
+		return nil, err
+	}
+	return append(checksum, sum...), nil
+}
+
+// stateChecksum returns the checksum of the definitions of the app state: the proto
+// files and the consensus versions of the modules. The existing state is incompatible
+// with an app built after one of them changed.
+func (c *Chain) stateChecksum(protoDir string) ([]byte, error) {
+	protoSum, err := dirchange.ChecksumFromPaths(c.app.Path, protoDir)
+	if err != nil && !errors.Is(err, dirchange.ErrNoFile) {
+		return nil, err
+	}
+	versions, err := moduleConsensusVersions(c.app.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	h.Write(protoSum)
+	for _, version := range versions {
+		h.Write([]byte(version + "\n"))
+	}
+	return h.Sum(nil), nil
+}
+
+// stateChanged checks if the definitions of the app state changed between two
+// source checksums, in which case the existing state can't be kept.
+func stateChanged(savedSourceChecksum, sourceChecksum []byte) bool {
+	return len(savedSourceChecksum) != len(sourceChecksum) ||
+		!bytes.Equal(savedSourceChecksum[:sha256.Size], sourceChecksum[:sha256.Size])
+}
+
+// moduleConsensusVersions returns the consensus versions of the app modules as "path: version",
+// sorted by path. The versions are the expressions returned by the ConsensusVersion methods
+// of the module files, the constants of the same file are replaced by their value.
+func moduleConsensusVersions(appPath string) ([]string, error) {
+	var files []string
+	for _, pattern := range []string{"x/*/*.go", "x/*/module/*.go"} {
+		matches, err := filepath.Glob(filepath.Join(appPath, pattern))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	slices.Sort(files)
+
+	var versions []string
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			// the files that don't compile are reported by the build.
+			continue
+		}
+
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv == nil || funcDecl.Name.Name != "ConsensusVersion" || funcDecl.Body == nil {
+				continue
+			}
+			for _, stmt := range funcDecl.Body.List {
+				ret, ok := stmt.(*ast.ReturnStmt)
+				if !ok || len(ret.Results) != 1 {
+					continue
+				}
+				version := ret.Results[0]
+				if ident, ok := version.(*ast.Ident); ok {
+					if value := constValue(f, ident.Name); value != nil {
+						version = value
+					}
+				}
+				relPath, err := filepath.Rel(appPath, path)
+				if err != nil {
+					return nil, err
+				}
+				versions = append(versions, fmt.Sprintf("%s: %s", filepath.ToSlash(relPath), types.ExprString(version)))
+			}
+		}
+	}
+	return versions, nil
+}
+
+// constValue returns the value of a constant declared in a file or nil when it is not found.
+func constValue(f *ast.File, name string) ast.Expr {
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, n := range valueSpec.Names {
+				if n.Name == name && i < len(valueSpec.Values) {
+					return valueSpec.Values[i]
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// regenerateProto generates the code from the proto files while the app is stopped.
+// The app is rebuilt with the generated code on the next source change.
+func (c *Chain) regenerateProto(ctx context.Context, cacheStorage cache.Storage, serveOptions serveOptions) {
+	if serveOptions.skipProto {
+		c.ev.SendInfo("Skip proto activated. Code won't be generated from proto files")
+		return
+	}
+
+	if err := c.generateFromConfig(ctx, cacheStorage, serveOptions.generateClients); err != nil {
+		c.ev.SendView(errorview.NewError(err), events.ProgressFinish(), events.Group(events.GroupError))
+		return
+	}
+
+	c.ev.Send("Code generated from proto files", events.Icon(icons.OK), events.ProgressFinish())
+}
+
 // serve performs the operations to serve the blockchain: build, init and start.
 // If the chain is already initialized and the file weren't changed, the app is directly started.
 // If the files changed, the state is imported.
+// If the chain home has been restored from a snapshot, the restored state is started as is.
+// With hot reload, the app is restarted on its existing state when the proto files and the module
+// consensus versions didn't change, and the state is imported only if the new app can't start on it.
+// The action forces the app to be rebuilt or only restarted, when empty the app is rebuilt
+// if its source changed.
 func (c *Chain) serve(
 	ctx context.Context,
 	cacheStorage cache.Storage,
 	buildTags []string,
-	forceReset, skipProto, skipBuild, generateClients bool,
+	action chainconfigv1.WatchAction,
+	forceReset, fromSnapshot, hotReload, skipProto, skipBuild, generateClients bool,
 ) error {
 	conf, err := c.Config()
 	if err != nil {
 		return &CannotBuildAppError{err}
 	}
 
-	sourceWatchPaths := appBackendSourceWatchPaths(conf.Build.Proto.Path)
-
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
@@ -359,7 +593,7 @@ func (c *Chain) serve(
 	if err != nil {
 		return err
 	}
-	if isInit {
+	if isInit && !fromSnapshot {
 		configModified := false
 		if c.ConfigPath() != "" {
 			configModified, err = dirchange.HasDirChecksumChanged(dirCache, configChecksumKey, c.app.Path, c.ConfigPath())
@@ -377,10 +611,19 @@ func (c *Chain) serve(
 
 	// check if source has been modified since last serve
 	// if the state must not be reset but the source has changed, we rebuild the chain and import the exported state
-	sourceModified, err := dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, c.app.Path, sourceWatchPaths...)
+	savedSourceChecksum, err := dirCache.Get(sourceChecksumKey)
+	if err != nil && !errors.Is(err, cache.ErrorNotFound) {
+		return err
+	}
+	sourceChecksum, err := c.sourceChecksum(conf.Build.Proto.Path)
 	if err != nil {
 		return err
 	}
+	sourceModified := !bytes.Equal(savedSourceChecksum, sourceChecksum)
+
+	// the proto files and the consensus versions of the modules define the state,
+	// the existing state can only be kept by a rebuilt app when they didn't change.
+	stateModified := stateChanged(savedSourceChecksum, sourceChecksum)
 
 	// we also consider the binary in the checksum to ensure the binary has not been changed by a third party
 	var binaryModified bool
@@ -401,7 +644,16 @@ func (c *Chain) serve(
 		}
 	}
 
-	appModified := sourceModified || binaryModified
+	var appModified bool
+	switch action {
+	case chainconfigv1.WatchActionRebuild:
+		appModified = true
+	case chainconfigv1.WatchActionRestart:
+		// source changes are ignored, the app is rebuilt with them on the next change.
+		appModified = binaryModified
+	default:
+		appModified = sourceModified || binaryModified
+	}
 
 	// check if exported genesis exists
 	exportGenesisExists := true
@@ -422,15 +674,18 @@ func (c *Chain) serve(
 		c.ev.SendInfo("Skip building activated. Binary won't be rebuilt, nor refresh on changes")
 	}
 
+	var built bool
 	if (!isInit || appModified) && !skipBuild {
 		// build the blockchain app
 		if err := c.build(ctx, cacheStorage, buildTags, "", skipProto, generateClients, true); err != nil {
 			return err
 		}
+		built = true
 	}
 
 	// init phase
-	initApp := !isInit || (appModified && !exportGenesisExists)
+	keepState := hotReload && isInit && appModified && !stateModified && !fromSnapshot
+	initApp := !isInit || (appModified && !exportGenesisExists && !fromSnapshot && !keepState)
 
 	//nolint:gocritic
 	if initApp {
@@ -439,16 +694,14 @@ func (c *Chain) serve(
 		if err := c.Init(ctx, InitArgsAll); err != nil {
 			return err
 		}
-	} else if appModified {
+	} else if keepState {
+		c.ev.Send("Proto files and consensus versions unchanged, restarting the app on the existing state...", events.ProgressUpdate())
+	} else if appModified && !fromSnapshot {
 		// if the chain is already initialized but the source has been modified
 		// we reset the chain database and import the genesis state
 		c.ev.Send("Existent genesis detected, restoring the database...", events.ProgressUpdate())
 
-		if err := commands.UnsafeReset(ctx); err != nil {
-			return err
-		}
-
-		if err := c.importChainState(); err != nil {
+		if err := c.restoreChainState(ctx, commands); err != nil {
 			return err
 		}
 	} else {
@@ -462,8 +715,12 @@ func (c *Chain) serve(
 		}
 	}
 
-	if err := dirchange.SaveDirChecksum(dirCache, sourceChecksumKey, c.app.Path, sourceWatchPaths...); err != nil {
-		return err
+	// the source checksums are only saved once the app is built from the source,
+	// so the changes of a restarted app are built on the next serve.
+	if built || skipBuild || !sourceModified {
+		if err := dirCache.Put(sourceChecksumKey, sourceChecksum); err != nil {
+			return err
+		}
 	}
 
 	if err := dirchange.SaveDirChecksum(dirCache, binaryChecksumKey, "", binaryPath); err != nil {
@@ -487,6 +744,31 @@ func (c *Chain) serve(
 	}
 
 	// start the blockchain
+	err = c.start(ctx, conf)
+	if !keepState {
+		return err
+	}
+
+	// the new app computed a different state than the one kept, fallback to the exported genesis
+	var startErr *CannotStartAppError
+	if ctx.Err() != nil || !errors.As(err, &startErr) || !startErr.IsAppHashMismatch() {
+		return err
+	}
+
+	if exportGenesisExists {
+		c.ev.Send("App hash mismatch, the existing state is incompatible with the new app. Restoring the exported genesis...", events.ProgressUpdate())
+
+		if err := c.restoreChainState(ctx, commands); err != nil {
+			return err
+		}
+	} else {
+		c.ev.Send("App hash mismatch, the existing state is incompatible with the new app. Initializing the app...", events.ProgressUpdate())
+
+		if err := c.Init(ctx, InitArgsAll); err != nil {
+			return err
+		}
+	}
+
 	return c.start(ctx, conf)
 }
 
@@ -599,6 +881,15 @@ func (c *Chain) saveChainState(ctx context.Context, commands chaincmdrunner.Runn
 	return commands.Export(ctx, genesisPath)
 }
 
+// restoreChainState resets the chain database and imports the saved genesis.
+func (c *Chain) restoreChainState(ctx context.Context, commands chaincmdrunner.Runner) error {
+	if err := commands.UnsafeReset(ctx); err != nil {
+		return err
+	}
+
+	return c.importChainState()
+}
+
 // importChainState imports the saved genesis in chain config to use it as the genesis.
 func (c *Chain) importChainState() error {
 	exportGenesisPath, err := c.exportedGenesisPath()
@@ -691,3 +982,13 @@ func (e *CannotStartAppError) ParseStartError() string {
 		return ""
 	}
 }
+
+// IsAppHashMismatch checks if the app failed to start because the app hash it computed
+// differs from the one stored by the node.
+func (e *CannotStartAppError) IsAppHashMismatch() bool {
+	if e.Err == nil {
+		return false
+	}
+
+	return appHashMismatchRe.MatchString(e.Err.Error())
+}
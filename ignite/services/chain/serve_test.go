@@ -0,0 +1,146 @@
+package chain
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func TestCannotStartAppErrorIsAppHashMismatch(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "replay app hash mismatch",
+			err: errors.Wrap(errors.New(`panic: state.AppHash does not match AppHash after replay. Got
+1A2B, expected 3C4D.`), "exit status 2"),
+			want: true,
+		},
+		{
+			name: "replay block app hash mismatch",
+			err:  errors.New("I[2024-01-01] starting ABCI\npanic: block.AppHash does not match AppHash after replay. Got 1A2B, expected 3C4D."),
+			want: true,
+		},
+		{
+			name: "block app hash mismatch",
+			err:  errors.New(`ERR error in validation err="wrong Block.Header.AppHash.  Expected 1A2B, got 3c4d"`),
+			want: true,
+		},
+		{
+			name: "app hash mismatch mentioned in a log",
+			err:  errors.New("INF checking the block does not match AppHash after replay"),
+		},
+		{
+			name: "app hash mismatch without hashes",
+			err:  errors.New("failed: wrong Block.Header.AppHash"),
+		},
+		{
+			name: "other error",
+			err:  errors.New("listen tcp 0.0.0.0:26657: bind: address already in use"),
+		},
+		{
+			name: "no error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &CannotStartAppError{AppName: "marsd", Err: tt.err}
+			require.Equal(t, tt.want, err.IsAppHashMismatch())
+		})
+	}
+}
+
+func TestChainSourceChecksum(t *testing.T) {
+	appPath := t.TempDir()
+	writeFile := func(path, content string) {
+		path = filepath.Join(appPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	writeFile("x/mars/keeper/keeper.go", "package keeper")
+	writeFile("proto/mars/tx.proto", `syntax = "proto3";`)
+
+	c := &Chain{app: App{Path: appPath}}
+	checksum, err := c.sourceChecksum("proto")
+	require.NoError(t, err)
+	require.Len(t, checksum, 2*sha256.Size)
+
+	// a source change only changes the checksum of the source files.
+	writeFile("x/mars/keeper/keeper.go", "package keeper\n")
+	sourceChanged, err := c.sourceChecksum("proto")
+	require.NoError(t, err)
+	require.Equal(t, checksum[:sha256.Size], sourceChanged[:sha256.Size])
+	require.NotEqual(t, checksum[sha256.Size:], sourceChanged[sha256.Size:])
+
+	// a proto change changes the checksum of the proto files.
+	writeFile("proto/mars/tx.proto", `syntax = "proto3";\n`)
+	protoChanged, err := c.sourceChecksum("proto")
+	require.NoError(t, err)
+	require.NotEqual(t, sourceChanged[:sha256.Size], protoChanged[:sha256.Size])
+	require.Equal(t, sourceChanged[sha256.Size:], protoChanged[sha256.Size:])
+}
+
+func TestStateChanged(t *testing.T) {
+	appPath := t.TempDir()
+	writeFile := func(path, content string) {
+		path = filepath.Join(appPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	writeFile("x/mars/keeper/keeper.go", "package keeper")
+	writeFile("x/mars/module/module.go", "package mars\n\nfunc (AppModule) ConsensusVersion() uint64 { return 1 }\n")
+	writeFile("proto/mars/tx.proto", `syntax = "proto3";`)
+
+	c := &Chain{app: App{Path: appPath}}
+	checksum := func() []byte {
+		sum, err := c.sourceChecksum("proto")
+		require.NoError(t, err)
+		return sum
+	}
+	saved := checksum()
+
+	// the state is reset without a saved checksum.
+	require.True(t, stateChanged(nil, saved))
+
+	// the state is kept when only the Go code changed.
+	writeFile("x/mars/keeper/keeper.go", "package keeper\n")
+	require.False(t, stateChanged(saved, checksum()))
+
+	// the state is reset when the consensus version of a module changed.
+	writeFile("x/mars/module/module.go", "package mars\n\nconst version = 2\n\nfunc (am AppModule) ConsensusVersion() uint64 {\n\treturn version\n}\n")
+	require.True(t, stateChanged(saved, checksum()))
+	saved = checksum()
+
+	// the state is reset when a module is added.
+	writeFile("x/venus/module/module.go", "package venus\n\nfunc (AppModule) ConsensusVersion() uint64 { return 1 }\n")
+	require.True(t, stateChanged(saved, checksum()))
+	saved = checksum()
+
+	// the state is reset when the proto files changed.
+	writeFile("proto/mars/tx.proto", `syntax = "proto3";\n`)
+	require.True(t, stateChanged(saved, checksum()))
+}
+
+func TestModuleConsensusVersions(t *testing.T) {
+	appPath := t.TempDir()
+	writeFile := func(path, content string) {
+		path = filepath.Join(appPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	writeFile("x/mars/module/module.go", "package mars\n\nconst version = 2\n\nfunc (AppModule) ConsensusVersion() uint64 { return version }\n")
+	writeFile("x/venus/module.go", "package venus\n\nfunc (AppModule) ConsensusVersion() uint64 { return 3 }\n")
+	writeFile("x/venus/genesis.go", "package venus\n\nfunc ConsensusVersion() uint64 { return 1 }\n")
+	writeFile("x/earth/module/module.go", "package earth\n\nfunc (AppModule) ConsensusVersion( {")
+
+	versions, err := moduleConsensusVersions(appPath)
+	require.NoError(t, err)
+	require.Equal(t, []string{"x/mars/module/module.go: 2", "x/venus/module.go: 3"}, versions)
+}
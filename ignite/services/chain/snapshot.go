@@ -0,0 +1,325 @@
+package chain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/otiai10/copy"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// snapshotsDir is the name of the directory where the snapshots of a chain are saved.
+	snapshotsDir = "snapshots"
+
+	// snapshotHomeDir is the name of the copy of the chain home inside a snapshot.
+	snapshotHomeDir = "home"
+
+	// snapshotMetadataFile is the name of the file describing a snapshot.
+	snapshotMetadataFile = "snapshot.json"
+)
+
+var (
+	// ErrSnapshotNotFound is returned when a snapshot doesn't exist.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+
+	// ErrSnapshotExists is returned when a snapshot is saved with the name of an existing snapshot.
+	ErrSnapshotExists = errors.New("snapshot already exists")
+
+	// ErrSnapshotChainID is returned when a snapshot of another chain is loaded.
+	ErrSnapshotChainID = errors.New("snapshot of another chain")
+
+	// ErrHomeInUse is returned when a snapshot is saved or loaded while a node uses the chain home.
+	ErrHomeInUse = errors.New("the chain home is in use, stop the chain first")
+)
+
+var snapshotNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// Snapshot is a named copy of the chain home, including its data, config and keyring,
+// and of the genesis exported when the chain was last served.
+type Snapshot struct {
+	Name      string    `json:"name"`
+	ChainID   string    `json:"chain_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SaveSnapshot saves the chain home as a named snapshot.
+// The chain must not be running while the snapshot is saved.
+func (c *Chain) SaveSnapshot(name string, overwrite bool) (Snapshot, error) {
+	if err := validateSnapshotName(name); err != nil {
+		return Snapshot{}, err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if _, err := os.Stat(home); os.IsNotExist(err) {
+		return Snapshot{}, errors.Errorf("the chain home %s doesn't exist, initialize the chain first", home)
+	} else if err != nil {
+		return Snapshot{}, err
+	}
+	if err := checkHomeNotInUse(home); err != nil {
+		return Snapshot{}, err
+	}
+
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if _, err := os.Stat(path); err == nil && !overwrite {
+		return Snapshot{}, errors.Wrap(ErrSnapshotExists, name)
+	} else if err != nil && !os.IsNotExist(err) {
+		return Snapshot{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	snapshot := Snapshot{
+		Name:      name,
+		ChainID:   chainID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	// the snapshot is written next to its final path, so an existing snapshot
+	// is only replaced once the copy is complete.
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return Snapshot{}, err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return Snapshot{}, err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	if err := copy.Copy(home, filepath.Join(tmpPath, snapshotHomeDir)); err != nil {
+		return Snapshot{}, errors.Wrapf(err, "cannot copy the chain home %s", home)
+	}
+
+	genesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if _, err := os.Stat(genesisPath); err == nil {
+		if err := copy.Copy(genesisPath, filepath.Join(tmpPath, exportedGenesis)); err != nil {
+			return Snapshot{}, err
+		}
+	} else if !os.IsNotExist(err) {
+		return Snapshot{}, err
+	}
+
+	metadata, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if err := os.WriteFile(filepath.Join(tmpPath, snapshotMetadataFile), metadata, 0o600); err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		return Snapshot{}, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// LoadSnapshot replaces the chain home with the one saved in a snapshot.
+// The snapshot must be of the same chain ID and the chain must not be
+// running while the snapshot is loaded.
+func (c *Chain) LoadSnapshot(name string) (Snapshot, error) {
+	snapshot, path, err := c.snapshot(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if snapshot.ChainID != chainID {
+		return Snapshot{}, errors.Wrapf(
+			ErrSnapshotChainID,
+			"the %s snapshot chain ID is %s, not %s",
+			name,
+			snapshot.ChainID,
+			chainID,
+		)
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if err := checkHomeNotInUse(home); err != nil {
+		return Snapshot{}, err
+	}
+
+	// the home is copied next to the current one so it is only
+	// replaced once the copy is complete.
+	tmpHome := home + ".snapshot"
+	if err := os.RemoveAll(tmpHome); err != nil {
+		return Snapshot{}, err
+	}
+	defer os.RemoveAll(tmpHome)
+
+	if err := copy.Copy(filepath.Join(path, snapshotHomeDir), tmpHome); err != nil {
+		return Snapshot{}, errors.Wrapf(err, "cannot copy the %s snapshot", name)
+	}
+	if err := os.RemoveAll(home); err != nil {
+		return Snapshot{}, err
+	}
+	if err := os.Rename(tmpHome, home); err != nil {
+		return Snapshot{}, err
+	}
+
+	// restore the exported genesis of the snapshot, so the state imported
+	// on the next source change is the one of the snapshot.
+	genesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	snapshotGenesisPath := filepath.Join(path, exportedGenesis)
+	if _, err := os.Stat(snapshotGenesisPath); err == nil {
+		if err := copy.Copy(snapshotGenesisPath, genesisPath); err != nil {
+			return Snapshot{}, err
+		}
+	} else if !os.IsNotExist(err) {
+		return Snapshot{}, err
+	} else if err := os.Remove(genesisPath); err != nil && !os.IsNotExist(err) {
+		return Snapshot{}, err
+	}
+
+	return snapshot, nil
+}
+
+// Snapshots returns the snapshots of the chain sorted by name.
+func (c *Chain) Snapshots() ([]Snapshot, error) {
+	dir, err := c.snapshotsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var snapshots []Snapshot
+	for _, entry := range entries {
+		// skip the snapshots being written
+		if !entry.IsDir() || !snapshotNameRe.MatchString(entry.Name()) {
+			continue
+		}
+
+		snapshot, _, err := c.snapshot(entry.Name())
+		if errors.Is(err, ErrSnapshotNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Name < snapshots[j].Name
+	})
+	return snapshots, nil
+}
+
+// DeleteSnapshot deletes a snapshot of the chain.
+func (c *Chain) DeleteSnapshot(name string) error {
+	_, path, err := c.snapshot(name)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(path)
+}
+
+// snapshot returns a snapshot of the chain and its path.
+func (c *Chain) snapshot(name string) (Snapshot, string, error) {
+	if err := validateSnapshotName(name); err != nil {
+		return Snapshot{}, "", err
+	}
+
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, "", err
+	}
+
+	metadata, err := os.ReadFile(filepath.Join(path, snapshotMetadataFile))
+	if os.IsNotExist(err) {
+		return Snapshot{}, "", errors.Wrap(ErrSnapshotNotFound, name)
+	} else if err != nil {
+		return Snapshot{}, "", err
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(metadata, &snapshot); err != nil {
+		return Snapshot{}, "", errors.Wrapf(err, "invalid metadata of the %s snapshot", name)
+	}
+	return snapshot, path, nil
+}
+
+// snapshotsPath returns the path where the snapshots of the chain are saved.
+func (c *Chain) snapshotsPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(savePath, snapshotsDir), nil
+}
+
+// snapshotPath returns the path of a snapshot of the chain.
+func (c *Chain) snapshotPath(name string) (string, error) {
+	dir, err := c.snapshotsPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// checkHomeNotInUse checks that no node uses the chain home. A running node
+// holds the lock files of its databases in the data directory of the home.
+func checkHomeNotInUse(home string) error {
+	locks, err := filepath.Glob(filepath.Join(home, "data", "*.db", "LOCK"))
+	if err != nil {
+		return err
+	}
+	for _, path := range locks {
+		lock := flock.New(path)
+		locked, err := lock.TryLock()
+		if err != nil {
+			return errors.Wrapf(err, "cannot check the lock %s", path)
+		}
+		if !locked {
+			return errors.Wrap(ErrHomeInUse, home)
+		}
+		if err := lock.Unlock(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSnapshotName(name string) error {
+	if !snapshotNameRe.MatchString(name) {
+		return errors.Errorf(
+			"invalid snapshot name %q, it must start with a letter or a digit and contain only letters, digits, '-' and '_'",
+			name,
+		)
+	}
+	return nil
+}
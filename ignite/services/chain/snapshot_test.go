@@ -0,0 +1,158 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofrs/flock"
+	"github.com/otiai10/copy"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
+)
+
+func TestSnapshots(t *testing.T) {
+	savePath := starportSavePath
+	starportSavePath = xfilepath.Path(t.TempDir())
+	t.Cleanup(func() { starportSavePath = savePath })
+
+	dir, err := tempSourceWithApp(t)
+	require.NoError(t, err)
+	home := filepath.Join(t.TempDir(), "home")
+	c, err := New(dir, HomePath(home), ID("mars"))
+	require.NoError(t, err)
+
+	genesisPath, err := c.exportedGenesisPath()
+	require.NoError(t, err)
+	dataPath := filepath.Join(home, "data", "state.db")
+
+	writeFile := func(path, content string) {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	requireFile := func(path, content string) {
+		t.Helper()
+		got, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, content, string(got))
+	}
+
+	t.Run("save without home", func(t *testing.T) {
+		_, err := c.SaveSnapshot("empty", false)
+		require.ErrorContains(t, err, "doesn't exist")
+	})
+
+	writeFile(dataPath, "v1")
+	writeFile(filepath.Join(home, "keyring-test", "alice.info"), "alice")
+
+	t.Run("save", func(t *testing.T) {
+		s, err := c.SaveSnapshot("no-genesis", false)
+		require.NoError(t, err)
+		require.Equal(t, "no-genesis", s.Name)
+		require.Equal(t, "mars", s.ChainID)
+
+		writeFile(genesisPath, "genesis v1")
+		_, err = c.SaveSnapshot("v1", false)
+		require.NoError(t, err)
+	})
+
+	t.Run("save existing", func(t *testing.T) {
+		_, err := c.SaveSnapshot("v1", false)
+		require.True(t, errors.Is(err, ErrSnapshotExists))
+
+		_, err = c.SaveSnapshot("v1", true)
+		require.NoError(t, err)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		snapshots, err := c.Snapshots()
+		require.NoError(t, err)
+		require.Len(t, snapshots, 2)
+		require.Equal(t, "no-genesis", snapshots[0].Name)
+		require.Equal(t, "v1", snapshots[1].Name)
+	})
+
+	t.Run("load", func(t *testing.T) {
+		writeFile(dataPath, "v2")
+		writeFile(filepath.Join(home, "data", "new.db"), "new")
+		writeFile(genesisPath, "genesis v2")
+
+		s, err := c.LoadSnapshot("v1")
+		require.NoError(t, err)
+		require.Equal(t, "v1", s.Name)
+		requireFile(dataPath, "v1")
+		requireFile(filepath.Join(home, "keyring-test", "alice.info"), "alice")
+		requireFile(genesisPath, "genesis v1")
+		require.NoFileExists(t, filepath.Join(home, "data", "new.db"))
+		require.NoDirExists(t, home+".snapshot")
+	})
+
+	t.Run("load without exported genesis", func(t *testing.T) {
+		_, err := c.LoadSnapshot("no-genesis")
+		require.NoError(t, err)
+		requireFile(dataPath, "v1")
+		require.NoFileExists(t, genesisPath)
+	})
+
+	t.Run("load snapshot of another chain", func(t *testing.T) {
+		other, err := New(dir, HomePath(home), ID("venus"))
+		require.NoError(t, err)
+
+		// the snapshots are saved by chain ID, copy the one of mars.
+		path, err := c.snapshotPath("v1")
+		require.NoError(t, err)
+		otherPath, err := other.snapshotPath("v1")
+		require.NoError(t, err)
+		require.NoError(t, copy.Copy(path, otherPath))
+
+		_, err = other.LoadSnapshot("v1")
+		require.True(t, errors.Is(err, ErrSnapshotChainID))
+		requireFile(dataPath, "v1")
+	})
+
+	t.Run("home in use", func(t *testing.T) {
+		// a running node locks its databases.
+		writeFile(filepath.Join(home, "data", "application.db", "LOCK"), "")
+		lock := flock.New(filepath.Join(home, "data", "application.db", "LOCK"))
+		require.NoError(t, lock.Lock())
+
+		_, err := c.LoadSnapshot("v1")
+		require.True(t, errors.Is(err, ErrHomeInUse))
+		require.FileExists(t, filepath.Join(home, "data", "application.db", "LOCK"))
+
+		_, err = c.SaveSnapshot("in-use", false)
+		require.True(t, errors.Is(err, ErrHomeInUse))
+
+		require.NoError(t, lock.Unlock())
+		_, err = c.LoadSnapshot("v1")
+		require.NoError(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, c.DeleteSnapshot("no-genesis"))
+
+		snapshots, err := c.Snapshots()
+		require.NoError(t, err)
+		require.Len(t, snapshots, 1)
+		require.Equal(t, "v1", snapshots[0].Name)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := c.LoadSnapshot("no-genesis")
+		require.True(t, errors.Is(err, ErrSnapshotNotFound))
+
+		err = c.DeleteSnapshot("no-genesis")
+		require.True(t, errors.Is(err, ErrSnapshotNotFound))
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := c.SaveSnapshot("../v1", false)
+		require.ErrorContains(t, err, "invalid snapshot name")
+
+		_, err = c.LoadSnapshot(".tmp")
+		require.ErrorContains(t, err, "invalid snapshot name")
+	})
+}
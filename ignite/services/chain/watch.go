@@ -0,0 +1,59 @@
+package chain
+
+import (
+	"slices"
+
+	"github.com/gobwas/glob"
+
+	chainconfigv1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// watchRule is a compiled serve watch action rule.
+type watchRule struct {
+	globs  []glob.Glob
+	action chainconfigv1.WatchAction
+}
+
+// watchRules holds the actions to perform when the watched files change.
+type watchRules []watchRule
+
+func newWatchRules(rules []chainconfigv1.WatchRule) (watchRules, error) {
+	compiled := make(watchRules, 0, len(rules))
+	for _, r := range rules {
+		rule := watchRule{action: r.Action}
+		for _, p := range r.Paths {
+			g, err := glob.Compile(p, '/')
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid serve watch pattern %q", p)
+			}
+			rule.globs = append(rule.globs, g)
+		}
+		compiled = append(compiled, rule)
+	}
+	return compiled, nil
+}
+
+// action returns the action of the first rule matching the path.
+// The app is rebuilt when no rule matches.
+func (rules watchRules) action(path string) chainconfigv1.WatchAction {
+	for _, r := range rules {
+		for _, g := range r.globs {
+			if g.Match(path) {
+				return r.action
+			}
+		}
+	}
+	return chainconfigv1.WatchActionRebuild
+}
+
+// actions returns the actions to perform when the paths change.
+func (rules watchRules) actions(paths []string) []chainconfigv1.WatchAction {
+	var actions []chainconfigv1.WatchAction
+	for _, path := range paths {
+		if action := rules.action(path); !slices.Contains(actions, action) {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
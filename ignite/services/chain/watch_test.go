@@ -0,0 +1,48 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfigv1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
+)
+
+func TestWatchRulesActions(t *testing.T) {
+	rules, err := newWatchRules([]chainconfigv1.WatchRule{
+		{Paths: []string{"proto/**"}, Action: chainconfigv1.WatchActionProto},
+		{Paths: []string{"docs/**", "config.yml"}, Action: chainconfigv1.WatchActionRestart},
+		{Paths: []string{"docs/*.go"}, Action: chainconfigv1.WatchActionRebuild},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  []chainconfigv1.WatchAction
+	}{
+		{
+			name:  "no rule",
+			paths: []string{"x/mars/keeper/keeper.go"},
+			want:  []chainconfigv1.WatchAction{chainconfigv1.WatchActionRebuild},
+		},
+		{
+			name:  "first matching rule",
+			paths: []string{"docs/docs.go"},
+			want:  []chainconfigv1.WatchAction{chainconfigv1.WatchActionRestart},
+		},
+		{
+			name:  "many paths",
+			paths: []string{"proto/mars/tx.proto", "proto/mars/query.proto", "config.yml"},
+			want:  []chainconfigv1.WatchAction{chainconfigv1.WatchActionProto, chainconfigv1.WatchActionRestart},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, rules.actions(tt.paths))
+		})
+	}
+
+	_, err = newWatchRules([]chainconfigv1.WatchRule{{Paths: []string{"[a"}}})
+	require.ErrorContains(t, err, `invalid serve watch pattern "[a"`)
+}
@@ -21,8 +21,8 @@ const (
 	assetListFilename = "assetlist.json"
 )
 
-// CreateChainRegistryFiles generates the chain registry files in the scaffolded chains.
-func (s Scaffolder) CreateChainRegistryFiles(chain *chain.Chain, cfg *chainconfig.Config) error {
+// CreateChainRegistryFiles generates the chain registry files of the scaffolded chain in outPath.
+func (s Scaffolder) CreateChainRegistryFiles(chain *chain.Chain, cfg *chainconfig.Config, outPath string) error {
 	binaryName, err := chain.Binary()
 	if err != nil {
 		return errors.Wrap(err, "failed to get binary name")
@@ -182,11 +182,11 @@ func (s Scaffolder) CreateChainRegistryFiles(chain *chain.Chain, cfg *chainconfi
 		},
 	}
 
-	if err := chainData.SaveJSON(chainFilename); err != nil {
+	if err := chainData.SaveJSON(filepath.Join(outPath, chainFilename)); err != nil {
 		return err
 	}
 
-	if err := assetListData.SaveJSON(assetListFilename); err != nil {
+	if err := assetListData.SaveJSON(filepath.Join(outPath, assetListFilename)); err != nil {
 		return err
 	}
 
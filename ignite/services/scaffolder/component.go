@@ -10,9 +10,13 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/gobuffalo/genny/v2"
+
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/v29/ignite/templates/enum"
+	"github.com/ignite/cli/v29/ignite/templates/field"
 	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
 )
 
@@ -153,6 +157,22 @@ func checkCustomTypes(ctx context.Context, appPath, appName, protoDir, module st
 	return protoanalysis.HasMessages(ctx, path, customFieldTypes...)
 }
 
+// enumGenerator returns the generator to scaffold the proto files of the enums used by the fields.
+func (s Scaffolder) enumGenerator(moduleName string, fields ...field.Fields) *genny.Generator {
+	var allFields field.Fields
+	for _, f := range fields {
+		allFields = append(allFields, f...)
+	}
+	return enum.NewGenerator(&enum.Options{
+		AppName:    s.modpath.Package,
+		ProtoDir:   s.protoDir,
+		ProtoVer:   "v1", // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		Fields:     allFields,
+	})
+}
+
 // checkForbiddenComponentName returns true if the name is forbidden as a component name.
 func checkForbiddenComponentName(name multiformatname.Name) error {
 	// Check with names already used from the scaffolded code
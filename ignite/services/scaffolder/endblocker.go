@@ -0,0 +1,88 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/endblocker"
+)
+
+// AddEndBlocker adds a queue of scheduled items processed by the end blocker of a scaffolded module.
+// The items are ordered by block height or block time, at most maxPerBlock items are processed per block.
+// if no module is given, the queue is scaffolded inside the app's default module.
+func (s Scaffolder) AddEndBlocker(moduleName, queueName, queueBy string, maxPerBlock uint64) error {
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	name, err := multiformatname.NewName(queueName)
+	if err != nil {
+		return err
+	}
+	if err := checkGoReservedWord(name.LowerCamel); err != nil {
+		return err
+	}
+	if err := checkQueueCreated(s.appPath, moduleName, name); err != nil {
+		return err
+	}
+	if err := checkQueueOptions(queueBy, maxPerBlock); err != nil {
+		return err
+	}
+
+	g, err := endblocker.NewGenerator(&endblocker.Options{
+		ModuleName:  moduleName,
+		ModulePath:  s.modpath.RawPath,
+		QueueName:   name,
+		QueueBy:     queueBy,
+		MaxPerBlock: maxPerBlock,
+	})
+	if err != nil {
+		return err
+	}
+	return s.Run(g)
+}
+
+// checkQueueOptions checks the ordering and the per block cap of a queue.
+func checkQueueOptions(queueBy string, maxPerBlock uint64) error {
+	switch queueBy {
+	case endblocker.QueueByHeight, endblocker.QueueByTime:
+	default:
+		return errors.Errorf(
+			"invalid queue order %s, must be %s or %s",
+			queueBy,
+			endblocker.QueueByHeight,
+			endblocker.QueueByTime,
+		)
+	}
+	if maxPerBlock == 0 {
+		return errors.New("the maximum number of items processed per block must be greater than zero")
+	}
+	return nil
+}
+
+// checkQueueCreated checks if the queue has been already created in the module.
+func checkQueueCreated(appPath, moduleName string, name multiformatname.Name) error {
+	path := filepath.Join(appPath, "x", moduleName, "keeper", fmt.Sprintf("queue_%s.go", name.Snake))
+	if _, err := os.Stat(path); err == nil {
+		return errors.Errorf("queue %s already exists in module %s", name.Original, moduleName)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
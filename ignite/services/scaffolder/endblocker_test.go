@@ -0,0 +1,14 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckQueueOptions(t *testing.T) {
+	require.NoError(t, checkQueueOptions("height", 100))
+	require.NoError(t, checkQueueOptions("time", 1))
+	require.EqualError(t, checkQueueOptions("block", 100), "invalid queue order block, must be height or time")
+	require.EqualError(t, checkQueueOptions("height", 0), "the maximum number of items processed per block must be greater than zero")
+}
@@ -0,0 +1,82 @@
+package scaffolder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/templates/event"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// AddEvent adds a new typed event and its keeper emitter to a scaffolded module.
+// if no module is given, the event is scaffolded inside the app's default module.
+func (s Scaffolder) AddEvent(ctx context.Context, moduleName, eventName string, fields ...string) error {
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	name, err := multiformatname.NewName(eventName)
+	if err != nil {
+		return err
+	}
+
+	opts := &event.Options{
+		AppName:    s.modpath.Package,
+		ProtoDir:   s.protoDir,
+		ProtoVer:   "v1", // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		EventName:  name,
+	}
+	if err := checkEventCreated(filepath.Join(s.appPath, opts.ProtoFile()), name); err != nil {
+		return err
+	}
+
+	if err := checkCustomTypes(ctx, s.appPath, s.modpath.Package, s.protoDir, moduleName, fields); err != nil {
+		return err
+	}
+	if opts.Fields, err = field.ParseFields(fields, checkGoReservedWord); err != nil {
+		return err
+	}
+
+	g, err := event.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+	return s.Run(s.enumGenerator(moduleName, opts.Fields), g)
+}
+
+// checkEventCreated checks if the event is already declared in the events proto file of the module.
+func checkEventCreated(path string, name multiformatname.Name) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	protoFile, err := protoutil.ParseProtoPath(path)
+	if err != nil {
+		return err
+	}
+	if protoutil.HasMessage(protoFile, event.MessageName(name.PascalCase)) {
+		return errors.Errorf("event %s already exists", name.PascalCase)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+)
+
+func TestCheckEventCreated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.proto")
+	published := multiformatname.MustNewName("post-published")
+	post := multiformatname.MustNewName("post")
+
+	// no events file yet
+	require.NoError(t, checkEventCreated(path, published))
+	ok, err := typeHasEvents(path, post)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, os.WriteFile(path, []byte(`syntax = "proto3";
+
+package blog.blog.v1;
+
+message EventPostPublished {
+  string title = 1;
+}
+
+message EventPostCreated {
+  string title = 1;
+}
+`), 0o644))
+
+	require.EqualError(t, checkEventCreated(path, published), "event PostPublished already exists")
+	require.NoError(t, checkEventCreated(path, multiformatname.MustNewName("post-archived")))
+
+	ok, err = typeHasEvents(path, post)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = typeHasEvents(path, multiformatname.MustNewName("comment"))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,226 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+	"github.com/ignite/cli/v29/ignite/templates/typed/list"
+	maptype "github.com/ignite/cli/v29/ignite/templates/typed/map"
+	"github.com/ignite/cli/v29/ignite/templates/typed/singleton"
+)
+
+// typeFields describes the fields of a list, map or singleton type scaffolded in a module.
+type typeFields struct {
+	kind RemoveKind
+	opts *typed.Options
+
+	// existing are the proto names of the fields of the type.
+	existing map[string]struct{}
+	// reserved are the proto names of the fields managed by the scaffolder, like the index or the signer.
+	reserved map[string]struct{}
+}
+
+// AddTypeFields adds fields to a list, map or singleton type previously scaffolded in a module.
+// if no module is given, the fields are added to the type of the app's default module.
+func (s Scaffolder) AddTypeFields(ctx context.Context, moduleName, typeName string, fields ...string) error {
+	t, err := s.loadTypeFields(moduleName, typeName)
+	if err != nil {
+		return err
+	}
+
+	if err := checkCustomTypes(ctx, s.appPath, s.modpath.Package, s.protoDir, t.opts.ModuleName, fields); err != nil {
+		return err
+	}
+	tFields, err := field.ParseFields(fields, checkForbiddenTypeField, "")
+	if err != nil {
+		return err
+	}
+	for _, f := range tFields {
+		if _, ok := t.existing[f.ProtoFieldName()]; ok {
+			return errors.Errorf("field %s already exists in type %s", f.Name.Original, t.opts.TypeName.Original)
+		}
+	}
+	t.opts.Fields = tFields
+
+	var g *genny.Generator
+	switch t.kind {
+	case RemoveList:
+		g = list.NewFieldsGenerator(t.opts)
+	case RemoveMap:
+		g = maptype.NewFieldsGenerator(t.opts)
+	default:
+		g = singleton.NewFieldsGenerator(t.opts)
+	}
+	return s.Run(s.enumGenerator(t.opts.ModuleName, tFields), g)
+}
+
+// RemoveTypeFields removes fields from a list, map or singleton type previously scaffolded in a module.
+// The index and the signer of the type can't be removed.
+// if no module is given, the fields are removed from the type of the app's default module.
+func (s Scaffolder) RemoveTypeFields(ctx context.Context, moduleName, typeName string, fieldNames ...string) error {
+	t, err := s.loadTypeFields(moduleName, typeName)
+	if err != nil {
+		return err
+	}
+
+	tFields := make(field.Fields, 0, len(fieldNames))
+	for _, fieldName := range fieldNames {
+		name, err := multiformatname.NewName(fieldName)
+		if err != nil {
+			return err
+		}
+		f := field.Field{Name: name}
+		if _, ok := t.existing[f.ProtoFieldName()]; !ok {
+			return errors.Errorf("field %s doesn't exist in type %s", fieldName, t.opts.TypeName.Original)
+		}
+		if _, ok := t.reserved[f.ProtoFieldName()]; ok {
+			return errors.Errorf("field %s is managed by the scaffolder and can't be removed", fieldName)
+		}
+		tFields = append(tFields, f)
+	}
+	t.opts.Fields = tFields
+
+	var g *genny.Generator
+	switch t.kind {
+	case RemoveList:
+		g = list.NewRemoveFieldsGenerator(t.opts)
+	case RemoveMap:
+		g = maptype.NewRemoveFieldsGenerator(t.opts)
+	default:
+		g = singleton.NewRemoveFieldsGenerator(t.opts)
+	}
+	return s.Run(g)
+}
+
+// loadTypeFields returns the fields of a type and returns an error if the type
+// is not a list, map or singleton type scaffolded with Ignite.
+func (s Scaffolder) loadTypeFields(moduleName, typeName string) (typeFields, error) {
+	// If no module is provided, we use the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return typeFields{}, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(typeName)
+	if err != nil {
+		return typeFields{}, err
+	}
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return typeFields{}, err
+	}
+	if !ok {
+		return typeFields{}, errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	t := typeFields{
+		opts: &typed.Options{
+			AppName:    s.modpath.Package,
+			ProtoDir:   s.protoDir,
+			ProtoVer:   "v1", // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
+			ModulePath: s.modpath.RawPath,
+			ModuleName: moduleName,
+			TypeName:   name,
+		},
+		existing: make(map[string]struct{}),
+		reserved: make(map[string]struct{}),
+	}
+	notScaffolded := errors.Errorf("type %s doesn't exist in module %s or was not scaffolded with Ignite", typeName, moduleName)
+
+	queryPath := filepath.Join(s.appPath, "x", moduleName, "keeper", fmt.Sprintf("query_%s.go", name.Snake))
+	if _, err := os.Stat(queryPath); os.IsNotExist(err) {
+		return typeFields{}, notScaffolded
+	} else if err != nil {
+		return typeFields{}, err
+	}
+
+	// the kind of the type is given by its field in the genesis state.
+	genesisFile, err := protoutil.ParseProtoPath(filepath.Join(s.appPath, t.opts.ProtoFile("genesis.proto")))
+	if err != nil {
+		return typeFields{}, err
+	}
+	switch {
+	case protoutil.HasField(genesisFile, typed.ProtoGenesisStateMessage, name.Snake+"_list"):
+		t.kind = RemoveList
+	case protoutil.HasField(genesisFile, typed.ProtoGenesisStateMessage, name.Snake+"_map"):
+		t.kind = RemoveMap
+	case protoutil.HasField(genesisFile, typed.ProtoGenesisStateMessage, name.Snake):
+		t.kind = RemoveSingle
+	default:
+		return typeFields{}, notScaffolded
+	}
+
+	typeFile, err := protoutil.ParseProtoPath(filepath.Join(s.appPath, t.opts.ProtoFile(name.Snake+".proto")))
+	if os.IsNotExist(err) {
+		return typeFields{}, notScaffolded
+	} else if err != nil {
+		return typeFields{}, err
+	}
+	typeMsg, err := protoutil.GetMessageByName(typeFile, name.PascalCase)
+	if err != nil {
+		return typeFields{}, notScaffolded
+	}
+	for _, f := range messageFields(typeMsg) {
+		t.existing[f.Name] = struct{}{}
+	}
+
+	// the index of the type is the request of the get query.
+	queryFile, err := protoutil.ParseProtoPath(filepath.Join(s.appPath, t.opts.ProtoFile("query.proto")))
+	if err != nil {
+		return typeFields{}, err
+	}
+	if getRequest, err := protoutil.GetMessageByName(queryFile, fmt.Sprintf("QueryGet%sRequest", name.PascalCase)); err == nil {
+		for _, f := range messageFields(getRequest) {
+			t.reserved[f.Name] = struct{}{}
+		}
+	}
+	// the fields used by secondary and unique indexes can't be removed.
+	secondary, unique := mapIndexes(queryFile, name)
+	for _, f := range append(secondary, unique...) {
+		t.reserved[f.ProtoFieldName()] = struct{}{}
+	}
+
+	// types scaffolded without messages don't have a signer.
+	txFile, err := protoutil.ParseProtoPath(filepath.Join(s.appPath, t.opts.ProtoFile("tx.proto")))
+	if err != nil {
+		return typeFields{}, err
+	}
+	msgCreate, err := protoutil.GetMessageByName(txFile, fmt.Sprintf("MsgCreate%s", name.PascalCase))
+	if err != nil {
+		t.opts.NoMessage = true
+		return t, nil
+	}
+	for _, el := range msgCreate.Elements {
+		if option, ok := el.(*proto.Option); ok && option.Name == typed.MsgSignerOption {
+			t.reserved[option.Constant.Source] = struct{}{}
+		}
+	}
+
+	return t, nil
+}
+
+// messageFields returns the fields of a proto message.
+func messageFields(m *proto.Message) []*proto.NormalField {
+	fields := make([]*proto.NormalField, 0, len(m.Elements))
+	for _, el := range m.Elements {
+		if f, ok := el.(*proto.NormalField); ok {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,145 @@
+package scaffolder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/emicklei/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+func TestLoadTypeFields(t *testing.T) {
+	var (
+		appPath   = t.TempDir()
+		protoPath = filepath.Join(appPath, "proto", "blog", "blog", "v1")
+		s         = Scaffolder{
+			appPath:  appPath,
+			protoDir: "proto",
+			modpath:  gomodulepath.Path{Package: "blog", RawPath: "blog"},
+		}
+	)
+	require.NoError(t, os.MkdirAll(filepath.Join(appPath, "x", "blog", "keeper"), 0o755))
+	require.NoError(t, os.MkdirAll(protoPath, 0o755))
+
+	files := map[string]string{
+		filepath.Join(appPath, "x", "blog", "keeper", "query_post.go"): "package keeper",
+		filepath.Join(appPath, "x", "blog", "keeper", "query_deal.go"): "package keeper",
+		filepath.Join(protoPath, "genesis.proto"): `syntax = "proto3";
+package blog.blog.v1;
+message GenesisState {
+  repeated Post post_list = 1;
+  uint64 post_count = 2;
+  repeated Deal deal_map = 3;
+}`,
+		filepath.Join(protoPath, "post.proto"): `syntax = "proto3";
+package blog.blog.v1;
+message Post {
+  uint64 id = 1;
+  string title = 2;
+  string creator = 3;
+}`,
+		filepath.Join(protoPath, "deal.proto"): `syntax = "proto3";
+package blog.blog.v1;
+message Deal {
+  string index = 1;
+  uint64 price = 2;
+}`,
+		filepath.Join(protoPath, "query.proto"): `syntax = "proto3";
+package blog.blog.v1;
+message QueryGetPostRequest {
+  uint64 id = 1;
+}
+message QueryGetDealRequest {
+  string index = 1;
+}
+message QueryGetDealByPriceRequest {
+  uint64 price = 1;
+}`,
+		filepath.Join(protoPath, "tx.proto"): `syntax = "proto3";
+package blog.blog.v1;
+message MsgCreatePost {
+  option (cosmos.msg.v1.signer) = "creator";
+  string creator = 1;
+  string title = 2;
+}`,
+	}
+	for path, content := range files {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	post, err := s.loadTypeFields("", "post")
+	require.NoError(t, err)
+	require.Equal(t, RemoveList, post.kind)
+	require.False(t, post.opts.NoMessage)
+	require.Len(t, post.existing, 3)
+	require.Contains(t, post.existing, "title")
+	require.Len(t, post.reserved, 2)
+	require.Contains(t, post.reserved, "id")
+	require.Contains(t, post.reserved, "creator")
+
+	deal, err := s.loadTypeFields("blog", "deal")
+	require.NoError(t, err)
+	require.Equal(t, RemoveMap, deal.kind)
+	require.True(t, deal.opts.NoMessage)
+	require.Len(t, deal.reserved, 2)
+	require.Contains(t, deal.reserved, "index")
+	require.Contains(t, deal.reserved, "price")
+
+	_, err = s.loadTypeFields("blog", "book")
+	require.EqualError(t, err, "type book doesn't exist in module blog or was not scaffolded with Ignite")
+	_, err = s.loadTypeFields("shop", "post")
+	require.EqualError(t, err, "the module shop doesn't exist")
+
+	require.ErrorContains(t, s.RemoveTypeFields(t.Context(), "blog", "post", "id"), "managed by the scaffolder")
+	require.ErrorContains(t, s.RemoveTypeFields(t.Context(), "blog", "post", "body"), "doesn't exist in type post")
+}
+
+func TestRemoveTypeFieldsReservesNumbers(t *testing.T) {
+	ctx := context.Background()
+	pathInfo, err := gomodulepath.Parse("github.com/test/blog")
+	require.NoError(t, err)
+	appPath := t.TempDir()
+	_, err = generate(ctx, pathInfo, "cosmos", 118, "stake", "proto", appPath, false, false, nil, nil)
+	require.NoError(t, err)
+
+	run := func(f func(s Scaffolder) error) {
+		s, err := New(ctx, appPath, "proto")
+		require.NoError(t, err)
+		require.NoError(t, f(s))
+		_, err = s.ApplyModifications()
+		require.NoError(t, err)
+	}
+	run(func(s Scaffolder) error {
+		return s.AddType(ctx, "post", ListType(), TypeWithFields("title", "price:coin"))
+	})
+	run(func(s Scaffolder) error { return s.RemoveTypeFields(ctx, "", "post", "title", "price") })
+	run(func(s Scaffolder) error { return s.AddTypeFields(ctx, "", "post", "title", "body") })
+
+	protoFile, err := protoutil.ParseProtoPath(filepath.Join(appPath, "proto", "blog", "blog", "v1", "post.proto"))
+	require.NoError(t, err)
+	require.False(t, protoutil.HasImport(protoFile, "cosmos/base/v1beta1/coin.proto"))
+
+	post, err := protoutil.GetMessageByName(protoFile, "Post")
+	require.NoError(t, err)
+	fields := make(map[string]int)
+	for _, f := range messageFields(post) {
+		fields[f.Name] = f.Sequence
+	}
+	require.Equal(t, map[string]int{"id": 1, "creator": 4, "title": 5, "body": 6}, fields)
+
+	var reserved []string
+	for _, el := range post.Elements {
+		if r, ok := el.(*proto.Reserved); ok {
+			for _, rng := range r.Ranges {
+				reserved = append(reserved, rng.SourceRepresentation())
+			}
+			reserved = append(reserved, r.FieldNames...)
+		}
+	}
+	require.ElementsMatch(t, []string{"2", "3", "price"}, reserved)
+}
@@ -0,0 +1,106 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/hooks"
+)
+
+const hooksSuffix = "Hooks"
+
+// AddHooks adds a new hooks interface to a scaffolded module.
+// Other modules subscribe to the hooks by providing an implementation with depinject.
+// if no module is given, the hooks are scaffolded inside the app's default module.
+func (s Scaffolder) AddHooks(moduleName, hookName string, methods ...string) error {
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	name, err := parseHookName(hookName)
+	if err != nil {
+		return err
+	}
+	if err := checkHooksCreated(s.appPath, moduleName, name); err != nil {
+		return err
+	}
+
+	hookMethods, err := parseHookMethods(methods)
+	if err != nil {
+		return err
+	}
+
+	g, err := hooks.NewGenerator(&hooks.Options{
+		ModuleName: moduleName,
+		ModulePath: s.modpath.RawPath,
+		HookName:   name,
+		Methods:    hookMethods,
+	})
+	if err != nil {
+		return err
+	}
+	return s.Run(g)
+}
+
+// parseHookName returns the name of the hooks without the "Hooks" suffix.
+func parseHookName(hookName string) (multiformatname.Name, error) {
+	name, err := multiformatname.NewName(hookName)
+	if err != nil {
+		return multiformatname.Name{}, err
+	}
+	if trimmed := strings.TrimSuffix(name.UpperCamel, hooksSuffix); trimmed != "" && trimmed != name.UpperCamel {
+		return multiformatname.NewName(trimmed)
+	}
+	return name, nil
+}
+
+// parseHookMethods validates the names of the methods of the hooks.
+func parseHookMethods(methods []string) ([]multiformatname.Name, error) {
+	var (
+		names = make([]multiformatname.Name, 0, len(methods))
+		exist = make(map[string]struct{})
+	)
+	for _, method := range methods {
+		name, err := multiformatname.NewName(method)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkGoReservedWord(name.LowerCamel); err != nil {
+			return nil, err
+		}
+		if _, ok := exist[name.UpperCamel]; ok {
+			return nil, errors.Errorf("duplicated hook method %s", method)
+		}
+		exist[name.UpperCamel] = struct{}{}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// checkHooksCreated checks if the hooks have been already created in the module.
+func checkHooksCreated(appPath, moduleName string, name multiformatname.Name) error {
+	path := filepath.Join(appPath, "x", moduleName, "types", fmt.Sprintf("hooks_%s.go", name.Snake))
+	if _, err := os.Stat(path); err == nil {
+		return errors.Errorf("hooks %s already exist in module %s", name.PascalCase, moduleName)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHookName(t *testing.T) {
+	tests := []struct {
+		name     string
+		hookName string
+		want     string
+		err      bool
+	}{
+		{name: "simple name", hookName: "staking", want: "Staking"},
+		{name: "name with hooks suffix", hookName: "stakingHooks", want: "Staking"},
+		{name: "hooks only", hookName: "hooks", want: "Hooks"},
+		{name: "invalid name", hookName: "1staking", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseHookName(tt.hookName)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.PascalCase)
+		})
+	}
+}
+
+func TestParseHookMethods(t *testing.T) {
+	names, err := parseHookMethods([]string{"afterDeal", "before-deal"})
+	require.NoError(t, err)
+	require.Len(t, names, 2)
+	require.Equal(t, "AfterDeal", names[0].UpperCamel)
+	require.Equal(t, "BeforeDeal", names[1].UpperCamel)
+
+	_, err = parseHookMethods([]string{"afterDeal", "after_deal"})
+	require.EqualError(t, err, "duplicated hook method after_deal")
+
+	_, err = parseHookMethods([]string{"func"})
+	require.Error(t, err)
+}
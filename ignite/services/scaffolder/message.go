@@ -146,7 +146,7 @@ func (s Scaffolder) AddMessage(
 		return err
 	}
 
-	return s.Run(g)
+	return s.Run(s.enumGenerator(moduleName, parsedMsgFields, parsedResFields), g)
 }
 
 // checkForbiddenMessageField returns true if the name is forbidden as a message name.
@@ -0,0 +1,126 @@
+package scaffolder
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/migration"
+)
+
+// AddMigration adds an in-place store migration of a scaffolded module from the consensus
+// version fromVersion to toVersion and bumps the consensus version of the module.
+// If fromVersion is zero, the current consensus version of the module is used.
+// If toVersion is zero, the migration targets the version following fromVersion.
+// if no module is given, the migration is scaffolded inside the app's default module.
+func (s Scaffolder) AddMigration(moduleName string, fromVersion, toVersion uint64) error {
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	modulePath := filepath.Join(s.appPath, "x", moduleName)
+	currentVersion, err := consensusVersion(filepath.Join(modulePath, "module", "module.go"))
+	if err != nil {
+		return err
+	}
+	if fromVersion == 0 {
+		fromVersion = currentVersion
+	}
+	if toVersion == 0 {
+		toVersion = fromVersion + 1
+	}
+	if err := checkMigrationVersions(currentVersion, fromVersion, toVersion); err != nil {
+		return err
+	}
+
+	migrationPath := filepath.Join(modulePath, "migrations", migration.VersionPackage(toVersion))
+	if _, err := os.Stat(migrationPath); err == nil {
+		return errors.Errorf("migration %s already exists in module %s", migration.VersionPackage(toVersion), moduleName)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	newMigrator := false
+	if _, err := os.Stat(filepath.Join(modulePath, "keeper", "migrator.go")); os.IsNotExist(err) {
+		newMigrator = true
+	} else if err != nil {
+		return err
+	}
+
+	g, err := migration.NewGenerator(&migration.Options{
+		ModuleName:  moduleName,
+		ModulePath:  s.modpath.RawPath,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		NewMigrator: newMigrator,
+	})
+	if err != nil {
+		return err
+	}
+	return s.Run(g)
+}
+
+// checkMigrationVersions checks that the migration starts from the current consensus
+// version of the module and targets the next one.
+func checkMigrationVersions(currentVersion, fromVersion, toVersion uint64) error {
+	if fromVersion != currentVersion {
+		return errors.Errorf(
+			"the migration must start from the current consensus version %d of the module, got %d",
+			currentVersion,
+			fromVersion,
+		)
+	}
+	if toVersion != fromVersion+1 {
+		return errors.Errorf(
+			"a migration upgrades the consensus version by one, the target version must be %d, got %d",
+			fromVersion+1,
+			toVersion,
+		)
+	}
+	return nil
+}
+
+// consensusVersion returns the consensus version returned by the ConsensusVersion method of a module file.
+func consensusVersion(path string) (uint64, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, decl := range f.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || funcDecl.Name.Name != "ConsensusVersion" || funcDecl.Body == nil {
+			continue
+		}
+		for _, stmt := range funcDecl.Body.List {
+			ret, ok := stmt.(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				continue
+			}
+			lit, ok := ret.Results[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				return 0, errors.Errorf("the consensus version of %s must be an integer literal", path)
+			}
+			return strconv.ParseUint(lit.Value, 0, 64)
+		}
+	}
+	return 0, errors.Errorf("ConsensusVersion method not found in %s", path)
+}
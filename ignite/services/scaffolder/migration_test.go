@@ -0,0 +1,68 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    uint64
+		err     string
+	}{
+		{
+			name:    "single line",
+			content: "package mars\n\nfunc (AppModule) ConsensusVersion() uint64 { return 3 }\n",
+			want:    3,
+		},
+		{
+			name:    "multi line",
+			content: "package mars\n\nfunc (am AppModule) ConsensusVersion() uint64 {\n\treturn 2\n}\n",
+			want:    2,
+		},
+		{
+			name:    "constant",
+			content: "package mars\n\nconst version = 2\n\nfunc (AppModule) ConsensusVersion() uint64 { return version }\n",
+			err:     "the consensus version of %s must be an integer literal",
+		},
+		{
+			name:    "no method",
+			content: "package mars\n\nfunc ConsensusVersion() uint64 { return 1 }\n",
+			err:     "ConsensusVersion method not found in %s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "module.go")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o644))
+
+			got, err := consensusVersion(path)
+			if tt.err != "" {
+				require.EqualError(t, err, fmt.Sprintf(tt.err, path))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCheckMigrationVersions(t *testing.T) {
+	require.NoError(t, checkMigrationVersions(1, 1, 2))
+	require.EqualError(
+		t,
+		checkMigrationVersions(2, 1, 2),
+		"the migration must start from the current consensus version 2 of the module, got 1",
+	)
+	require.EqualError(
+		t,
+		checkMigrationVersions(1, 1, 3),
+		"a migration upgrades the consensus version by one, the target version must be 2, got 3",
+	)
+}
@@ -135,7 +135,7 @@ func (s Scaffolder) AddPacket(
 	if err != nil {
 		return err
 	}
-	return s.Run(g)
+	return s.Run(s.enumGenerator(moduleName, parsedPacketFields, parsedAcksFields), g)
 }
 
 // isIBCModule returns true if the provided module implements the IBC module interface
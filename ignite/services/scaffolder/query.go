@@ -80,5 +80,5 @@ func (s Scaffolder) AddQuery(
 		return err
 	}
 
-	return s.Run(g)
+	return s.Run(s.enumGenerator(moduleName, parsedReqFields, parsedResFields), g)
 }
@@ -0,0 +1,287 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/templates/event"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/message"
+	"github.com/ignite/cli/v29/ignite/templates/query"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+	"github.com/ignite/cli/v29/ignite/templates/typed/list"
+	maptype "github.com/ignite/cli/v29/ignite/templates/typed/map"
+	"github.com/ignite/cli/v29/ignite/templates/typed/singleton"
+)
+
+// RemoveKind is the kind of component to remove from a module.
+type RemoveKind string
+
+const (
+	// RemoveList removes a type scaffolded with `scaffold list`.
+	RemoveList RemoveKind = "list"
+
+	// RemoveMap removes a type scaffolded with `scaffold map`.
+	RemoveMap RemoveKind = "map"
+
+	// RemoveSingle removes a type scaffolded with `scaffold single`.
+	RemoveSingle RemoveKind = "single"
+
+	// RemoveMessage removes a message scaffolded with `scaffold message`.
+	RemoveMessage RemoveKind = "message"
+
+	// RemoveQuery removes a query scaffolded with `scaffold query`.
+	RemoveQuery RemoveKind = "query"
+)
+
+// RemoveKinds returns the kinds of components that can be removed.
+func RemoveKinds() []RemoveKind {
+	return []RemoveKind{RemoveList, RemoveMap, RemoveSingle, RemoveMessage, RemoveQuery}
+}
+
+// ParseRemoveKind parses the kind of component to remove.
+func ParseRemoveKind(kind string) (RemoveKind, error) {
+	for _, k := range RemoveKinds() {
+		if string(k) == kind {
+			return k, nil
+		}
+	}
+	return "", errors.Errorf("invalid component kind %q, expected one of %v", kind, RemoveKinds())
+}
+
+// RemoveComponent removes a component previously scaffolded in a module.
+// if no module is given, the component is removed from the app's default module.
+func (s Scaffolder) RemoveComponent(ctx context.Context, kind RemoveKind, moduleName, componentName string) error {
+	// If no module is provided, we remove the component from the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(componentName)
+	if err != nil {
+		return err
+	}
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	if err := checkComponentExists(s.appPath, moduleName, kind, name); err != nil {
+		return err
+	}
+
+	var (
+		g        *genny.Generator
+		gens     []*genny.Generator
+		protoVer = "v1" // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
+	)
+	switch kind {
+	case RemoveList, RemoveMap, RemoveSingle:
+		opts := &typed.Options{
+			AppName:    s.modpath.Package,
+			ProtoDir:   s.protoDir,
+			ProtoVer:   protoVer,
+			ModulePath: s.modpath.RawPath,
+			ModuleName: moduleName,
+			TypeName:   name,
+		}
+		switch kind {
+		case RemoveList:
+			g = list.NewRemoveGenerator(opts)
+		case RemoveMap:
+			queryFile, err := protoutil.ParseProtoPath(filepath.Join(s.appPath, opts.ProtoFile("query.proto")))
+			if err != nil {
+				return err
+			}
+			opts.SecondaryIndexes, opts.UniqueIndexes = mapIndexes(queryFile, name)
+			g = maptype.NewRemoveGenerator(opts)
+		default:
+			g = singleton.NewRemoveGenerator(opts)
+		}
+
+		hasEvents, err := typeHasEvents(filepath.Join(s.appPath, opts.ProtoFile(event.ProtoFileName)), name)
+		if err != nil {
+			return err
+		}
+		if hasEvents {
+			gens = append(gens, event.NewTypeEventsRemoveGenerator(opts))
+		}
+	case RemoveMessage:
+		g = message.NewRemoveGenerator(&message.Options{
+			AppName:    s.modpath.Package,
+			ProtoDir:   s.protoDir,
+			ProtoVer:   protoVer,
+			ModulePath: s.modpath.RawPath,
+			ModuleName: moduleName,
+			MsgName:    name,
+		})
+	case RemoveQuery:
+		g = query.NewRemoveGenerator(&query.Options{
+			AppName:    s.modpath.Package,
+			ProtoDir:   s.protoDir,
+			ProtoVer:   protoVer,
+			ModulePath: s.modpath.RawPath,
+			ModuleName: moduleName,
+			QueryName:  name,
+		})
+	default:
+		return errors.Errorf("invalid component kind %q", kind)
+	}
+
+	return s.Run(append(gens, g)...)
+}
+
+// typeHasEvents checks if the created, updated and deleted events of a type are declared in the events proto file.
+func typeHasEvents(path string, typeName multiformatname.Name) (bool, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	protoFile, err := protoutil.ParseProtoPath(path)
+	if err != nil {
+		return false, err
+	}
+	return protoutil.HasMessage(protoFile, event.MessageName(event.TypeEvents(typeName)[0])), nil
+}
+
+// checkComponentExists checks that a component of the given kind was scaffolded in a module.
+// The list, map and singleton types are identified by the kind of their collection in the keeper.
+func checkComponentExists(appPath, moduleName string, kind RemoveKind, compName multiformatname.Name) error {
+	var (
+		keeperPath = filepath.Join(appPath, "x", moduleName, "keeper")
+		typesPath  = filepath.Join(appPath, "x", moduleName, "types")
+		paths      []string
+	)
+	switch kind {
+	case RemoveList, RemoveSingle, RemoveQuery:
+		paths = append(paths, filepath.Join(keeperPath, fmt.Sprintf("query_%s.go", compName.Snake)))
+	case RemoveMap:
+		paths = append(paths,
+			filepath.Join(keeperPath, fmt.Sprintf("query_%s.go", compName.Snake)),
+			filepath.Join(typesPath, fmt.Sprintf("key_%s.go", compName.Snake)),
+		)
+	case RemoveMessage:
+		paths = append(paths, filepath.Join(keeperPath, fmt.Sprintf("msg_server_%s.go", compName.Snake)))
+	default:
+		return errors.Errorf("invalid component kind %q", kind)
+	}
+
+	notExist := errors.Errorf("%s %s doesn't exist in module %s", kind, compName.Original, moduleName)
+	for _, path := range paths {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return notExist
+		} else if err != nil {
+			return err
+		}
+	}
+
+	// the messages and queries don't have a collection in the keeper.
+	var typeKind RemoveKind
+	if _, err := os.Stat(filepath.Join(keeperPath, "keeper.go")); err == nil {
+		typeKind, err = keeperCollectionKind(filepath.Join(keeperPath, "keeper.go"), compName)
+		if err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	switch {
+	case typeKind == kind:
+		return nil
+	case typeKind != "":
+		return errors.Errorf("%s is a %s type in module %s, not a %s", compName.Original, typeKind, moduleName, kind)
+	case kind == RemoveQuery || kind == RemoveMessage:
+		return nil
+	default:
+		return notExist
+	}
+}
+
+// keeperCollectionKind returns the kind of the type stored in the collection
+// named after the type in the Keeper struct of a keeper file.
+// An empty kind is returned if the keeper has no collection for the type.
+func keeperCollectionKind(keeperPath string, typeName multiformatname.Name) (RemoveKind, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), keeperPath, nil, 0)
+	if err != nil {
+		return "", err
+	}
+
+	fields := make(map[string]string)
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok || spec.Name.Name != "Keeper" {
+			return true
+		}
+		if st, ok := spec.Type.(*ast.StructType); ok {
+			for _, field := range st.Fields.List {
+				for _, name := range field.Names {
+					fields[name.Name] = types.ExprString(field.Type)
+				}
+			}
+		}
+		return false
+	})
+
+	collection := fields[typeName.UpperCamel]
+	switch {
+	case strings.HasPrefix(collection, "collections.Map[uint64,") &&
+		fields[typeName.UpperCamel+"Seq"] == "collections.Sequence":
+		return RemoveList, nil
+	case strings.HasPrefix(collection, "collections.Map["),
+		strings.HasPrefix(collection, "*collections.IndexedMap["):
+		return RemoveMap, nil
+	case strings.HasPrefix(collection, "collections.Item["):
+		return RemoveSingle, nil
+	default:
+		return "", nil
+	}
+}
+
+// mapIndexes returns the secondary and unique indexes of a map type,
+// found from the request messages of their queries in the query proto file.
+// Only the names of the indexed fields are set.
+func mapIndexes(queryFile *proto.Proto, typeName multiformatname.Name) (secondary, unique field.Fields) {
+	for _, el := range queryFile.Elements {
+		msg, ok := el.(*proto.Message)
+		if !ok {
+			continue
+		}
+		for _, f := range messageFields(msg) {
+			name, err := multiformatname.NewName(f.Name)
+			if err != nil {
+				continue
+			}
+			switch msg.Name {
+			case maptype.ListByIndexRequest(typeName, name):
+				secondary = append(secondary, field.Field{Name: name})
+			case maptype.GetByIndexRequest(typeName, name):
+				unique = append(unique, field.Field{Name: name})
+			}
+		}
+	}
+	return secondary, unique
+}
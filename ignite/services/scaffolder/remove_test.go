@@ -0,0 +1,274 @@
+package scaffolder
+
+import (
+	"bytes"
+	"context"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+func TestParseRemoveKind(t *testing.T) {
+	for _, kind := range RemoveKinds() {
+		got, err := ParseRemoveKind(string(kind))
+		require.NoError(t, err)
+		require.Equal(t, kind, got)
+	}
+
+	_, err := ParseRemoveKind("packet")
+	require.Error(t, err)
+}
+
+func TestCheckComponentExists(t *testing.T) {
+	appPath := t.TempDir()
+	keeperPath := filepath.Join(appPath, "x", "blog", "keeper")
+	typesPath := filepath.Join(appPath, "x", "blog", "types")
+	require.NoError(t, os.MkdirAll(keeperPath, 0o755))
+	require.NoError(t, os.MkdirAll(typesPath, 0o755))
+
+	for _, file := range []string{
+		filepath.Join(keeperPath, "query_post.go"),
+		filepath.Join(keeperPath, "query_author.go"),
+		filepath.Join(keeperPath, "query_settings.go"),
+		filepath.Join(keeperPath, "query_show_post.go"),
+		filepath.Join(keeperPath, "msg_server_send_post.go"),
+		filepath.Join(typesPath, "key_author.go"),
+	} {
+		require.NoError(t, os.WriteFile(file, []byte("package keeper"), 0o644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(keeperPath, "keeper.go"), []byte(`package keeper
+
+type Keeper struct {
+	Params   collections.Item[types.Params]
+	PostSeq  collections.Sequence
+	Post     collections.Map[uint64, types.Post]
+	Author   *collections.IndexedMap[string, types.Author, types.AuthorIndexes]
+	Settings collections.Item[types.Settings]
+}
+`), 0o644))
+
+	newName := func(name string) multiformatname.Name {
+		n, err := multiformatname.NewName(name)
+		require.NoError(t, err)
+		return n
+	}
+	var (
+		post     = newName("post")
+		author   = newName("author")
+		settings = newName("settings")
+		showPost = newName("showPost")
+		sendPost = newName("sendPost")
+		comment  = newName("comment")
+	)
+
+	require.NoError(t, checkComponentExists(appPath, "blog", RemoveList, post))
+	require.NoError(t, checkComponentExists(appPath, "blog", RemoveMap, author))
+	require.NoError(t, checkComponentExists(appPath, "blog", RemoveSingle, settings))
+	require.NoError(t, checkComponentExists(appPath, "blog", RemoveQuery, showPost))
+	require.NoError(t, checkComponentExists(appPath, "blog", RemoveMessage, sendPost))
+
+	require.EqualError(
+		t,
+		checkComponentExists(appPath, "blog", RemoveMap, post),
+		"map post doesn't exist in module blog",
+	)
+	require.EqualError(
+		t,
+		checkComponentExists(appPath, "blog", RemoveSingle, post),
+		"post is a list type in module blog, not a single",
+	)
+	require.EqualError(
+		t,
+		checkComponentExists(appPath, "blog", RemoveList, author),
+		"author is a map type in module blog, not a list",
+	)
+	require.EqualError(
+		t,
+		checkComponentExists(appPath, "blog", RemoveQuery, settings),
+		"settings is a single type in module blog, not a query",
+	)
+	require.EqualError(
+		t,
+		checkComponentExists(appPath, "blog", RemoveList, showPost),
+		"list showPost doesn't exist in module blog",
+	)
+	require.EqualError(
+		t,
+		checkComponentExists(appPath, "blog", RemoveList, comment),
+		"list comment doesn't exist in module blog",
+	)
+	require.Error(t, checkComponentExists(appPath, "blog", RemoveMessage, post))
+}
+
+// normalizeGo removes the unused imports of a Go file, like goimports after a
+// scaffold, and returns its tokens separated by spaces, the generators don't
+// keep the formatting of the files they modify.
+func normalizeGo(t *testing.T, content []byte) string {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", content, parser.ParseComments)
+	require.NoError(t, err)
+	for _, spec := range slices.Clone(f.Imports) {
+		path, err := strconv.Unquote(spec.Path.Value)
+		require.NoError(t, err)
+		if !astutil.UsesImport(f, path) {
+			name := ""
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			astutil.DeleteNamedImport(fset, f, name, path)
+		}
+	}
+	ast.SortImports(fset, f)
+
+	var buf bytes.Buffer
+	require.NoError(t, format.Node(&buf, fset, f))
+
+	var (
+		s      scanner.Scanner
+		tokens []string
+	)
+	s.Init(fset.AddFile("", -1, buf.Len()), buf.Bytes(), nil, scanner.ScanComments)
+	for {
+		_, tok, lit := s.Scan()
+		switch {
+		case tok == token.EOF:
+			return strings.Join(tokens, " ")
+		case tok == token.SEMICOLON && lit == "\n":
+			// the semicolons inserted at the end of the lines.
+		case lit != "":
+			tokens = append(tokens, lit)
+		default:
+			tokens = append(tokens, tok.String())
+		}
+	}
+}
+
+// removeBlankLines removes the blank lines and the trailing spaces of a file.
+func removeBlankLines(content string) string {
+	lines := strings.Split(content, "\n")
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line = strings.TrimRight(line, " \t"); line != "" {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
+
+// readTree returns the content of the files of a directory by relative path.
+// The Go and proto files are normalized, the tools modifying them don't keep
+// their original formatting.
+func readTree(t *testing.T, root string) map[string]string {
+	t.Helper()
+
+	tree := make(map[string]string)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		switch filepath.Ext(path) {
+		case ".go":
+			tree[rel] = normalizeGo(t, content)
+		case ".proto":
+			pf, err := protoutil.ParseProtoFile(bytes.NewReader(content))
+			if err != nil {
+				return err
+			}
+			tree[rel] = removeBlankLines(protoutil.Print(pf))
+		default:
+			tree[rel] = string(content)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return tree
+}
+
+func TestRemoveComponentRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    RemoveKind
+		addKind AddTypeKind
+		options []AddTypeOption
+	}{
+		{
+			name:    "list",
+			kind:    RemoveList,
+			addKind: ListType(),
+			options: []AddTypeOption{TypeWithFields("title", "body", "likes:uint")},
+		},
+		{
+			name:    "map",
+			kind:    RemoveMap,
+			addKind: MapType("slug"),
+			options: []AddTypeOption{
+				TypeWithFields("title", "author"),
+				TypeWithSecondaryIndexes("author"),
+			},
+		},
+		{
+			name:    "singleton",
+			kind:    RemoveSingle,
+			addKind: SingletonType(),
+			options: []AddTypeOption{TypeWithFields("title", "enabled:bool")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			pathInfo, err := gomodulepath.Parse("github.com/test/blog")
+			require.NoError(t, err)
+			appPath := t.TempDir()
+			_, err = generate(ctx, pathInfo, "cosmos", 118, "stake", "proto", appPath, false, false, nil, nil)
+			require.NoError(t, err)
+			baseline := readTree(t, appPath)
+
+			s, err := New(ctx, appPath, "proto")
+			require.NoError(t, err)
+			require.NoError(t, s.AddType(ctx, "post", tt.addKind, tt.options...))
+			_, err = s.ApplyModifications()
+			require.NoError(t, err)
+			require.NotEqual(t, baseline, readTree(t, appPath))
+
+			s, err = New(ctx, appPath, "proto")
+			require.NoError(t, err)
+			require.NoError(t, s.RemoveComponent(ctx, tt.kind, "", "post"))
+			_, err = s.ApplyModifications()
+			require.NoError(t, err)
+			tree := readTree(t, appPath)
+			for path, content := range baseline {
+				assert.Equal(t, content, tree[path], path)
+			}
+			for path := range tree {
+				assert.Contains(t, baseline, path)
+			}
+		})
+	}
+}
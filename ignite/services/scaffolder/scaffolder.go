@@ -81,6 +81,10 @@ func (s Scaffolder) ApplyModifications(options ...xgenny.ApplyOption) (xgenny.So
 	return s.runner.ApplyModifications(options...)
 }
 
+func (s Scaffolder) DiffModifications() (xgenny.SourceModification, string, error) {
+	return s.runner.DiffModifications()
+}
+
 func (s Scaffolder) Run(gens ...*genny.Generator) error {
 	return s.runner.Run(gens...)
 }
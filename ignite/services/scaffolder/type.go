@@ -2,12 +2,14 @@ package scaffolder
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/gobuffalo/genny/v2"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/event"
 	"github.com/ignite/cli/v29/ignite/templates/field"
 	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
 	"github.com/ignite/cli/v29/ignite/templates/typed"
@@ -33,10 +35,13 @@ type addTypeOptions struct {
 	isMap       bool
 	isSingleton bool
 
-	index string
+	index            string
+	secondaryIndexes []string
+	uniqueIndexes    []string
 
 	withoutMessage    bool
 	withoutSimulation bool
+	withEvents        bool
 	signer            string
 }
 
@@ -93,6 +98,22 @@ func TypeWithFields(fields ...string) AddTypeOption {
 	}
 }
 
+// TypeWithSecondaryIndexes adds secondary indexes on the given fields to a map type.
+// A secondary index allows to list the values of the map sharing the same field value.
+func TypeWithSecondaryIndexes(fields ...string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.secondaryIndexes = fields
+	}
+}
+
+// TypeWithUniqueIndexes adds unique indexes on the given fields to a map type.
+// A unique index ensures that no two values of the map share the same field value.
+func TypeWithUniqueIndexes(fields ...string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.uniqueIndexes = fields
+	}
+}
+
 // TypeWithoutMessage disables generating sdk compatible messages and tx related APIs.
 func TypeWithoutMessage() AddTypeOption {
 	return func(o *addTypeOptions) {
@@ -107,6 +128,13 @@ func TypeWithoutSimulation() AddTypeOption {
 	}
 }
 
+// TypeWithEvents makes the messages of the type emit typed events when a value is created, updated or deleted.
+func TypeWithEvents() AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.withEvents = true
+	}
+}
+
 // TypeWithSigner provides a custom signer name for the message.
 func TypeWithSigner(signer string) AddTypeOption {
 	return func(o *addTypeOptions) {
@@ -181,16 +209,28 @@ func (s Scaffolder) AddType(
 			NoSimulation: o.withoutSimulation,
 			MsgSigner:    mfSigner,
 			IsIBC:        isIBC,
+			Events:       o.withEvents,
 		}
-		gens []*genny.Generator
+		gens = []*genny.Generator{s.enumGenerator(moduleName, tFields)}
 	)
 
+	if !o.isMap && (len(o.secondaryIndexes) > 0 || len(o.uniqueIndexes) > 0) {
+		return errors.New("secondary and unique indexes are only supported by map types")
+	}
+
+	if o.withEvents {
+		if o.withoutMessage || !(o.isList || o.isMap || o.isSingleton) {
+			return errors.New("events are only supported by list, map and single types with messages")
+		}
+		gens = append(gens, event.NewTypeEventsGenerator(opts))
+	}
+
 	// create the type generator depending on the model
 	switch {
 	case o.isList:
 		g, err = list.NewGenerator(opts)
 	case o.isMap:
-		g, err = mapGenerator(opts, o.index)
+		g, err = mapGenerator(opts, o.index, o.secondaryIndexes, o.uniqueIndexes)
 	case o.isSingleton:
 		g, err = singleton.NewGenerator(opts)
 	default:
@@ -258,7 +298,7 @@ func parseTypeFields(opts addTypeOptions) (field.Fields, error) {
 }
 
 // mapGenerator returns the template generator for a map.
-func mapGenerator(opts *typed.Options, index string) (*genny.Generator, error) {
+func mapGenerator(opts *typed.Options, index string, secondaryIndexes, uniqueIndexes []string) (*genny.Generator, error) {
 	// Parse indexes with the associated type
 	if strings.Contains(index, ",") {
 		return nil, errors.Errorf("multi-index map isn't supported")
@@ -284,5 +324,71 @@ func mapGenerator(opts *typed.Options, index string) (*genny.Generator, error) {
 	}
 
 	opts.Index = parsedIndexes[0]
+
+	if opts.SecondaryIndexes, opts.UniqueIndexes, err = parseMapIndexes(opts, secondaryIndexes, uniqueIndexes); err != nil {
+		return nil, err
+	}
 	return maptype.NewGenerator(opts)
 }
+
+// parseMapIndexes returns the fields of the map type used by the secondary and unique indexes.
+// The indexed fields must be indexable fields of the type or, for secondary indexes, the message signer.
+func parseMapIndexes(opts *typed.Options, secondaryIndexes, uniqueIndexes []string) (secondary, unique field.Fields, err error) {
+	var (
+		exists  = make(map[string]struct{})
+		resolve = func(name string, isUnique bool) (field.Field, error) {
+			mfName, err := multiformatname.NewName(name)
+			if err != nil {
+				return field.Field{}, err
+			}
+			if _, ok := exists[mfName.LowerCamel]; ok {
+				return field.Field{}, errors.Errorf("%s is indexed more than once", name)
+			}
+			exists[mfName.LowerCamel] = struct{}{}
+
+			if mfName.LowerCamel == opts.Index.Name.LowerCamel {
+				return field.Field{}, errors.Errorf("%s is already the index of the map", name)
+			}
+			if !opts.NoMessage && mfName.LowerCamel == opts.MsgSigner.LowerCamel {
+				if isUnique {
+					return field.Field{}, errors.Errorf("the signer %s can't be a unique index", name)
+				}
+				return field.Field{
+					Name:         opts.MsgSigner,
+					DatatypeName: datatype.String,
+				}, nil
+			}
+
+			idx := slices.IndexFunc(opts.Fields, func(f field.Field) bool {
+				return f.Name.LowerCamel == mfName.LowerCamel
+			})
+			if idx < 0 {
+				return field.Field{}, errors.Errorf("the index %s isn't a field of the map", name)
+			}
+			f := opts.Fields[idx]
+			if dt, ok := datatype.IsSupportedType(f.DatatypeName); !ok || dt.NonIndex {
+				return field.Field{}, errors.Errorf("invalid index type %s for %s", f.DatatypeName, name)
+			}
+			if isUnique && f.DatatypeName == datatype.Bool {
+				return field.Field{}, errors.Errorf("the bool field %s can't be a unique index", name)
+			}
+			return f, nil
+		}
+	)
+
+	for _, name := range secondaryIndexes {
+		f, err := resolve(name, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		secondary = append(secondary, f)
+	}
+	for _, name := range uniqueIndexes {
+		f, err := resolve(name, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		unique = append(unique, f)
+	}
+	return secondary, unique, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/randstr"
 	"github.com/ignite/cli/v29/ignite/templates/field"
 	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
 )
 
 func TestParseTypeFields(t *testing.T) {
@@ -232,6 +233,96 @@ func TestCheckForbiddenTypeIndexField(t *testing.T) {
 	}
 }
 
+func TestParseMapIndexes(t *testing.T) {
+	fields, err := field.ParseFields([]string{"title", "priority:uint", "active:bool", "tags:array.string"}, checkForbiddenTypeField)
+	require.NoError(t, err)
+	index, err := field.ParseFields([]string{"index"}, checkForbiddenTypeIndex)
+	require.NoError(t, err)
+	signer, err := multiformatname.NewName("creator")
+	require.NoError(t, err)
+
+	opts := &typed.Options{
+		Fields:    fields,
+		Index:     index[0],
+		MsgSigner: signer,
+	}
+
+	tests := []struct {
+		name              string
+		secondaryIndexes  []string
+		uniqueIndexes     []string
+		noMessage         bool
+		expectedSecondary []string
+		expectedUnique    []string
+		err               string
+	}{
+		{
+			name:              "secondary and unique indexes",
+			secondaryIndexes:  []string{"creator", "priority", "active"},
+			uniqueIndexes:     []string{"title"},
+			expectedSecondary: []string{"creator", "priority", "active"},
+			expectedUnique:    []string{"title"},
+		},
+		{
+			name:             "signer without message",
+			secondaryIndexes: []string{"creator"},
+			noMessage:        true,
+			err:              "the index creator isn't a field of the map",
+		},
+		{
+			name:          "signer as unique index",
+			uniqueIndexes: []string{"creator"},
+			err:           "the signer creator can't be a unique index",
+		},
+		{
+			name:          "bool as unique index",
+			uniqueIndexes: []string{"active"},
+			err:           "the bool field active can't be a unique index",
+		},
+		{
+			name:             "non indexable field",
+			secondaryIndexes: []string{"tags"},
+			err:              "invalid index type array.string for tags",
+		},
+		{
+			name:             "primary index",
+			secondaryIndexes: []string{"index"},
+			err:              "index is already the index of the map",
+		},
+		{
+			name:             "field indexed twice",
+			secondaryIndexes: []string{"title"},
+			uniqueIndexes:    []string{"title"},
+			err:              "title is indexed more than once",
+		},
+		{
+			name:             "unknown field",
+			secondaryIndexes: []string{"owner"},
+			err:              "the index owner isn't a field of the map",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts.NoMessage = tc.noMessage
+			secondary, unique, err := parseMapIndexes(opts, tc.secondaryIndexes, tc.uniqueIndexes)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := func(fields field.Fields) (names []string) {
+				for _, f := range fields {
+					names = append(names, f.Name.LowerCamel)
+				}
+				return names
+			}
+			require.Equal(t, tc.expectedSecondary, names(secondary))
+			require.Equal(t, tc.expectedUnique, names(unique))
+		})
+	}
+}
+
 func Test_checkMaxLength(t *testing.T) {
 	tests := []struct {
 		desc        string
@@ -0,0 +1,91 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/templates/upgrade"
+)
+
+// AddUpgrade adds a chain upgrade handler to the app, the handler runs the migrations of all the modules
+// and the given stores are added or deleted at the height of the upgrade.
+func (s Scaffolder) AddUpgrade(name string, addedStores, deletedStores []string) error {
+	pkgName, err := upgradePackageName(name)
+	if err != nil {
+		return err
+	}
+	if err := checkStoreUpgrades(addedStores, deletedStores); err != nil {
+		return err
+	}
+
+	upgradePath := filepath.Join(s.appPath, "app", "upgrades", pkgName)
+	if _, err := os.Stat(upgradePath); err == nil {
+		return errors.Errorf("upgrade %s already exists", name)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	newRegistry := false
+	if _, err := os.Stat(filepath.Join(s.appPath, "app", "upgrades.go")); os.IsNotExist(err) {
+		newRegistry = true
+	} else if err != nil {
+		return err
+	}
+
+	g, err := upgrade.NewGenerator(&upgrade.Options{
+		ModulePath:    s.modpath.RawPath,
+		UpgradeName:   name,
+		PackageName:   pkgName,
+		AddedStores:   addedStores,
+		DeletedStores: deletedStores,
+		NewRegistry:   newRegistry,
+	})
+	if err != nil {
+		return err
+	}
+	return s.Run(g)
+}
+
+// upgradePackageName returns the name of the Go package of an upgrade, e.g. v2_0_0 for the v2.0.0 upgrade.
+func upgradePackageName(name string) (string, error) {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "_"):
+			b.WriteRune('_')
+		}
+	}
+
+	pkgName := strings.TrimSuffix(b.String(), "_")
+	if pkgName == "" {
+		return "", errors.Errorf("invalid upgrade name %q", name)
+	}
+	if unicode.IsDigit(rune(pkgName[0])) {
+		pkgName = "v" + pkgName
+	}
+	if err := checkGoReservedWord(pkgName); err != nil {
+		return "", err
+	}
+	return pkgName, nil
+}
+
+// checkStoreUpgrades checks the names of the stores added and deleted by an upgrade.
+func checkStoreUpgrades(addedStores, deletedStores []string) error {
+	stores := make(map[string]struct{})
+	for _, store := range slices.Concat(addedStores, deletedStores) {
+		if store == "" {
+			return errors.New("the store name can't be empty")
+		}
+		if _, ok := stores[store]; ok {
+			return errors.Errorf("store %s is upgraded more than once", store)
+		}
+		stores[store] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradePackageName(t *testing.T) {
+	tests := []struct {
+		name        string
+		upgradeName string
+		want        string
+		err         bool
+	}{
+		{name: "semantic version", upgradeName: "v2.0.0", want: "v2_0_0"},
+		{name: "version without prefix", upgradeName: "2.1", want: "v2_1"},
+		{name: "named upgrade", upgradeName: "Mars-Upgrade", want: "mars_upgrade"},
+		{name: "trailing separator", upgradeName: "v3-", want: "v3"},
+		{name: "no letter or digit", upgradeName: "...", err: true},
+		{name: "reserved word", upgradeName: "func", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := upgradePackageName(tt.upgradeName)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCheckStoreUpgrades(t *testing.T) {
+	require.NoError(t, checkStoreUpgrades([]string{"foo"}, []string{"bar"}))
+	require.NoError(t, checkStoreUpgrades(nil, nil))
+	require.EqualError(t, checkStoreUpgrades([]string{"foo"}, []string{"foo"}), "store foo is upgraded more than once")
+	require.EqualError(t, checkStoreUpgrades([]string{""}, nil), "the store name can't be empty")
+}
@@ -0,0 +1,135 @@
+package endblocker
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+)
+
+//go:embed files/* files/**/*
+var files embed.FS
+
+// NewGenerator returns the generator to scaffold an end blocker processing a scheduled queue in a module.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	subFs, err := fs.Sub(files, "files")
+	if err != nil {
+		return nil, errors.Errorf("fail to generate sub: %w", err)
+	}
+
+	g := genny.New()
+	g.RunFn(keeperModify(opts))
+	g.RunFn(moduleModify(opts))
+
+	if err := g.OnlyFS(subFs, nil, nil); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("QueueName", opts.QueueName)
+	ctx.Set("QueueBy", opts.QueueBy)
+	ctx.Set("MaxPerBlock", opts.MaxPerBlock)
+	ctx.Set("IsTime", opts.IsTime())
+	ctx.Set("DueType", opts.DueType())
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{queueName}}", opts.QueueName.Snake))
+	return g, nil
+}
+
+// keeperModify adds the queue collection to the keeper of the module.
+func keeperModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		imports := []xast.ImportOptions{xast.WithImport("cosmossdk.io/collections")}
+		if opts.IsTime() {
+			imports = append(
+				imports,
+				xast.WithImport("time"),
+				xast.WithNamedImport("sdk", "github.com/cosmos/cosmos-sdk/types"),
+			)
+		}
+		content, err := xast.AppendImports(f.String(), imports...)
+		if err != nil {
+			return err
+		}
+
+		queueName := fmt.Sprintf("%sQueue", opts.QueueName.UpperCamel)
+		content, err = xast.ModifyStruct(
+			content,
+			"Keeper",
+			xast.AppendStructValue(
+				queueName,
+				fmt.Sprintf("collections.KeySet[collections.Pair[%s, uint64]]", opts.DueType()),
+			),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(
+			content,
+			"NewKeeper",
+			xast.AppendFuncStruct(
+				"Keeper",
+				queueName,
+				fmt.Sprintf(
+					`collections.NewKeySet(sb, types.%[1]vKey, "%[2]vQueue", collections.PairKeyCodec(%[3]v, collections.Uint64Key))`,
+					queueName,
+					opts.QueueName.LowerCamel,
+					opts.DueKeyCodec(),
+				),
+			),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// moduleModify processes the queue in the end blocker of the module.
+func moduleModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "module/module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateProcess := `if err := am.keeper.Process%[1]vQueue(ctx); err != nil {
+	return err
+}`
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"EndBlock",
+			xast.NameFuncParam(0, "ctx"),
+			xast.AppendFuncCode(fmt.Sprintf(templateProcess, opts.QueueName.UpperCamel)),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
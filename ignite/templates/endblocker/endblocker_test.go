@@ -0,0 +1,104 @@
+package endblocker
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+)
+
+const (
+	keeperFile = `package keeper
+
+import (
+	"cosmossdk.io/collections"
+)
+
+type Keeper struct {
+	Schema collections.Schema
+}
+
+func NewKeeper() Keeper {
+	sb := collections.NewSchemaBuilder(nil)
+
+	k := Keeper{}
+	return k
+}
+`
+	moduleFile = `package blog
+
+// EndBlock contains the logic that is automatically triggered at the end of each block.
+func (am AppModule) EndBlock(_ context.Context) error {
+	return nil
+}
+`
+)
+
+func TestNewGenerator(t *testing.T) {
+	run := func(t *testing.T, queueBy string) *genny.Runner {
+		t.Helper()
+
+		r := genny.DryRunner(t.Context())
+		r.Disk.Add(genny.NewFileS("x/blog/keeper/keeper.go", keeperFile))
+		r.Disk.Add(genny.NewFileS("x/blog/module/module.go", moduleFile))
+
+		g, err := NewGenerator(&Options{
+			ModuleName:  "blog",
+			ModulePath:  "github.com/test/blog",
+			QueueName:   multiformatname.MustNewName("expiry"),
+			QueueBy:     queueBy,
+			MaxPerBlock: 50,
+		})
+		require.NoError(t, err)
+		require.NoError(t, r.With(g))
+		require.NoError(t, r.Run())
+		return r
+	}
+	find := func(t *testing.T, r *genny.Runner, path string) string {
+		t.Helper()
+
+		f, err := r.Disk.Find(path)
+		require.NoError(t, err)
+		return f.String()
+	}
+
+	t.Run("queue by height", func(t *testing.T) {
+		r := run(t, QueueByHeight)
+
+		keeper := find(t, r, "x/blog/keeper/keeper.go")
+		require.Contains(t, keeper, "ExpiryQueue collections.KeySet[collections.Pair[int64, uint64]]")
+		require.Contains(t, keeper, `collections.NewKeySet(sb, types.ExpiryQueueKey, "expiryQueue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key))`)
+
+		module := find(t, r, "x/blog/module/module.go")
+		require.Contains(t, module, "func (am AppModule) EndBlock(ctx context.Context) error")
+		require.Contains(t, module, "if err := am.keeper.ProcessExpiryQueue(ctx); err != nil")
+
+		types := find(t, r, "x/blog/types/queue_expiry.go")
+		require.Contains(t, types, "ExpiryQueueMaxPerBlock = 50")
+		require.Contains(t, types, `AttributeKeyExpiryDue = "due_height"`)
+
+		queue := find(t, r, "x/blog/keeper/queue_expiry.go")
+		require.Contains(t, queue, "func (k Keeper) EnqueueExpiry(ctx context.Context, due int64, id uint64) error")
+		require.Contains(t, queue, "k.dueExpiryItems(ctx, sdkCtx.BlockHeight())")
+		require.NotContains(t, queue, `"time"`)
+
+		test := find(t, r, "x/blog/keeper/queue_expiry_test.go")
+		require.Contains(t, test, "WithBlockHeight(10)")
+	})
+
+	t.Run("queue by time", func(t *testing.T) {
+		r := run(t, QueueByTime)
+
+		keeper := find(t, r, "x/blog/keeper/keeper.go")
+		require.Contains(t, keeper, `sdk "github.com/cosmos/cosmos-sdk/types"`)
+		require.Contains(t, keeper, "ExpiryQueue collections.KeySet[collections.Pair[time.Time, uint64]]")
+		require.Contains(t, keeper, "collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)")
+
+		queue := find(t, r, "x/blog/keeper/queue_expiry.go")
+		require.Contains(t, queue, "func (k Keeper) EnqueueExpiry(ctx context.Context, due time.Time, id uint64) error")
+		require.Contains(t, queue, "k.dueExpiryItems(ctx, sdkCtx.BlockTime())")
+		require.Contains(t, queue, "item.K1().Format(time.RFC3339Nano)")
+	})
+}
@@ -0,0 +1,43 @@
+package endblocker
+
+import (
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+)
+
+const (
+	// QueueByHeight orders the queue by block height.
+	QueueByHeight = "height"
+
+	// QueueByTime orders the queue by block time.
+	QueueByTime = "time"
+)
+
+// Options ...
+type Options struct {
+	ModuleName  string
+	ModulePath  string
+	QueueName   multiformatname.Name
+	QueueBy     string
+	MaxPerBlock uint64
+}
+
+// IsTime returns true if the queue is ordered by block time.
+func (opts *Options) IsTime() bool {
+	return opts.QueueBy == QueueByTime
+}
+
+// DueType returns the Go type of the due key of the queue.
+func (opts *Options) DueType() string {
+	if opts.IsTime() {
+		return "time.Time"
+	}
+	return "int64"
+}
+
+// DueKeyCodec returns the collections key codec of the due key of the queue.
+func (opts *Options) DueKeyCodec() string {
+	if opts.IsTime() {
+		return "sdk.TimeKey"
+	}
+	return "collections.Int64Key"
+}
@@ -0,0 +1,133 @@
+package enum
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+)
+
+// Options represents the options to scaffold the enums used by the fields of a component.
+type Options struct {
+	AppName    string
+	ProtoDir   string
+	ProtoVer   string
+	ModuleName string
+	ModulePath string
+	Fields     field.Fields
+}
+
+// ProtoFile returns the path to the proto file declaring the enum.
+func (opts *Options) ProtoFile(enumName string) (string, error) {
+	name, err := multiformatname.NewName(enumName)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(opts.ProtoDir, opts.AppName, opts.ModuleName, opts.ProtoVer, name.Snake+".proto"), nil
+}
+
+// NewGenerator returns the generator to scaffold the proto files declaring the enums of the fields.
+// Each enum is declared in its own proto file, which is kept if the enum already exists with the same values.
+func NewGenerator(opts *Options) *genny.Generator {
+	g := genny.New()
+	g.RunFn(protoEnumsCreate(opts))
+	return g
+}
+
+func protoEnumsCreate(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		declared := make(map[string]string)
+		for _, definition := range opts.Fields.Enums() {
+			name := datatype.EnumName(definition)
+			if existing, ok := declared[name]; ok && existing != definition {
+				return errors.Errorf("enum %s is defined with different values", name)
+			}
+			declared[name] = definition
+
+			path, err := opts.ProtoFile(name)
+			if err != nil {
+				return err
+			}
+
+			f, err := r.Disk.Find(path)
+			switch {
+			case os.IsNotExist(err):
+				if err := protoEnumCreate(r, opts, path, definition); err != nil {
+					return err
+				}
+				continue
+			case err != nil:
+				return err
+			}
+
+			content := f.String()
+			protoFile, err := protoutil.ParseProtoFile(strings.NewReader(content))
+			if err != nil {
+				return err
+			}
+			if err := checkEnum(protoFile, path, definition); err != nil {
+				return err
+			}
+
+			// the file found is part of the runner results, so it is kept unchanged.
+			if err := r.File(genny.NewFileS(path, content)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func protoEnumCreate(r *genny.Runner, opts *Options, path, definition string) error {
+	header := fmt.Sprintf(`syntax = "proto3";
+package %s;
+
+option go_package = "%s/x/%s/types";
+`,
+		module.ProtoPackageName(opts.ModulePath, opts.ModuleName, opts.ProtoVer),
+		opts.ModulePath,
+		opts.ModuleName,
+	)
+	protoFile, err := protoutil.ParseProtoFile(strings.NewReader(header))
+	if err != nil {
+		return err
+	}
+	protoutil.Append(protoFile, datatype.ProtoEnum(definition))
+
+	newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+	return r.File(newFile)
+}
+
+// checkEnum checks the enum of the definition is declared in the proto file with the same values.
+func checkEnum(protoFile *proto.Proto, path, definition string) error {
+	name := datatype.EnumName(definition)
+	for _, el := range protoFile.Elements {
+		enum, ok := el.(*proto.Enum)
+		if !ok || enum.Name != name {
+			continue
+		}
+
+		values := make([]string, 0, len(enum.Elements))
+		for _, enumEl := range enum.Elements {
+			if f, ok := enumEl.(*proto.EnumField); ok {
+				values = append(values, f.Name)
+			}
+		}
+		if !slices.Equal(values, datatype.EnumValues(definition)) {
+			return errors.Errorf("enum %s already exists in %s with different values", name, path)
+		}
+		return nil
+	}
+	return errors.Errorf("file %s already exists and doesn't declare the enum %s", path, name)
+}
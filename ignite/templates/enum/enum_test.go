@@ -0,0 +1,79 @@
+package enum
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+func TestNewGenerator(t *testing.T) {
+	fields, err := field.ParseFields([]string{"status:enum:Status(ACTIVE|INACTIVE)", "name"}, func(string) error { return nil })
+	require.NoError(t, err)
+
+	opts := &Options{
+		AppName:    "blog",
+		ProtoDir:   "proto",
+		ProtoVer:   "v1",
+		ModuleName: "blog",
+		ModulePath: "github.com/test/blog",
+		Fields:     fields,
+	}
+	path := "proto/blog/blog/v1/status.proto"
+
+	run := func(existing string) (string, error) {
+		r := genny.DryRunner(t.Context())
+		if existing != "" {
+			r.Disk.Add(genny.NewFileS(path, existing))
+		}
+		if err := r.With(NewGenerator(opts)); err != nil {
+			return "", err
+		}
+		if err := r.Run(); err != nil {
+			return "", err
+		}
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return "", err
+		}
+		return f.String(), nil
+	}
+
+	created, err := run("")
+	require.NoError(t, err)
+	require.Equal(t, `syntax = "proto3";
+
+package blog.blog.v1;
+
+option go_package = "github.com/test/blog/x/blog/types";
+
+// Status defines the Status enum.
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_ACTIVE      = 1;
+  STATUS_INACTIVE    = 2;
+}
+`, created)
+
+	// the existing enum is kept as is
+	kept, err := run(created)
+	require.NoError(t, err)
+	require.Equal(t, created, kept)
+
+	// the existing enum has different values
+	_, err = run(`syntax = "proto3";
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_ACTIVE = 1;
+}
+`)
+	require.ErrorContains(t, err, "enum Status already exists in proto/blog/blog/v1/status.proto with different values")
+
+	// the existing file doesn't declare the enum
+	_, err = run(`syntax = "proto3";
+message Status {}
+`)
+	require.ErrorContains(t, err, "doesn't declare the enum Status")
+}
@@ -0,0 +1,153 @@
+package event
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+//go:embed files/* files/**/*
+var files embed.FS
+
+// NewGenerator returns the generator to scaffold a typed event and its emitter in a module.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	subFs, err := fs.Sub(files, "files")
+	if err != nil {
+		return nil, errors.Errorf("fail to generate sub: %w", err)
+	}
+
+	g := genny.New()
+	g.RunFn(protoEventModify(opts))
+
+	if err := g.OnlyFS(subFs, nil, nil); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("EventName", opts.EventName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{eventName}}", opts.EventName.Snake))
+	return g, nil
+}
+
+// NewTypeEventsGenerator returns the generator to add the events emitted when
+// a value of a type is created, updated and deleted. The events contain the value.
+func NewTypeEventsGenerator(opts *typed.Options) *genny.Generator {
+	var (
+		crudMessages = typed.CRUDMessages(opts.TypeName)
+		messages     = make([]*proto.Message, 0, len(crudMessages))
+	)
+	for i, name := range TypeEvents(opts.TypeName) {
+		msg := protoutil.NewMessage(
+			MessageName(name),
+			protoutil.WithFields(protoutil.NewField(
+				opts.TypeName.Snake,
+				opts.TypeName.PascalCase,
+				1,
+				protoutil.WithFieldOptions(protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())),
+			)),
+		)
+		protoutil.AttachComment(msg, fmt.Sprintf("%s is emitted by the Msg%s message.", msg.Name, crudMessages[i]))
+		messages = append(messages, msg)
+	}
+
+	g := genny.New()
+	g.RunFn(protoEventsModify(
+		typeEventsOptions(opts),
+		[]string{typed.GoGoProtoImport, opts.ProtoTypeImport().Filename},
+		messages...,
+	))
+	return g
+}
+
+// typeEventsOptions returns the options of the events proto file of the module of a type.
+func typeEventsOptions(opts *typed.Options) *Options {
+	return &Options{
+		AppName:    opts.AppName,
+		ProtoDir:   opts.ProtoDir,
+		ProtoVer:   opts.ProtoVer,
+		ModuleName: opts.ModuleName,
+		ModulePath: opts.ModulePath,
+	}
+}
+
+// protoEventModify adds the message of the event to the events proto file of the module.
+func protoEventModify(opts *Options) genny.RunFn {
+	fields := make([]*proto.NormalField, 0, len(opts.Fields))
+	for i, f := range opts.Fields {
+		fields = append(fields, f.ToProtoField(i+1))
+	}
+	msg := protoutil.NewMessage(MessageName(opts.EventName.PascalCase), protoutil.WithFields(fields...))
+	protoutil.AttachComment(msg, fmt.Sprintf("%s defines the %s event.", msg.Name, opts.EventName.PascalCase))
+
+	imports := opts.Fields.ProtoImports()
+	for _, f := range opts.Fields.Custom() {
+		imports = append(imports, fmt.Sprintf("%s/%s/%s/%s.proto", opts.AppName, opts.ModuleName, opts.ProtoVer, f))
+	}
+	return protoEventsModify(opts, imports, msg)
+}
+
+// protoEventsModify appends the event messages to the events proto file of the module,
+// the file is created if it doesn't exist yet.
+func protoEventsModify(opts *Options, imports []string, messages ...*proto.Message) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.ProtoFile()
+		content := fmt.Sprintf(`syntax = "proto3";
+package %s;
+
+option go_package = "%s/x/%s/types";
+`,
+			module.ProtoPackageName(opts.ModulePath, opts.ModuleName, opts.ProtoVer),
+			opts.ModulePath,
+			opts.ModuleName,
+		)
+		f, err := r.Disk.Find(path)
+		switch {
+		case err == nil:
+			content = f.String()
+		case !os.IsNotExist(err):
+			return err
+		}
+
+		protoFile, err := protoutil.ParseProtoFile(strings.NewReader(content))
+		if err != nil {
+			return err
+		}
+
+		protoImports := make([]*proto.Import, 0, len(imports))
+		for _, imp := range imports {
+			protoImports = append(protoImports, protoutil.NewImport(imp))
+		}
+		if err := protoutil.AddImports(protoFile, true, protoImports...); err != nil {
+			return errors.Errorf("failed to add imports to %s: %w", path, err)
+		}
+
+		for _, msg := range messages {
+			if protoutil.HasMessage(protoFile, msg.Name) {
+				return errors.Errorf("event %s already exists in %s", msg.Name, path)
+			}
+			protoutil.Append(protoFile, msg)
+		}
+
+		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+		return r.File(newFile)
+	}
+}
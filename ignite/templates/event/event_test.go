@@ -0,0 +1,128 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+const protoPath = "proto/blog/blog/v1/events.proto"
+
+func TestNewGenerator(t *testing.T) {
+	fields, err := field.ParseFields([]string{"title", "likes:uint"}, func(string) error { return nil })
+	require.NoError(t, err)
+
+	r := genny.DryRunner(t.Context())
+	g, err := NewGenerator(&Options{
+		AppName:    "blog",
+		ProtoDir:   "proto",
+		ProtoVer:   "v1",
+		ModuleName: "blog",
+		ModulePath: "github.com/test/blog",
+		EventName:  multiformatname.MustNewName("post-published"),
+		Fields:     fields,
+	})
+	require.NoError(t, err)
+	require.NoError(t, r.With(g))
+	require.NoError(t, r.Run())
+
+	f, err := r.Disk.Find(protoPath)
+	require.NoError(t, err)
+	require.Equal(t, `syntax = "proto3";
+
+package blog.blog.v1;
+
+option go_package = "github.com/test/blog/x/blog/types";
+
+// EventPostPublished defines the PostPublished event.
+message EventPostPublished {
+  string title = 1;
+  uint64 likes = 2;
+}
+
+`, f.String())
+
+	f, err = r.Disk.Find("x/blog/keeper/event_post_published.go")
+	require.NoError(t, err)
+	require.Contains(t, f.String(), "func (k Keeper) EmitPostPublishedEvent(ctx context.Context, event types.EventPostPublished) error")
+	require.Contains(t, f.String(), `"github.com/test/blog/x/blog/types"`)
+}
+
+func TestTypeEventsGenerator(t *testing.T) {
+	const existing = `syntax = "proto3";
+
+package blog.blog.v1;
+
+option go_package = "github.com/test/blog/x/blog/types";
+
+// EventPostPublished defines the PostPublished event.
+message EventPostPublished {
+  string title = 1;
+}
+`
+	opts := &typed.Options{
+		AppName:    "blog",
+		ProtoDir:   "proto",
+		ProtoVer:   "v1",
+		ModuleName: "blog",
+		ModulePath: "github.com/test/blog",
+		TypeName:   multiformatname.MustNewName("post"),
+	}
+	run := func(content string, g *genny.Generator) (string, error) {
+		r := genny.DryRunner(t.Context())
+		r.Disk.Add(genny.NewFileS(protoPath, content))
+		if err := r.With(g); err != nil {
+			return "", err
+		}
+		if err := r.Run(); err != nil {
+			return "", err
+		}
+		f, err := r.Disk.Find(protoPath)
+		if err != nil {
+			return "", err
+		}
+		return f.String(), nil
+	}
+
+	added, err := run(existing, NewTypeEventsGenerator(opts))
+	require.NoError(t, err)
+	require.Contains(t, added, `import "gogoproto/gogo.proto";`)
+	require.Contains(t, added, `import "blog/blog/v1/post.proto";`)
+	require.Contains(t, added, `// EventPostPublished defines the PostPublished event.
+message EventPostPublished {
+  string title = 1;
+}
+
+// EventPostCreated is emitted by the MsgCreatePost message.
+message EventPostCreated {
+  Post post = 1 [(gogoproto.nullable) = false];
+}
+
+// EventPostUpdated is emitted by the MsgUpdatePost message.
+message EventPostUpdated {
+  Post post = 1 [(gogoproto.nullable) = false];
+}
+
+// EventPostDeleted is emitted by the MsgDeletePost message.
+message EventPostDeleted {
+  Post post = 1 [(gogoproto.nullable) = false];
+}
+`)
+
+	// the events can't be added twice
+	_, err = run(added, NewTypeEventsGenerator(opts))
+	require.EqualError(t, err, "event EventPostCreated already exists in "+protoPath)
+
+	removed, err := run(added, NewTypeEventsRemoveGenerator(opts))
+	require.NoError(t, err)
+	require.NotContains(t, removed, "EventPostCreated")
+	require.NotContains(t, removed, "EventPostUpdated")
+	require.NotContains(t, removed, "EventPostDeleted")
+	require.NotContains(t, removed, "blog/blog/v1/post.proto")
+	require.Contains(t, removed, "EventPostPublished")
+}
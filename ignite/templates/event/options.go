@@ -0,0 +1,42 @@
+package event
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// ProtoFileName is the name of the proto file declaring the events of a module.
+const ProtoFileName = "events.proto"
+
+// Options ...
+type Options struct {
+	AppName    string
+	ProtoDir   string
+	ProtoVer   string
+	ModuleName string
+	ModulePath string
+	EventName  multiformatname.Name
+	Fields     field.Fields
+}
+
+// ProtoFile returns the path to the proto file declaring the events of the module.
+func (opts *Options) ProtoFile() string {
+	return filepath.Join(opts.ProtoDir, opts.AppName, opts.ModuleName, opts.ProtoVer, ProtoFileName)
+}
+
+// MessageName returns the name of the proto message of an event.
+func MessageName(eventName string) string {
+	return fmt.Sprintf("Event%s", eventName)
+}
+
+// TypeEvents returns the names of the events emitted when a value of a type is created, updated and deleted.
+func TypeEvents(typeName multiformatname.Name) []string {
+	return []string{
+		fmt.Sprintf("%sCreated", typeName.PascalCase),
+		fmt.Sprintf("%sUpdated", typeName.PascalCase),
+		fmt.Sprintf("%sDeleted", typeName.PascalCase),
+	}
+}
@@ -0,0 +1,19 @@
+package event
+
+import (
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+// NewTypeEventsRemoveGenerator returns the generator to remove the events added by NewTypeEventsGenerator.
+func NewTypeEventsRemoveGenerator(opts *typed.Options) *genny.Generator {
+	removal := typed.ProtoRemoval{Imports: []string{opts.ProtoTypeImport().Filename}}
+	for _, name := range TypeEvents(opts.TypeName) {
+		removal.Messages = append(removal.Messages, MessageName(name))
+	}
+
+	g := genny.New()
+	g.RunFn(typed.ProtoRemoveModify(typeEventsOptions(opts).ProtoFile(), removal))
+	return g
+}
@@ -0,0 +1,132 @@
+package datatype
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+const (
+	enumValuesStart     = "("
+	enumValuesEnd       = ")"
+	enumValuesSeparator = "|"
+	enumUnspecified     = "UNSPECIFIED"
+)
+
+// DataEnum is a protobuf enum data type definition.
+// The datatype of the field is the enum definition with the format Name(A|B|C).
+var DataEnum = DataType{
+	Name:                    Enum,
+	DataType:                func(datatype string) string { return EnumName(datatype) },
+	CollectionsKeyValueName: func(string) string { return collectionValueComment },
+	DefaultTestValue:        "unspecified", // AutoCLI name of the first value of any enum.
+	ValueLoop:               "1",
+	ProtoType: func(datatype, name string, index int) string {
+		return fmt.Sprintf("%s %s = %d", EnumName(datatype), name, index)
+	},
+	GenesisArgs: func(name multiformatname.Name, _ int) string {
+		return fmt.Sprintf("%s: 1,\n", name.UpperCamel)
+	},
+	GoCLIImports: []GoImport{{Name: "fmt"}, {Name: "strings"}},
+	// the values are parsed from their proto names, like FOO_STATUS_ACTIVE, or
+	// from their AutoCLI names without the enum prefix, like active.
+	CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%[1]v%[2]vValue, ok := types.%[3]v_value[args[%[4]v]]
+					if !ok {
+						%[1]v%[2]vValue, ok = types.%[3]v_value["%[5]v"+strings.ToUpper(strings.ReplaceAll(args[%[4]v], "-", "_"))]
+					}
+					if !ok {
+						return fmt.Errorf("invalid %[3]v value %%s", args[%[4]v])
+					}
+					%[1]v%[2]v := types.%[3]v(%[1]v%[2]vValue)`, prefix, name.UpperCamel, EnumName(datatype), argIndex, enumPrefix(datatype))
+	},
+	ToProtoField: func(datatype, name string, index int) *proto.NormalField {
+		return protoutil.NewField(name, EnumName(datatype), index)
+	},
+	NonIndex: true,
+}
+
+// ParseEnum parses an enum definition with the format Name(A|B|C) and returns its
+// normalized form, where the name is in upper camel case and the values in upper snake case.
+func ParseEnum(definition string) (string, error) {
+	name, values, ok := strings.Cut(definition, enumValuesStart)
+	if !ok || !strings.HasSuffix(values, enumValuesEnd) {
+		return "", errors.Errorf("invalid enum %s, should be 'Name(A|B|C)'", definition)
+	}
+	enumName, err := multiformatname.NewName(name, multiformatname.NoNumber)
+	if err != nil {
+		return "", errors.Errorf("invalid enum name %s: %w", name, err)
+	}
+
+	var (
+		normalized = make([]string, 0)
+		exist      = make(map[string]struct{})
+	)
+	for _, value := range strings.Split(strings.TrimSuffix(values, enumValuesEnd), enumValuesSeparator) {
+		valueName, err := multiformatname.NewName(value)
+		if err != nil {
+			return "", errors.Errorf("invalid value %s for enum %s: %w", value, name, err)
+		}
+		value = strings.ToUpper(valueName.Snake)
+		if value == enumUnspecified {
+			return "", errors.Errorf("the value %s of enum %s is reserved", value, name)
+		}
+		if _, ok := exist[value]; ok {
+			return "", errors.Errorf("the value %s of enum %s is duplicated", value, name)
+		}
+		exist[value] = struct{}{}
+		normalized = append(normalized, value)
+	}
+
+	return fmt.Sprintf(
+		"%s%s%s%s",
+		enumName.UpperCamel,
+		enumValuesStart,
+		strings.Join(normalized, enumValuesSeparator),
+		enumValuesEnd,
+	), nil
+}
+
+// EnumName returns the name of the enum from its definition.
+func EnumName(definition string) string {
+	name, _, _ := strings.Cut(definition, enumValuesStart)
+	return name
+}
+
+// EnumValues returns the proto value names of the enum from its definition.
+// The values are prefixed with the enum name, and the first value is the unspecified one.
+func EnumValues(definition string) []string {
+	_, values, _ := strings.Cut(definition, enumValuesStart)
+	var (
+		prefix      = enumPrefix(definition)
+		protoValues = []string{prefix + enumUnspecified}
+	)
+	for _, value := range strings.Split(strings.TrimSuffix(values, enumValuesEnd), enumValuesSeparator) {
+		protoValues = append(protoValues, prefix+value)
+	}
+	return protoValues
+}
+
+// enumPrefix returns the prefix of the proto value names of the enum from its definition.
+func enumPrefix(definition string) string {
+	enumName, err := multiformatname.NewName(EnumName(definition), multiformatname.NoNumber)
+	if err != nil {
+		panic(err)
+	}
+	return strings.ToUpper(enumName.Snake) + "_"
+}
+
+// ProtoEnum returns the enum from its definition as a *proto.Enum node.
+func ProtoEnum(definition string) *proto.Enum {
+	values := EnumValues(definition)
+	fields := make([]*proto.EnumField, 0, len(values))
+	for i, value := range values {
+		fields = append(fields, protoutil.NewEnumField(value, i))
+	}
+	return protoutil.NewEnum(EnumName(definition), protoutil.WithEnumFields(fields...))
+}
@@ -0,0 +1,98 @@
+package datatype_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+)
+
+func TestParseEnum(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       string
+		err        bool
+	}{
+		{
+			name:       "valid enum",
+			definition: "Status(ACTIVE|INACTIVE)",
+			want:       "Status(ACTIVE|INACTIVE)",
+		},
+		{
+			name:       "normalized enum",
+			definition: "order-kind(buy|stopLoss)",
+			want:       "OrderKind(BUY|STOP_LOSS)",
+		},
+		{
+			name:       "missing values",
+			definition: "Status",
+			err:        true,
+		},
+		{
+			name:       "empty values",
+			definition: "Status()",
+			err:        true,
+		},
+		{
+			name:       "unclosed values",
+			definition: "Status(A|B",
+			err:        true,
+		},
+		{
+			name:       "duplicated value",
+			definition: "Status(A|B|A)",
+			err:        true,
+		},
+		{
+			name:       "reserved value",
+			definition: "Status(UNSPECIFIED|A)",
+			err:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := datatype.ParseEnum(tt.definition)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEnumValues(t *testing.T) {
+	definition := "OrderKind(BUY|SELL)"
+
+	require.Equal(t, "OrderKind", datatype.EnumName(definition))
+	require.Equal(t, []string{"ORDER_KIND_UNSPECIFIED", "ORDER_KIND_BUY", "ORDER_KIND_SELL"}, datatype.EnumValues(definition))
+
+	f, err := protoutil.ParseProtoFile(strings.NewReader(`syntax = "proto3";`))
+	require.NoError(t, err)
+	protoutil.Append(f, datatype.ProtoEnum(definition))
+	require.Equal(t, `syntax = "proto3";
+
+// OrderKind defines the OrderKind enum.
+enum OrderKind {
+  ORDER_KIND_UNSPECIFIED = 0;
+  ORDER_KIND_BUY         = 1;
+  ORDER_KIND_SELL        = 2;
+}
+`, protoutil.Print(f))
+}
+
+func TestEnumCLIArgs(t *testing.T) {
+	name, err := multiformatname.NewName("kind")
+	require.NoError(t, err)
+
+	args := datatype.DataEnum.CLIArgs(name, "OrderKind(BUY|STOP_LOSS)", "arg", 1)
+	require.Contains(t, args, `argKindValue, ok := types.OrderKind_value[args[1]]`)
+	require.Contains(t, args, `argKindValue, ok = types.OrderKind_value["ORDER_KIND_"+strings.ToUpper(strings.ReplaceAll(args[1], "-", "_"))]`)
+	require.Contains(t, args, `argKind := types.OrderKind(argKindValue)`)
+}
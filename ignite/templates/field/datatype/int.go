@@ -45,6 +45,41 @@ var (
 		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
 	}
 
+	// DataInt32 is an int32 data type definition.
+	DataInt32 = DataType{
+		Name:                    Int32,
+		DataType:                func(string) string { return "int32" },
+		CollectionsKeyValueName: func(string) string { return "collections.Int32Key" },
+		DefaultTestValue:        "111",
+		ValueLoop:               "int32(i)",
+		ValueIndex:              "0",
+		ValueInvalidIndex:       "100000",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("int32 %s = %d", name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: %d,\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := cast.ToInt32E(args[%d])
+            		if err != nil {
+                		return err
+            		}`,
+				prefix, name.UpperCamel, argIndex)
+		},
+		ToBytes: func(name string) string {
+			return fmt.Sprintf(`%[1]vBytes := make([]byte, 4)
+  					binary.BigEndian.PutUint32(%[1]vBytes, uint32(%[1]v))`, name)
+		},
+		ToString: func(name string) string {
+			return fmt.Sprintf("strconv.FormatInt(int64(%s), 10)", name)
+		},
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, "int32", index)
+		},
+		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+	}
+
 	// DataIntSlice is an int array data type definition.
 	DataIntSlice = DataType{
 		Name:                    IntSlice,
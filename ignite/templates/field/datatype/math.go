@@ -0,0 +1,72 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+var (
+	// DataDec legacy decimal data type definition.
+	DataDec = DataType{
+		Name:                    Dec,
+		DataType:                func(string) string { return "math.LegacyDec" },
+		CollectionsKeyValueName: func(string) string { return collectionValueComment },
+		DefaultTestValue:        "1.5",
+		ValueLoop:               "math.LegacyNewDec(int64(i))",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf(`string %s = %d [(cosmos_proto.scalar) = "cosmos.Dec", (gogoproto.customtype) = "cosmossdk.io/math.LegacyDec", (gogoproto.nullable) = false]`,
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := math.LegacyNewDecFromStr(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoCLIImports: []GoImport{{Name: "cosmossdk.io/math"}},
+		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos_proto/cosmos.proto"},
+		NonIndex:     true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, "string", index, protoutil.WithFieldOptions(
+				protoutil.NewOption("cosmos_proto.scalar", "cosmos.Dec", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.customtype", "cosmossdk.io/math.LegacyDec", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom()),
+			))
+		},
+	}
+
+	// DataMathInt math int data type definition.
+	DataMathInt = DataType{
+		Name:                    MathInt,
+		DataType:                func(string) string { return "math.Int" },
+		CollectionsKeyValueName: func(string) string { return collectionValueComment },
+		DefaultTestValue:        "1000",
+		ValueLoop:               "math.NewInt(int64(i))",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf(`string %s = %d [(cosmos_proto.scalar) = "cosmos.Int", (gogoproto.customtype) = "cosmossdk.io/math.Int", (gogoproto.nullable) = false]`,
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]s%[2]s, ok := math.NewIntFromString(args[%[3]d])
+					if !ok {
+						return fmt.Errorf("invalid integer %%s", args[%[3]d])
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoCLIImports: []GoImport{{Name: "cosmossdk.io/math"}},
+		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos_proto/cosmos.proto"},
+		NonIndex:     true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, "string", index, protoutil.WithFieldOptions(
+				protoutil.NewOption("cosmos_proto.scalar", "cosmos.Int", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.customtype", "cosmossdk.io/math.Int", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom()),
+			))
+		},
+	}
+)
@@ -0,0 +1,76 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+var (
+	// DataTimestamp timestamp data type definition.
+	DataTimestamp = DataType{
+		Name:                    Timestamp,
+		DataType:                func(string) string { return "time.Time" },
+		CollectionsKeyValueName: func(string) string { return collectionValueComment },
+		DefaultTestValue:        "2024-01-01T00:00:00Z",
+		ValueLoop:               "time.Unix(int64(i), 0).UTC()",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Timestamp %s = %d [(gogoproto.nullable) = false, (gogoproto.stdtime) = true]",
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.Parse(time.RFC3339, args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoCLIImports: []GoImport{{Name: "time"}},
+		ProtoImports: []string{"gogoproto/gogo.proto", "google/protobuf/timestamp.proto"},
+		NonIndex:     true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(
+				name, "google.protobuf.Timestamp", index, protoutil.WithFieldOptions(
+					protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom()),
+					protoutil.NewOption("gogoproto.stdtime", "true", protoutil.Custom()),
+				),
+			)
+		},
+	}
+
+	// DataDuration duration data type definition.
+	DataDuration = DataType{
+		Name:                    Duration,
+		DataType:                func(string) string { return "time.Duration" },
+		CollectionsKeyValueName: func(string) string { return collectionValueComment },
+		DefaultTestValue:        "10s",
+		ValueLoop:               "time.Duration(i) * time.Second",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Duration %s = %d [(gogoproto.nullable) = false, (gogoproto.stdduration) = true]",
+				name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: %d,\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.ParseDuration(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoCLIImports: []GoImport{{Name: "time"}},
+		ProtoImports: []string{"gogoproto/gogo.proto", "google/protobuf/duration.proto"},
+		NonIndex:     true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(
+				name, "google.protobuf.Duration", index, protoutil.WithFieldOptions(
+					protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom()),
+					protoutil.NewOption("gogoproto.stdduration", "true", protoutil.Custom()),
+				),
+			)
+		},
+	}
+)
@@ -28,12 +28,16 @@ const (
 	Int Name = "int"
 	// Int64 represents the int64 type name.
 	Int64 Name = "int64"
+	// Int32 represents the int32 type name.
+	Int32 Name = "int32"
 	// IntSlice represents the int array type name.
 	IntSlice Name = "array.int"
 	// Uint represents the uint type name.
 	Uint Name = "uint"
 	// Uint64 represents the uint64 type name.
 	Uint64 Name = "uint64"
+	// Uint32 represents the uint32 type name.
+	Uint32 Name = "uint32"
 	// UintSlice represents the uint array type name.
 	UintSlice Name = "array.uint"
 	// Coin represents the coin type name.
@@ -48,6 +52,16 @@ const (
 	Bytes Name = "bytes"
 	// Address represents the address type name.
 	Address Name = "address"
+	// Dec represents the legacy decimal type name.
+	Dec Name = "dec"
+	// MathInt represents the math int type name.
+	MathInt Name = "math.int"
+	// Timestamp represents the timestamp type name.
+	Timestamp Name = "timestamp"
+	// Duration represents the duration type name.
+	Duration Name = "duration"
+	// Enum represents the enum type name.
+	Enum Name = "enum"
 	// Custom represents the custom type name.
 	Custom Name = Name(TypeCustom)
 	// CustomSlice represents the custom array type name.
@@ -81,10 +95,12 @@ var supportedTypes = map[Name]DataType{
 	Bool:              DataBool,
 	Int:               DataInt,
 	Int64:             DataInt,
+	Int32:             DataInt32,
 	IntSlice:          DataIntSlice,
 	IntSliceAlias:     DataIntSlice,
 	Uint:              DataUint,
 	Uint64:            DataUint,
+	Uint32:            DataUint32,
 	UintSlice:         DataUintSlice,
 	UintSliceAlias:    DataUintSlice,
 	Coin:              DataCoin,
@@ -94,6 +110,11 @@ var supportedTypes = map[Name]DataType{
 	DecCoins:          DataDecCoinSlice,
 	DecCoinSliceAlias: DataDecCoinSlice,
 	Address:           DataAddress,
+	Dec:               DataDec,
+	MathInt:           DataMathInt,
+	Timestamp:         DataTimestamp,
+	Duration:          DataDuration,
+	Enum:              DataEnum,
 	Custom:            DataCustom,
 	CustomSlice:       DataCustomSlice,
 }
@@ -127,6 +148,9 @@ func (t DataType) Usage() string {
 	if t.Name == Custom || t.Name == CustomSlice {
 		return "use the custom type to scaffold already created chain types."
 	}
+	if t.Name == Enum {
+		return "use '<FIELD_NAME>:enum:<ENUM_NAME>(A|B|C)' to scaffold a protobuf enum with the given values (eg: status:enum:Status(ACTIVE|INACTIVE))."
+	}
 	usage := fmt.Sprintf("use '<FIELD_NAME>:%s' to scaffold %s types (eg: %s).", t.Name, t.DataType(""), t.DefaultTestValue)
 	if t.Name == Coins || t.Name == DecCoins ||
 		t.Name == CoinSliceAlias || t.Name == DecCoinSliceAlias {
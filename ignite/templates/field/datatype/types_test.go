@@ -99,6 +99,41 @@ func TestIsSupportedType(t *testing.T) {
 			typename: datatype.Address,
 			ok:       true,
 		},
+		{
+			name:     "int32",
+			typename: datatype.Int32,
+			ok:       true,
+		},
+		{
+			name:     "uint32",
+			typename: datatype.Uint32,
+			ok:       true,
+		},
+		{
+			name:     "dec",
+			typename: datatype.Dec,
+			ok:       true,
+		},
+		{
+			name:     "math int",
+			typename: datatype.MathInt,
+			ok:       true,
+		},
+		{
+			name:     "timestamp",
+			typename: datatype.Timestamp,
+			ok:       true,
+		},
+		{
+			name:     "duration",
+			typename: datatype.Duration,
+			ok:       true,
+		},
+		{
+			name:     "enum",
+			typename: datatype.Enum,
+			ok:       true,
+		},
 		{
 			name:     "invalid type name",
 			typename: datatype.Name("invalid"),
@@ -45,6 +45,41 @@ var (
 		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
 	}
 
+	// DataUint32 is an uint32 data type definition.
+	DataUint32 = DataType{
+		Name:                    Uint32,
+		DataType:                func(string) string { return "uint32" },
+		CollectionsKeyValueName: func(string) string { return "collections.Uint32Key" },
+		DefaultTestValue:        "111",
+		ValueLoop:               "uint32(i)",
+		ValueIndex:              "0",
+		ValueInvalidIndex:       "100000",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("uint32 %s = %d", name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: %d,\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := cast.ToUint32E(args[%d])
+            		if err != nil {
+                		return err
+            		}`,
+				prefix, name.UpperCamel, argIndex)
+		},
+		ToBytes: func(name string) string {
+			return fmt.Sprintf(`%[1]vBytes := make([]byte, 4)
+  					binary.BigEndian.PutUint32(%[1]vBytes, %[1]v)`, name)
+		},
+		ToString: func(name string) string {
+			return fmt.Sprintf("strconv.FormatUint(uint64(%s), 10)", name)
+		},
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, "uint32", index)
+		},
+		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+	}
+
 	// DataUintSlice uint array data type definition.
 	DataUintSlice = DataType{
 		Name:                    UintSlice,
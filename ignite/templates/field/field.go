@@ -54,10 +54,17 @@ func (f Field) IsSlice() bool {
 		datatype.Bool,
 		datatype.Int,
 		datatype.Int64,
+		datatype.Int32,
 		datatype.Uint,
 		datatype.Uint64,
+		datatype.Uint32,
 		datatype.DecCoin,
 		datatype.Coin,
+		datatype.Dec,
+		datatype.MathInt,
+		datatype.Timestamp,
+		datatype.Duration,
+		datatype.Enum,
 		datatype.Custom:
 		return false
 	default:
@@ -70,16 +70,41 @@ func (f Fields) CLIUsage() string {
 }
 
 // Custom returns a list of custom fields.
+// Enums are declared in their own proto file, so they are returned as custom fields.
 func (f Fields) Custom() []string {
 	fields := make([]string, 0)
 	for _, field := range f {
-		if field.DatatypeName == datatype.Custom || field.DatatypeName == datatype.CustomSlice {
+		switch field.DatatypeName {
+		case datatype.Custom, datatype.CustomSlice:
 			dataType, err := multiformatname.NewName(field.Datatype)
 			if err != nil {
 				panic(err)
 			}
 			fields = append(fields, dataType.Snake)
+		case datatype.Enum:
+			dataType, err := multiformatname.NewName(datatype.EnumName(field.Datatype))
+			if err != nil {
+				panic(err)
+			}
+			fields = append(fields, dataType.Snake)
 		}
 	}
 	return fields
 }
+
+// Enums returns the definitions of the enum fields.
+func (f Fields) Enums() []string {
+	enums := make([]string, 0)
+	exist := make(map[string]struct{})
+	for _, field := range f {
+		if field.DatatypeName != datatype.Enum {
+			continue
+		}
+		if _, ok := exist[field.Datatype]; ok {
+			continue
+		}
+		exist[field.Datatype] = struct{}{}
+		enums = append(enums, field.Datatype)
+	}
+	return enums
+}
@@ -9,30 +9,43 @@ import (
 )
 
 // validateField validates the field Name and type, and checks the name is not forbidden by Ignite CLI.
-func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, error) {
-	name, dataTypeName, err := parseField(field)
+func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, string, error) {
+	name, dataTypeName, definition, err := parseField(field)
 	if err != nil {
-		return name, "", err
+		return name, "", "", err
 	}
 
 	// Ensure the field Name is not a Go reserved Name, it would generate an incorrect code
 	if err := isForbiddenField(name.LowerCamel); err != nil {
-		return name, "", errors.Errorf("%s can't be used as a field Name: %w", name, err)
+		return name, "", "", errors.Errorf("%s can't be used as a field Name: %w", name, err)
 	}
 
-	return name, dataTypeName, nil
+	return name, dataTypeName, definition, nil
 }
 
-// parseField parses the field string and returns the multiformat name and datatype name.
-func parseField(field string) (multiformatname.Name, datatype.Name, error) {
+// parseField parses the field string and returns the multiformat name, the datatype name
+// and, for enums, the normalized enum definition.
+func parseField(field string) (multiformatname.Name, datatype.Name, string, error) {
 	fieldSplit := strings.Split(field, datatype.Separator)
-	if len(fieldSplit) > 2 {
-		return multiformatname.Name{}, "", errors.Errorf("invalid field format: %s, should be 'Name' or 'Name:type'", field)
+	isEnum := len(fieldSplit) == 3 && datatype.Name(fieldSplit[1]) == datatype.Enum
+	if len(fieldSplit) > 2 && !isEnum {
+		return multiformatname.Name{}, "", "", errors.Errorf("invalid field format: %s, should be 'Name' or 'Name:type'", field)
 	}
 
 	name, err := multiformatname.NewName(fieldSplit[0])
 	if err != nil {
-		return name, "", err
+		return name, "", "", err
+	}
+
+	if isEnum {
+		definition, err := datatype.ParseEnum(fieldSplit[2])
+		if err != nil {
+			return name, "", "", err
+		}
+		return name, datatype.Enum, definition, nil
+	}
+	if len(fieldSplit) == 2 && datatype.Name(fieldSplit[1]) == datatype.Enum {
+		return name, "", "", errors.Errorf("invalid enum field format: %s, should be 'Name:enum:EnumName(A|B|C)'", field)
 	}
 
 	// Check if the object has an explicit type. The default is a string
@@ -41,14 +54,14 @@ func parseField(field string) (multiformatname.Name, datatype.Name, error) {
 	if isTypeSpecified {
 		dataTypeName = datatype.Name(fieldSplit[1])
 	}
-	return name, dataTypeName, nil
+	return name, dataTypeName, "", nil
 }
 
 // MultipleCoins checks if the provided fields contain more than one coin type.
 func MultipleCoins(fields []string) (bool, error) {
 	coinsCount := 0
 	for _, field := range fields {
-		_, datatypeName, err := parseField(field)
+		_, datatypeName, _, err := parseField(field)
 		if err != nil {
 			return false, err
 		}
@@ -77,7 +90,7 @@ func ParseFields(
 
 	var parsedFields Fields
 	for _, field := range fields {
-		name, datatypeName, err := validateField(field, isForbiddenField)
+		name, datatypeName, definition, err := validateField(field, isForbiddenField)
 		if err != nil {
 			return parsedFields, err
 		}
@@ -88,6 +101,15 @@ func ParseFields(
 		}
 		existingFields[name.LowerCamel] = struct{}{}
 
+		if datatypeName == datatype.Enum {
+			parsedFields = append(parsedFields, Field{
+				Name:         name,
+				Datatype:     definition,
+				DatatypeName: datatype.Enum,
+			})
+			continue
+		}
+
 		// Check if is a static type
 		if _, ok := datatype.IsSupportedType(datatypeName); ok {
 			parsedFields = append(parsedFields, Field{
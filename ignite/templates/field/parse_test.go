@@ -35,6 +35,18 @@ func TestForbiddenParseFields(t *testing.T) {
 	// invalid format
 	_, err = ParseFields([]string{"foo:int:int"}, alwaysInvalid)
 	require.Error(t, err)
+
+	// enum without values
+	_, err = ParseFields([]string{"foo:enum"}, noCheck)
+	require.Error(t, err)
+
+	// invalid enum definition
+	_, err = ParseFields([]string{"foo:enum:Status"}, noCheck)
+	require.Error(t, err)
+
+	// duplicated enum value
+	_, err = ParseFields([]string{"foo:enum:Status(A|a)"}, noCheck)
+	require.Error(t, err)
 }
 
 func TestParseFields1(t *testing.T) {
@@ -207,6 +219,52 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test enum types",
+			fields: []string{
+				name1.Original + ":enum:status(active|in-active)",
+				name2.Original + ":enum:OrderKind(BUY|SELL)",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Enum,
+					Datatype:     "Status(ACTIVE|IN_ACTIVE)",
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Enum,
+					Datatype:     "OrderKind(BUY|SELL)",
+				},
+			},
+		},
+		{
+			name: "test domain types",
+			fields: []string{
+				name1.Original + ":timestamp",
+				name2.Original + ":dec",
+				name3.Original + ":math.int",
+				name4.Original + ":int32",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Timestamp,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Dec,
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.MathInt,
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.Int32,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1,6 +1,7 @@
 package plushhelpers
 
 import (
+	"html/template"
 	"strings"
 
 	"github.com/gobuffalo/plush/v4"
@@ -18,6 +19,12 @@ func ExtendPlushContext(ctx *plush.Context) {
 	ctx.Set("appendFieldsAndMergeCustomImports", appendFieldsAndMergeCustomImports)
 	ctx.Set("title", xstrings.Title)
 	ctx.Set("toLower", strings.ToLower)
+	ctx.Set("raw", raw)
+}
+
+// raw prevents the value from being HTML escaped, e.g. the quoted proto field options.
+func raw(s string) template.HTML {
+	return template.HTML(s) //nolint:gosec // templates generate source code, not HTML
 }
 
 func appendFieldsAndMergeCustomImports(f field.Field, fields ...field.Fields) []string {
@@ -0,0 +1,108 @@
+package hooks
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+)
+
+//go:embed files/* files/**/*
+var files embed.FS
+
+// NewGenerator returns the generator to scaffold hooks in a module.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	subFs, err := fs.Sub(files, "files")
+	if err != nil {
+		return nil, errors.Errorf("fail to generate sub: %w", err)
+	}
+
+	g := genny.New()
+	g.RunFn(keeperModify(opts))
+	g.RunFn(depinjectModify(opts))
+
+	if err := g.OnlyFS(subFs, nil, nil); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("HookName", opts.HookName)
+	ctx.Set("Methods", opts.Methods)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{hookName}}", opts.HookName.Snake))
+	return g, nil
+}
+
+// keeperModify adds the hooks to the keeper of the module.
+// The hooks are shared by a pointer so that all the copies of the keeper call the same hooks.
+func keeperModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		hooksField := fmt.Sprintf("%sHooks", opts.HookName.LowerCamel)
+		content, err := xast.ModifyStruct(
+			f.String(),
+			"Keeper",
+			xast.AppendStructValue(hooksField, fmt.Sprintf("*types.Multi%sHooks", opts.HookName.PascalCase)),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(
+			content,
+			"NewKeeper",
+			xast.AppendFuncStruct("Keeper", hooksField, fmt.Sprintf("new(types.Multi%sHooks)", opts.HookName.PascalCase)),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// depinjectModify registers the invoker setting the hooks provided by the other modules with depinject.
+func depinjectModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "module/depinject.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"init",
+			xast.AppendInsideFuncCall(
+				"appconfig.Register",
+				fmt.Sprintf("appconfig.Invoke(InvokeSet%sHooks)", opts.HookName.PascalCase),
+				-1,
+			),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,88 @@
+package hooks
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+)
+
+const (
+	keeperFile = `package keeper
+
+type Keeper struct {
+	authority []byte
+}
+
+func NewKeeper(authority []byte) Keeper {
+	return Keeper{
+		authority: authority,
+	}
+}
+`
+	depinjectFile = `package blog
+
+import (
+	"cosmossdk.io/depinject/appconfig"
+
+	"github.com/test/blog/x/blog/keeper"
+	"github.com/test/blog/x/blog/types"
+)
+
+func init() {
+	appconfig.Register(
+		&types.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+`
+)
+
+func TestNewGenerator(t *testing.T) {
+	opts := &Options{
+		ModuleName: "blog",
+		ModulePath: "github.com/test/blog",
+		HookName:   multiformatname.MustNewName("post"),
+		Methods: []multiformatname.Name{
+			multiformatname.MustNewName("afterPostCreated"),
+			multiformatname.MustNewName("beforePostDeleted"),
+		},
+	}
+
+	r := genny.DryRunner(t.Context())
+	r.Disk.Add(genny.NewFileS("x/blog/keeper/keeper.go", keeperFile))
+	r.Disk.Add(genny.NewFileS("x/blog/module/depinject.go", depinjectFile))
+
+	g, err := NewGenerator(opts)
+	require.NoError(t, err)
+	require.NoError(t, r.With(g))
+	require.NoError(t, r.Run())
+
+	keeper, err := r.Disk.Find("x/blog/keeper/keeper.go")
+	require.NoError(t, err)
+	require.Contains(t, keeper.String(), "postHooks *types.MultiPostHooks")
+	require.Contains(t, keeper.String(), "postHooks: new(types.MultiPostHooks)")
+
+	depinject, err := r.Disk.Find("x/blog/module/depinject.go")
+	require.NoError(t, err)
+	require.Contains(t, depinject.String(), "appconfig.Invoke(InvokeSetPostHooks)")
+
+	invoker, err := r.Disk.Find("x/blog/module/hooks_post.go")
+	require.NoError(t, err)
+	require.Contains(t, invoker.String(), "package blog")
+	require.Contains(t, invoker.String(), "func InvokeSetPostHooks(k keeper.Keeper, hooks map[string]types.PostHooksWrapper) error")
+
+	hooks, err := r.Disk.Find("x/blog/types/hooks_post.go")
+	require.NoError(t, err)
+	require.Contains(t, hooks.String(), "type PostHooks interface")
+	require.Contains(t, hooks.String(), "AfterPostCreated(ctx context.Context) error")
+	require.Contains(t, hooks.String(), "func (h MultiPostHooks) BeforePostDeleted(ctx context.Context) error")
+	require.Contains(t, hooks.String(), "type PostHooksWrapper struct{ PostHooks }")
+
+	setter, err := r.Disk.Find("x/blog/keeper/hooks_post.go")
+	require.NoError(t, err)
+	require.Contains(t, setter.String(), "func (k Keeper) SetPostHooks(hooks ...types.PostHooks)")
+	require.Contains(t, setter.String(), `"github.com/test/blog/x/blog/types"`)
+}
@@ -0,0 +1,13 @@
+package hooks
+
+import (
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+)
+
+// Options ...
+type Options struct {
+	ModuleName string
+	ModulePath string
+	HookName   multiformatname.Name
+	Methods    []multiformatname.Name
+}
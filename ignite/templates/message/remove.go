@@ -0,0 +1,54 @@
+package message
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+// NewRemoveGenerator returns the generator to remove a message scaffolded in a module.
+func NewRemoveGenerator(opts *Options) *genny.Generator {
+	var (
+		g              = genny.New()
+		typenamePascal = opts.MsgName.PascalCase
+	)
+
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("tx.proto"), typed.ProtoRemoval{
+		Service: "Msg",
+		RPCs:    []string{typenamePascal},
+		Messages: []string{
+			fmt.Sprintf("Msg%s", typenamePascal),
+			fmt.Sprintf("Msg%sResponse", typenamePascal),
+		},
+	}))
+	g.RunFn(typed.TypesCodecRemove(opts.ModuleName, typenamePascal))
+	g.RunFn(typed.ClientCliRemove(opts.ModuleName, nil, []string{typenamePascal}))
+	g.RunFn(moduleSimulationRemove(opts))
+	g.RunFn(typed.RemoveFiles(
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("msg_server_%s.go", opts.MsgName.Snake)),
+		filepath.Join("x", opts.ModuleName, "simulation", opts.MsgName.Snake+".go"),
+	))
+
+	return g
+}
+
+func moduleSimulationRemove(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "module/simulation.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := typed.RemoveModuleSimulationMsg(f.String(), opts.MsgName)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,100 @@
+package migration
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+)
+
+var (
+	//go:embed files/migration/* files/migration/**/*
+	fsMigration embed.FS
+
+	//go:embed files/migrator/* files/migrator/**/*
+	fsMigrator embed.FS
+)
+
+// NewGenerator returns the generator to scaffold an in-place store migration of a module.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+	g.RunFn(moduleModify(opts))
+
+	if err := box(g, fsMigration, "files/migration"); err != nil {
+		return g, err
+	}
+	if opts.NewMigrator {
+		if err := box(g, fsMigrator, "files/migrator"); err != nil {
+			return g, err
+		}
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("FromVersion", opts.FromVersion)
+	ctx.Set("ToVersion", opts.ToVersion)
+	ctx.Set("MigrationPackage", opts.MigrationPackage())
+	ctx.Set("MigratorMethod", opts.MigratorMethod())
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{fromVersion}}", VersionPackage(opts.FromVersion)))
+	g.Transformer(genny.Replace("{{toVersion}}", opts.MigrationPackage()))
+	return g, nil
+}
+
+func box(g *genny.Generator, files embed.FS, dir string) error {
+	subFs, err := fs.Sub(files, dir)
+	if err != nil {
+		return errors.Errorf("fail to generate sub: %w", err)
+	}
+	return g.OnlyFS(subFs, nil, nil)
+}
+
+// moduleModify registers the migration in the module and bumps its consensus version.
+func moduleModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "module/module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateRegister := `if cfg, ok := registrar.(module.Configurator); ok {
+	if err := cfg.RegisterMigration(types.ModuleName, %[1]v, keeper.NewMigrator(am.keeper).%[2]v); err != nil {
+		return err
+	}
+}`
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"RegisterServices",
+			xast.AppendFuncCode(fmt.Sprintf(templateRegister, opts.FromVersion, opts.MigratorMethod())),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(
+			content,
+			"ConsensusVersion",
+			xast.NewFuncReturn(strconv.FormatUint(opts.ToVersion, 10)),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,91 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/stretchr/testify/require"
+)
+
+const moduleFile = `package blog
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"google.golang.org/grpc"
+
+	"github.com/test/blog/x/blog/keeper"
+	"github.com/test/blog/x/blog/types"
+)
+
+var _ module.AppModule = (*AppModule)(nil)
+
+type AppModule struct {
+	keeper keeper.Keeper
+}
+
+func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
+	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
+
+	return nil
+}
+
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+`
+
+func TestNewGenerator(t *testing.T) {
+	tests := []struct {
+		name        string
+		newMigrator bool
+	}{
+		{name: "first migration", newMigrator: true},
+		{name: "existing migrator", newMigrator: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := genny.DryRunner(t.Context())
+			r.Disk.Add(genny.NewFileS("x/blog/module/module.go", moduleFile))
+
+			g, err := NewGenerator(&Options{
+				ModuleName:  "blog",
+				ModulePath:  "github.com/test/blog",
+				FromVersion: 1,
+				ToVersion:   2,
+				NewMigrator: tt.newMigrator,
+			})
+			require.NoError(t, err)
+			require.NoError(t, r.With(g))
+			require.NoError(t, r.Run())
+
+			module, err := r.Disk.Find("x/blog/module/module.go")
+			require.NoError(t, err)
+			require.Contains(t, module.String(), "cfg.RegisterMigration(types.ModuleName, 1, keeper.NewMigrator(am.keeper).Migrate1to2)")
+			require.Contains(t, module.String(), "ConsensusVersion() uint64 { return 2 }")
+
+			migrate, err := r.Disk.Find("x/blog/migrations/v2/migrate.go")
+			require.NoError(t, err)
+			require.Contains(t, migrate.String(), "package v2")
+
+			method, err := r.Disk.Find("x/blog/keeper/migrator_v2.go")
+			require.NoError(t, err)
+			require.Contains(t, method.String(), "func (m Migrator) Migrate1to2(ctx sdk.Context) error")
+			require.Contains(t, method.String(), `v2 "github.com/test/blog/x/blog/migrations/v2"`)
+
+			test, err := r.Disk.Find("x/blog/keeper/migrator_v2_test.go")
+			require.NoError(t, err)
+			require.Contains(t, test.String(), "func TestMigrate1to2(t *testing.T)")
+			require.Contains(t, test.String(), `loadGenesis("genesis_v1.json")`)
+
+			for _, fixture := range []string{"genesis_v1.json", "genesis_v2.json"} {
+				_, err = r.Disk.Find("x/blog/migrations/v2/testdata/" + fixture)
+				require.NoError(t, err)
+			}
+
+			_, err = r.Disk.Find("x/blog/keeper/migrator.go")
+			if tt.newMigrator {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package migration
+
+import "fmt"
+
+// Options ...
+type Options struct {
+	ModuleName  string
+	ModulePath  string
+	FromVersion uint64
+	ToVersion   uint64
+	// NewMigrator is true when the module has no migrator yet.
+	NewMigrator bool
+}
+
+// MigrationPackage returns the name of the package of the migration.
+func (opts *Options) MigrationPackage() string {
+	return VersionPackage(opts.ToVersion)
+}
+
+// MigratorMethod returns the name of the migrator method running the migration.
+func (opts *Options) MigratorMethod() string {
+	return fmt.Sprintf("Migrate%dto%d", opts.FromVersion, opts.ToVersion)
+}
+
+// VersionPackage returns the name of the migration package of a consensus version.
+func VersionPackage(version uint64) string {
+	return fmt.Sprintf("v%d", version)
+}
@@ -0,0 +1,33 @@
+package query
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+// NewRemoveGenerator returns the generator to remove a query scaffolded in a module.
+func NewRemoveGenerator(opts *Options) *genny.Generator {
+	var (
+		g              = genny.New()
+		typenamePascal = opts.QueryName.PascalCase
+	)
+
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("query.proto"), typed.ProtoRemoval{
+		Service: "Query",
+		RPCs:    []string{typenamePascal},
+		Messages: []string{
+			fmt.Sprintf("Query%sRequest", typenamePascal),
+			fmt.Sprintf("Query%sResponse", typenamePascal),
+		},
+	}))
+	g.RunFn(typed.ClientCliRemove(opts.ModuleName, []string{typenamePascal}, nil))
+	g.RunFn(typed.RemoveFiles(
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("query_%s.go", opts.QueryName.Snake)),
+	))
+
+	return g
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/templates/field"
 )
 
 const (
@@ -27,39 +28,69 @@ func AppendAutoCLITxOptions(content string, options ...string) (string, error) {
 	return appendAutoCLIOptions(content, autoCLIServiceTx, options...)
 }
 
-func appendAutoCLIOptions(content, service string, options ...string) (string, error) {
-	fileSet := token.NewFileSet()
-	file, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
-	if err != nil {
-		return "", err
-	}
+// RemoveAutoCLIQueryOptions removes the options of the given RPC methods from the Query RpcCommandOptions in AutoCLIOptions.
+func RemoveAutoCLIQueryOptions(content string, rpcMethods ...string) (string, error) {
+	return removeAutoCLIOptions(content, autoCLIServiceQuery, rpcMethods...)
+}
 
-	autoCLIOptionsFunc := findFunctionByName(file, "AutoCLIOptions")
-	if autoCLIOptionsFunc == nil {
-		return "", errors.New(`function "AutoCLIOptions" not found`)
-	}
+// RemoveAutoCLITxOptions removes the options of the given RPC methods from the Tx RpcCommandOptions in AutoCLIOptions.
+func RemoveAutoCLITxOptions(content string, rpcMethods ...string) (string, error) {
+	return removeAutoCLIOptions(content, autoCLIServiceTx, rpcMethods...)
+}
 
-	moduleOptionsLit, err := findModuleOptionsLiteral(autoCLIOptionsFunc)
-	if err != nil {
-		return "", err
-	}
+// AppendAutoCLITxPositionalArgs appends the fields to the positional arguments and the usage of the
+// Tx command of the given RPC method. Only the last field can be variadic, so the existing arguments
+// are no longer variadic when appending fields after them.
+func AppendAutoCLITxPositionalArgs(content, rpcMethod string, fields field.Fields) (string, error) {
+	return modifyAutoCLIPositionalArgs(content, autoCLIServiceTx, rpcMethod, func(args []positionalArg, use []string) ([]positionalArg, []string) {
+		for i := range args {
+			args[i].varargs = false
+		}
+		for i, f := range fields {
+			args = append(args, positionalArg{
+				protoField: f.ProtoFieldName(),
+				varargs:    i == len(fields)-1 && f.IsSlice(),
+			})
+			use = append(use, fmt.Sprintf("[%s]", f.CLIUsage()))
+		}
+		return args, use
+	})
+}
 
-	serviceDescriptorField, found := findCompositeField(moduleOptionsLit, service)
-	if !found {
-		return "", errors.Errorf("field %q not found in ModuleOptions", service)
-	}
-	serviceDescriptorLit, found := resolveCompositeLiteral(serviceDescriptorField.Value)
-	if !found {
-		return "", errors.Errorf("field %q is not a composite literal in ModuleOptions", service)
-	}
+// RemoveAutoCLITxPositionalArgs removes the fields from the positional arguments and the usage of the
+// Tx command of the given RPC method.
+func RemoveAutoCLITxPositionalArgs(content, rpcMethod string, fields field.Fields) (string, error) {
+	return modifyAutoCLIPositionalArgs(content, autoCLIServiceTx, rpcMethod, func(args []positionalArg, use []string) ([]positionalArg, []string) {
+		var (
+			protoFields = make(map[string]struct{}, len(fields))
+			usages      = make(map[string]struct{}, len(fields))
+		)
+		for _, f := range fields {
+			protoFields[f.ProtoFieldName()] = struct{}{}
+			usages[fmt.Sprintf("[%s]", f.CLIUsage())] = struct{}{}
+		}
 
-	rpcCommandOptionsField, found := findCompositeField(serviceDescriptorLit, "RpcCommandOptions")
-	if !found {
-		return "", errors.Errorf(`field "RpcCommandOptions" not found in %q service descriptor`, service)
-	}
-	rpcCommandOptionsLit, found := resolveCompositeLiteral(rpcCommandOptionsField.Value)
-	if !found {
-		return "", errors.Errorf(`field "RpcCommandOptions" in %q service descriptor is not a composite literal`, service)
+		newArgs := make([]positionalArg, 0, len(args))
+		for _, arg := range args {
+			if _, ok := protoFields[arg.protoField]; !ok {
+				newArgs = append(newArgs, arg)
+			}
+		}
+		newUse := make([]string, 0, len(use))
+		for _, u := range use {
+			if _, ok := usages[u]; !ok {
+				newUse = append(newUse, u)
+			}
+		}
+		return newArgs, newUse
+	})
+}
+
+func appendAutoCLIOptions(content, service string, options ...string) (string, error) {
+	fileSet := token.NewFileSet()
+	rpcCommandOptionsLit, err := findRPCCommandOptionsLiteral(fileSet, content, service)
+	if err != nil {
+		return "", err
 	}
 
 	existingRPCMethods := map[string]struct{}{}
@@ -109,6 +140,240 @@ func appendAutoCLIOptions(content, service string, options ...string) (string, e
 	return string(formatted), nil
 }
 
+func removeAutoCLIOptions(content, service string, rpcMethods ...string) (string, error) {
+	fileSet := token.NewFileSet()
+	rpcCommandOptionsLit, err := findRPCCommandOptionsLiteral(fileSet, content, service)
+	if err != nil {
+		return "", err
+	}
+
+	toRemove := make(map[string]struct{}, len(rpcMethods))
+	for _, method := range rpcMethods {
+		toRemove[method] = struct{}{}
+	}
+
+	file := fileSet.File(rpcCommandOptionsLit.Rbrace)
+	if file == nil {
+		return "", errors.New(`failed to find token file for "RpcCommandOptions"`)
+	}
+
+	// remove the options from the end, so the offsets of the previous ones remain valid.
+	for i := len(rpcCommandOptionsLit.Elts) - 1; i >= 0; i-- {
+		elt := rpcCommandOptionsLit.Elts[i]
+		method, ok := rpcMethod(elt)
+		if !ok {
+			continue
+		}
+		if _, ok := toRemove[method]; !ok {
+			continue
+		}
+
+		start := file.Offset(elt.Pos())
+		for start > 0 && (content[start-1] == '\t' || content[start-1] == ' ') {
+			start--
+		}
+		end := file.Offset(elt.End())
+		if end < len(content) && content[end] == ',' {
+			end++
+		}
+		if end < len(content) && content[end] == '\n' {
+			end++
+		}
+		content = content[:start] + content[end:]
+	}
+
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return "", err
+	}
+
+	return string(formatted), nil
+}
+
+// positionalArg is a positional argument of an autocli command.
+type positionalArg struct {
+	protoField string
+	varargs    bool
+}
+
+// positionalArgsModifier returns the new positional arguments and the new usage words of an autocli command.
+type positionalArgsModifier func(args []positionalArg, use []string) ([]positionalArg, []string)
+
+func modifyAutoCLIPositionalArgs(content, service, method string, modify positionalArgsModifier) (string, error) {
+	fileSet := token.NewFileSet()
+	rpcCommandOptionsLit, err := findRPCCommandOptionsLiteral(fileSet, content, service)
+	if err != nil {
+		return "", err
+	}
+
+	var commandLit *ast.CompositeLit
+	for _, elt := range rpcCommandOptionsLit.Elts {
+		if m, ok := rpcMethod(elt); ok && m == method {
+			commandLit, _ = resolveCompositeLiteral(elt)
+			break
+		}
+	}
+	if commandLit == nil {
+		return "", errors.Errorf("autocli options for %q not found in %q service descriptor", method, service)
+	}
+
+	useField, found := findCompositeField(commandLit, "Use")
+	if !found {
+		return "", errors.Errorf(`field "Use" not found in %q autocli options`, method)
+	}
+	useLit, ok := useField.Value.(*ast.BasicLit)
+	if !ok || useLit.Kind != token.STRING {
+		return "", errors.Errorf(`field "Use" of %q autocli options is not a string`, method)
+	}
+	use, err := strconv.Unquote(useLit.Value)
+	if err != nil {
+		return "", err
+	}
+
+	var args []positionalArg
+	argsField, hasArgs := findCompositeField(commandLit, "PositionalArgs")
+	if hasArgs {
+		argsLit, ok := resolveCompositeLiteral(argsField.Value)
+		if !ok {
+			return "", errors.Errorf(`field "PositionalArgs" of %q autocli options is not a composite literal`, method)
+		}
+		for _, elt := range argsLit.Elts {
+			arg, ok := parsePositionalArg(elt)
+			if !ok {
+				return "", errors.Errorf(`invalid positional argument in %q autocli options`, method)
+			}
+			args = append(args, arg)
+		}
+	}
+
+	words := strings.Fields(use)
+	if len(words) == 0 {
+		return "", errors.Errorf(`field "Use" of %q autocli options is empty`, method)
+	}
+	args, usage := modify(args, words[1:])
+	newUse := strings.Join(append([]string{words[0]}, usage...), " ")
+
+	file := fileSet.File(commandLit.Rbrace)
+	if file == nil {
+		return "", errors.Errorf("failed to find token file for %q autocli options", method)
+	}
+
+	// the positional arguments are always after the usage, so they are modified first
+	// to keep the offset of the usage valid.
+	newArgs := formatPositionalArgs(args)
+	switch {
+	case hasArgs && len(args) == 0:
+		start, end := file.Offset(argsField.Pos()), file.Offset(argsField.End())
+		for start > 0 && (content[start-1] == '\t' || content[start-1] == ' ') {
+			start--
+		}
+		if end < len(content) && content[end] == ',' {
+			end++
+		}
+		if end < len(content) && content[end] == '\n' {
+			end++
+		}
+		content = content[:start] + content[end:]
+	case hasArgs:
+		start, end := file.Offset(argsField.Value.Pos()), file.Offset(argsField.Value.End())
+		content = content[:start] + newArgs + content[end:]
+	case len(args) > 0:
+		offset := file.Offset(useField.End())
+		if offset < len(content) && content[offset] == ',' {
+			content = content[:offset+1] + "\nPositionalArgs: " + newArgs + "," + content[offset+1:]
+		} else {
+			content = content[:offset] + ", PositionalArgs: " + newArgs + content[offset:]
+		}
+	}
+
+	start, end := file.Offset(useLit.Pos()), file.Offset(useLit.End())
+	content = content[:start] + strconv.Quote(newUse) + content[end:]
+
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return "", err
+	}
+
+	return string(formatted), nil
+}
+
+func parsePositionalArg(expr ast.Expr) (positionalArg, bool) {
+	argLit, ok := resolveCompositeLiteral(expr)
+	if !ok {
+		return positionalArg{}, false
+	}
+
+	protoField, found := findCompositeField(argLit, "ProtoField")
+	if !found {
+		return positionalArg{}, false
+	}
+	protoFieldLit, ok := protoField.Value.(*ast.BasicLit)
+	if !ok || protoFieldLit.Kind != token.STRING {
+		return positionalArg{}, false
+	}
+	name, err := strconv.Unquote(protoFieldLit.Value)
+	if err != nil {
+		return positionalArg{}, false
+	}
+
+	arg := positionalArg{protoField: name}
+	if varargs, found := findCompositeField(argLit, "Varargs"); found {
+		if ident, ok := varargs.Value.(*ast.Ident); ok && ident.Name == "true" {
+			arg.varargs = true
+		}
+	}
+	return arg, true
+}
+
+func formatPositionalArgs(args []positionalArg) string {
+	descriptors := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg.varargs {
+			descriptors = append(descriptors, fmt.Sprintf(`{ProtoField: %q, Varargs: true}`, arg.protoField))
+			continue
+		}
+		descriptors = append(descriptors, fmt.Sprintf(`{ProtoField: %q}`, arg.protoField))
+	}
+	return fmt.Sprintf("[]*autocliv1.PositionalArgDescriptor{%s}", strings.Join(descriptors, ", "))
+}
+
+func findRPCCommandOptionsLiteral(fileSet *token.FileSet, content, service string) (*ast.CompositeLit, error) {
+	file, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	autoCLIOptionsFunc := findFunctionByName(file, "AutoCLIOptions")
+	if autoCLIOptionsFunc == nil {
+		return nil, errors.New(`function "AutoCLIOptions" not found`)
+	}
+
+	moduleOptionsLit, err := findModuleOptionsLiteral(autoCLIOptionsFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceDescriptorField, found := findCompositeField(moduleOptionsLit, service)
+	if !found {
+		return nil, errors.Errorf("field %q not found in ModuleOptions", service)
+	}
+	serviceDescriptorLit, found := resolveCompositeLiteral(serviceDescriptorField.Value)
+	if !found {
+		return nil, errors.Errorf("field %q is not a composite literal in ModuleOptions", service)
+	}
+
+	rpcCommandOptionsField, found := findCompositeField(serviceDescriptorLit, "RpcCommandOptions")
+	if !found {
+		return nil, errors.Errorf(`field "RpcCommandOptions" not found in %q service descriptor`, service)
+	}
+	rpcCommandOptionsLit, found := resolveCompositeLiteral(rpcCommandOptionsField.Value)
+	if !found {
+		return nil, errors.Errorf(`field "RpcCommandOptions" in %q service descriptor is not a composite literal`, service)
+	}
+
+	return rpcCommandOptionsLit, nil
+}
+
 func parseRPCOption(option string) (ast.Expr, string, error) {
 	option = normalizeOption(option)
 	if option == "" {
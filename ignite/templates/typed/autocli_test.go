@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/templates/field"
 )
 
 const autoCLITestContent = `package foo
@@ -162,3 +164,73 @@ func TestAppendAutoCLIQueryOptionsFormatting(t *testing.T) {
 	require.Contains(t, normalized, "RpcMethod:\"ListBook\",")
 	require.Contains(t, normalized, "RpcMethod:\"GetBook\",")
 }
+
+func TestRemoveAutoCLIOptions(t *testing.T) {
+	content, err := AppendAutoCLIQueryOptions(autoCLITestContent, `{
+		RpcMethod: "ListBook",
+		Use: "list-book",
+		Short: "List all books",
+	}`)
+	require.NoError(t, err)
+
+	content, err = AppendAutoCLITxOptions(content, `{
+		RpcMethod: "CreateBook",
+		Use: "create-book [title]",
+		Short: "Create a new book",
+	}`)
+	require.NoError(t, err)
+
+	content, err = RemoveAutoCLIQueryOptions(content, "ListBook")
+	require.NoError(t, err)
+	content, err = RemoveAutoCLITxOptions(content, "CreateBook", "DoesNotExist")
+	require.NoError(t, err)
+
+	require.NotContains(t, content, `RpcMethod: "ListBook"`)
+	require.NotContains(t, content, `RpcMethod: "CreateBook"`)
+	require.Equal(t, 1, strings.Count(content, `RpcMethod: "Params"`))
+	require.Equal(t, 1, strings.Count(content, `RpcMethod: "UpdateParams"`))
+
+	_, err = RemoveAutoCLIQueryOptions("package foo", "ListBook")
+	require.Error(t, err)
+}
+
+func TestAutoCLITxPositionalArgs(t *testing.T) {
+	content, err := AppendAutoCLITxOptions(autoCLITestContent, `{
+		RpcMethod: "CreateBook",
+		Use: "create-book [tags]",
+		Short: "Create a new book",
+		PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tags", Varargs: true}},
+	}`, `{
+		RpcMethod: "DeleteBook",
+		Use: "delete-book",
+		Short: "Delete a book",
+	}`)
+	require.NoError(t, err)
+
+	fields, err := field.ParseFields([]string{"title", "authors:array.string"}, func(string) error { return nil }, "")
+	require.NoError(t, err)
+
+	content, err = AppendAutoCLITxPositionalArgs(content, "CreateBook", fields)
+	require.NoError(t, err)
+	content, err = AppendAutoCLITxPositionalArgs(content, "DeleteBook", fields[:1])
+	require.NoError(t, err)
+
+	normalized := strings.NewReplacer(" ", "", "\t", "", "\n", "").Replace(content)
+	require.Contains(t, normalized, `Use:"create-book[tags][title][authors]"`)
+	require.Contains(t, normalized, `PositionalArgs:[]*autocliv1.PositionalArgDescriptor{{ProtoField:"tags"},{ProtoField:"title"},{ProtoField:"authors",Varargs:true}}`)
+	require.Contains(t, normalized, `Use:"delete-book[title]",PositionalArgs:[]*autocliv1.PositionalArgDescriptor{{ProtoField:"title"}},Short:"Deleteabook"`)
+
+	content, err = RemoveAutoCLITxPositionalArgs(content, "CreateBook", fields)
+	require.NoError(t, err)
+	content, err = RemoveAutoCLITxPositionalArgs(content, "DeleteBook", fields[:1])
+	require.NoError(t, err)
+
+	normalized = strings.NewReplacer(" ", "", "\t", "", "\n", "").Replace(content)
+	require.Contains(t, normalized, `Use:"create-book[tags]"`)
+	require.Contains(t, normalized, `PositionalArgs:[]*autocliv1.PositionalArgDescriptor{{ProtoField:"tags"}}`)
+	require.Contains(t, normalized, `Use:"delete-book"`)
+	require.Equal(t, 1, strings.Count(content, "PositionalArgs"))
+
+	_, err = AppendAutoCLITxPositionalArgs(content, "UpdateBook", fields)
+	require.Error(t, err)
+}
@@ -0,0 +1,438 @@
+package typed
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"math/rand"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// ProtoFieldsModify appends the fields to the type message and, if the type has messages,
+// to the create and update messages. Each field uses the next free index of its message.
+func ProtoFieldsModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		if err := protoFieldsAppend(r, opts, opts.ProtoFile(opts.TypeName.Snake+".proto"), opts.TypeName.PascalCase); err != nil {
+			return err
+		}
+		if opts.NoMessage {
+			return nil
+		}
+		return protoFieldsAppend(r, opts, opts.ProtoFile("tx.proto"), crudFieldsMessages(opts)...)
+	}
+}
+
+// ProtoFieldsRemove removes the fields from the type message and, if the type has messages,
+// from the create and update messages.
+func ProtoFieldsRemove(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		if err := protoFieldsRemove(r, opts, opts.ProtoFile(opts.TypeName.Snake+".proto"), opts.TypeName.PascalCase); err != nil {
+			return err
+		}
+		if opts.NoMessage {
+			return nil
+		}
+		return protoFieldsRemove(r, opts, opts.ProtoFile("tx.proto"), crudFieldsMessages(opts)...)
+	}
+}
+
+// MsgServerFieldsModify sets the fields of the type from the create and update messages.
+func MsgServerFieldsModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		if opts.NoMessage {
+			return nil
+		}
+
+		path := filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("msg_server_%s.go", opts.TypeName.Snake))
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		elts := make([]string, 0, len(opts.Fields))
+		for _, field := range opts.Fields {
+			elts = append(elts, fmt.Sprintf("%[1]s: msg.%[1]s", field.Name.UpperCamel))
+		}
+
+		content := f.String()
+		for _, funcName := range crudFieldsFuncs(opts) {
+			content, err = appendStructLiteralElts(content, funcName, opts.TypeName.PascalCase, elts...)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// MsgServerFieldsRemove removes the fields of the type set from the create and update messages.
+func MsgServerFieldsRemove(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		if opts.NoMessage {
+			return nil
+		}
+
+		path := filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("msg_server_%s.go", opts.TypeName.Snake))
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, funcName := range crudFieldsFuncs(opts) {
+			options := make([]xast.FunctionOptions, 0, len(opts.Fields))
+			for _, field := range opts.Fields {
+				options = append(options, xast.RemoveFuncStruct(opts.TypeName.PascalCase, field.Name.UpperCamel))
+			}
+
+			content, err = xast.ModifyFunction(content, funcName, options...)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// AutoCLIFieldsModify appends the fields to the positional arguments of the create and update commands.
+func AutoCLIFieldsModify(opts *Options) genny.RunFn {
+	return autoCLIFieldsModify(opts, AppendAutoCLITxPositionalArgs)
+}
+
+// AutoCLIFieldsRemove removes the fields from the positional arguments of the create and update commands.
+func AutoCLIFieldsRemove(opts *Options) genny.RunFn {
+	return autoCLIFieldsModify(opts, RemoveAutoCLITxPositionalArgs)
+}
+
+// GenesisTestsFieldsModify sets sample values for the fields of the type in the genesis tests.
+// Only singleton types are set with their fields in the genesis tests.
+func GenesisTestsFieldsModify(opts *Options) genny.RunFn {
+	return genesisTestsFieldsModify(opts, func(content, funcName string) (string, error) {
+		elts := make([]string, 0, len(opts.Fields))
+		for _, field := range opts.Fields {
+			genesisArgs := strings.TrimSuffix(strings.TrimSpace(field.GenesisArgs(rand.Intn(100))), ",")
+			if genesisArgs != "" {
+				elts = append(elts, genesisArgs)
+			}
+		}
+		return appendStructLiteralElts(content, funcName, opts.TypeName.PascalCase, elts...)
+	})
+}
+
+// GenesisTestsFieldsRemove removes the sample values of the fields of the type from the genesis tests.
+func GenesisTestsFieldsRemove(opts *Options) genny.RunFn {
+	return genesisTestsFieldsModify(opts, func(content, funcName string) (string, error) {
+		options := make([]xast.FunctionOptions, 0, len(opts.Fields))
+		for _, field := range opts.Fields {
+			options = append(options, xast.RemoveFuncStruct(opts.TypeName.PascalCase, field.Name.UpperCamel))
+		}
+		return xast.ModifyFunction(content, funcName, options...)
+	})
+}
+
+func protoFieldsAppend(r *genny.Runner, opts *Options, path string, messages ...string) error {
+	f, err := r.Disk.Find(path)
+	if err != nil {
+		return err
+	}
+	protoFile, err := protoutil.ParseProtoFile(f)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range messages {
+		message, err := protoutil.GetMessageByName(protoFile, name)
+		if err != nil {
+			return errors.Errorf("failed while looking up message '%s' in %s: %w", name, path, err)
+		}
+
+		// the names of removed fields can be used again, their numbers can't.
+		nextID := protoutil.NextUniqueID(message)
+		for i, field := range opts.Fields {
+			protoutil.UnreserveNames(message, field.ProtoFieldName())
+			protoutil.Append(message, field.ToProtoField(nextID+i))
+		}
+	}
+
+	// Ensure custom types are imported
+	var protoImports []*proto.Import
+	for _, imp := range opts.Fields.ProtoImports() {
+		protoImports = append(protoImports, protoutil.NewImport(imp))
+	}
+	for _, f := range opts.Fields.Custom() {
+		protoPath := fmt.Sprintf("%[1]v/%[2]v/%[3]v/%[4]v.proto", opts.AppName, opts.ModuleName, opts.ProtoVer, f)
+		protoImports = append(protoImports, protoutil.NewImport(protoPath))
+	}
+	if err = protoutil.AddImports(protoFile, true, protoImports...); err != nil {
+		return errors.Errorf("failed while adding imports in %s: %w", path, err)
+	}
+
+	newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+	return r.File(newFile)
+}
+
+func protoFieldsRemove(r *genny.Runner, opts *Options, path string, messages ...string) error {
+	f, err := r.Disk.Find(path)
+	if err != nil {
+		return err
+	}
+	protoFile, err := protoutil.ParseProtoFile(f)
+	if err != nil {
+		return err
+	}
+
+	fields := make([]string, 0, len(opts.Fields))
+	for _, field := range opts.Fields {
+		fields = append(fields, field.ProtoFieldName())
+	}
+
+	usedImports := fieldImportsInUse(protoFile)
+	for _, name := range messages {
+		message, err := protoutil.GetMessageByName(protoFile, name)
+		if err != nil {
+			return errors.Errorf("failed while looking up message '%s' in %s: %w", name, path, err)
+		}
+
+		// the numbers and names of the removed fields are reserved, so the
+		// data encoded with the previous versions of the message can't be
+		// decoded as new fields.
+		removed := make([]*proto.NormalField, 0, len(fields))
+		for _, name := range fields {
+			if field, err := protoutil.GetFieldByName(message, name); err == nil {
+				removed = append(removed, field)
+			}
+		}
+		protoutil.RemoveFields(message, fields...)
+		protoutil.ReserveFields(message, removed...)
+	}
+
+	// remove the imports only used by the removed fields.
+	stillUsed := fieldImportsInUse(protoFile)
+	for imp, used := range usedImports {
+		if used && !stillUsed[imp] {
+			protoutil.RemoveImports(protoFile, imp)
+		}
+	}
+
+	newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+	return r.File(newFile)
+}
+
+// fieldImportRefs are the references made by the fields to the proto imports of their datatypes.
+var fieldImportRefs = map[string]string{
+	"gogoproto/gogo.proto":            "(gogoproto.",
+	"cosmos_proto/cosmos.proto":       "(cosmos_proto.",
+	"cosmos/base/v1beta1/coin.proto":  "cosmos.base.v1beta1.",
+	"google/protobuf/timestamp.proto": "google.protobuf.Timestamp",
+	"google/protobuf/duration.proto":  "google.protobuf.Duration",
+}
+
+// fieldImportsInUse returns the imports of a proto file used by its fields, among the
+// imports of the field datatypes and of the custom types declared in the same package.
+func fieldImportsInUse(protoFile *proto.Proto) map[string]bool {
+	var (
+		imports = make(map[string]bool)
+		types   = make(map[string]bool)
+		options = make(map[string]bool)
+	)
+	proto.Walk(protoFile,
+		proto.WithNormalField(func(f *proto.NormalField) {
+			types[f.Type] = true
+			for _, option := range f.Options {
+				options[option.Name] = true
+			}
+		}),
+		proto.WithOption(func(option *proto.Option) {
+			options[option.Name] = true
+		}),
+	)
+
+	for _, el := range protoFile.Elements {
+		imp, ok := el.(*proto.Import)
+		if !ok {
+			continue
+		}
+
+		ref, ok := fieldImportRefs[imp.Filename]
+		if !ok {
+			// the custom types are declared in a file named after them.
+			name, err := multiformatname.NewName(strings.TrimSuffix(filepath.Base(imp.Filename), ".proto"))
+			if err != nil {
+				continue
+			}
+			imports[imp.Filename] = types[name.PascalCase]
+			continue
+		}
+		for name := range types {
+			if strings.HasPrefix(name, ref) {
+				imports[imp.Filename] = true
+			}
+		}
+		for name := range options {
+			if strings.HasPrefix(name, ref) {
+				imports[imp.Filename] = true
+			}
+		}
+	}
+	return imports
+}
+
+func autoCLIFieldsModify(opts *Options, modify func(content, rpcMethod string, fields field.Fields) (string, error)) genny.RunFn {
+	return func(r *genny.Runner) error {
+		if opts.NoMessage {
+			return nil
+		}
+
+		path := filepath.Join("x", opts.ModuleName, "module/autocli.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, rpcMethod := range crudFieldsFuncs(opts) {
+			content, err = modify(content, rpcMethod, opts.Fields)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTestsFieldsModify(opts *Options, modify func(content, funcName string) (string, error)) genny.RunFn {
+	return func(r *genny.Runner) error {
+		tests := []struct {
+			path     string
+			funcName string
+		}{
+			{filepath.Join("x", opts.ModuleName, "keeper/genesis_test.go"), "TestGenesis"},
+			{filepath.Join("x", opts.ModuleName, "types/genesis_test.go"), "TestGenesisState_Validate"},
+		}
+		for _, test := range tests {
+			f, err := r.Disk.Find(test.path)
+			if err != nil {
+				return err
+			}
+
+			content, err := modify(f.String(), test.funcName)
+			if err != nil {
+				return err
+			}
+
+			if err := r.File(genny.NewFileS(test.path, content)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// appendStructLiteralElts appends the elements to the struct literals of the given type in a function.
+// Unlike xast.AppendFuncStruct, the elements are inserted in the source code before the closing
+// brace of the literals, so each element is kept on its own line and the comments and empty
+// lines following the literals are preserved.
+func appendStructLiteralElts(content, funcName, typeName string, elts ...string) (string, error) {
+	if len(elts) == 0 {
+		return content, nil
+	}
+
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+	funcDecl := findFunctionByName(file, funcName)
+	if funcDecl == nil {
+		return "", errors.Errorf("function %q not found", funcName)
+	}
+
+	var lits []*ast.CompositeLit
+	ast.Inspect(funcDecl, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		switch t := lit.Type.(type) {
+		case *ast.Ident:
+			ok = t.Name == typeName
+		case *ast.SelectorExpr:
+			ok = t.Sel.Name == typeName
+		default:
+			ok = false
+		}
+		if ok {
+			lits = append(lits, lit)
+		}
+		return true
+	})
+	if len(lits) == 0 {
+		return "", errors.Errorf("struct literal %q not found in function %q", typeName, funcName)
+	}
+
+	// insert from the end, so the offsets of the previous literals remain valid.
+	sort.Slice(lits, func(i, j int) bool { return lits[i].Rbrace > lits[j].Rbrace })
+	for _, lit := range lits {
+		var (
+			offset    = fileSet.Position(lit.Lbrace).Offset + 1
+			rbrace    = fileSet.Position(lit.Rbrace).Offset
+			insertion strings.Builder
+		)
+		if len(lit.Elts) > 0 {
+			offset = fileSet.Position(lit.Elts[len(lit.Elts)-1].End()).Offset
+			if content[offset] == ',' {
+				offset++
+			} else {
+				insertion.WriteString(",")
+			}
+		}
+		for _, elt := range elts {
+			insertion.WriteString("\n" + elt + ",")
+		}
+		if !strings.Contains(content[offset:rbrace], "\n") {
+			insertion.WriteString("\n")
+		}
+		content = content[:offset] + insertion.String() + content[offset:]
+	}
+
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+// crudFieldsMessages returns the messages containing the fields of a type.
+func crudFieldsMessages(opts *Options) []string {
+	return []string{
+		fmt.Sprintf("MsgCreate%s", opts.TypeName.PascalCase),
+		fmt.Sprintf("MsgUpdate%s", opts.TypeName.PascalCase),
+	}
+}
+
+// crudFieldsFuncs returns the msg server functions and RPC methods setting the fields of a type.
+func crudFieldsFuncs(opts *Options) []string {
+	return []string{
+		fmt.Sprintf("Create%s", opts.TypeName.PascalCase),
+		fmt.Sprintf("Update%s", opts.TypeName.PascalCase),
+	}
+}
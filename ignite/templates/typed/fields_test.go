@@ -0,0 +1,52 @@
+package typed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendStructLiteralElts(t *testing.T) {
+	content := `package keeper
+
+func (k msgServer) UpdatePost(msg *types.MsgUpdatePost) error {
+	var post = types.Post{
+		Id:    msg.Id,
+		Title: msg.Title,
+	}
+
+	// Checks that the element exists
+	return k.Post.Set(post)
+}
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{Params: types.DefaultParams(), Post: &types.Post{}, Other: types.Other{Id: 1}}
+	_ = genesisState
+}
+`
+
+	got, err := appendStructLiteralElts(content, "UpdatePost", "Post", "Tags: msg.Tags", "Priority: msg.Priority")
+	require.NoError(t, err)
+	require.Contains(t, got, `	var post = types.Post{
+		Id:       msg.Id,
+		Title:    msg.Title,
+		Tags:     msg.Tags,
+		Priority: msg.Priority,
+	}
+
+	// Checks that the element exists
+	return k.Post.Set(post)
+`)
+
+	got, err = appendStructLiteralElts(got, "TestGenesis", "Post", `Title: "1"`)
+	require.NoError(t, err)
+	require.Contains(t, got, `	genesisState := types.GenesisState{Params: types.DefaultParams(), Post: &types.Post{
+		Title: "1",
+	}, Other: types.Other{Id: 1}}
+`)
+
+	_, err = appendStructLiteralElts(got, "TestGenesis", "Book", `Title: "1"`)
+	require.Error(t, err)
+	_, err = appendStructLiteralElts(got, "CreatePost", "Post", `Title: "1"`)
+	require.Error(t, err)
+}
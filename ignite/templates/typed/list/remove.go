@@ -0,0 +1,276 @@
+package list
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+// NewRemoveGenerator returns the generator to remove a list type scaffolded in a module.
+func NewRemoveGenerator(opts *typed.Options) *genny.Generator {
+	var (
+		g              = genny.New()
+		typenamePascal = opts.TypeName.PascalCase
+		typeImport     = opts.ProtoTypeImport().Filename
+	)
+
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("query.proto"), typed.ProtoRemoval{
+		Service: "Query",
+		RPCs: []string{
+			fmt.Sprintf("Get%s", typenamePascal),
+			fmt.Sprintf("List%s", typenamePascal),
+		},
+		Messages: []string{
+			fmt.Sprintf("QueryGet%sRequest", typenamePascal),
+			fmt.Sprintf("QueryGet%sResponse", typenamePascal),
+			fmt.Sprintf("QueryAll%sRequest", typenamePascal),
+			fmt.Sprintf("QueryAll%sResponse", typenamePascal),
+		},
+		Imports: []string{typeImport},
+	}))
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("genesis.proto"), typed.ProtoRemoval{
+		Message: typed.ProtoGenesisStateMessage,
+		Fields:  []string{opts.TypeName.Snake + "_list", opts.TypeName.Snake + "_count"},
+		Imports: []string{typeImport},
+	}))
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("tx.proto"), typed.ProtoTxCRUDRemoval(opts.TypeName)))
+	g.RunFn(typesKeyRemove(opts))
+	g.RunFn(keeperRemove(opts))
+	g.RunFn(typed.ClientCliRemove(
+		opts.ModuleName,
+		[]string{fmt.Sprintf("List%s", typenamePascal), fmt.Sprintf("Get%s", typenamePascal)},
+		typed.CRUDMessages(opts.TypeName),
+	))
+	g.RunFn(typed.TypesCodecRemove(opts.ModuleName, typed.CRUDMessages(opts.TypeName)...))
+	g.RunFn(genesisTypesRemove(opts))
+	g.RunFn(genesisModuleRemove(opts))
+	g.RunFn(genesisTestsRemove(opts))
+	g.RunFn(genesisTypesTestsRemove(opts))
+	g.RunFn(moduleSimulationRemove(opts))
+	g.RunFn(typed.RemoveFiles(
+		opts.ProtoFile(opts.TypeName.Snake+".proto"),
+		filepath.Join("x", opts.ModuleName, "types", opts.TypeName.Snake+".pb.go"),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("query_%s.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("query_%s_test.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("msg_server_%s.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("msg_server_%s_test.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "simulation", opts.TypeName.Snake+".go"),
+	))
+
+	return g
+}
+
+// typesKeyRemove removes the collection prefixes from the keys.go file.
+func typesKeyRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "types/keys.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.RemoveGlobal(
+			f.String(),
+			fmt.Sprintf("%vKey", opts.TypeName.PascalCase),
+			fmt.Sprintf("%vCountKey", opts.TypeName.PascalCase),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperRemove removes the collections item type from the keeper.
+func keeperRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		seqName := fmt.Sprintf("%vSeq", opts.TypeName.UpperCamel)
+		content, err := xast.ModifyStruct(
+			f.String(),
+			"Keeper",
+			xast.RemoveStructValue(seqName),
+			xast.RemoveStructValue(opts.TypeName.UpperCamel),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(
+			content,
+			"NewKeeper",
+			xast.RemoveFuncStruct("Keeper", opts.TypeName.UpperCamel),
+			xast.RemoveFuncStruct("Keeper", seqName),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTypesRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "types/genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		listName := fmt.Sprintf("%vList", opts.TypeName.UpperCamel)
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"DefaultGenesis",
+			xast.RemoveFuncStruct("GenesisState", listName),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(
+			content,
+			"Validate",
+			xast.RemoveFuncCode(
+				listName,
+				fmt.Sprintf("%vIdMap", opts.TypeName.LowerCamel),
+				fmt.Sprintf("%vCount", opts.TypeName.LowerCamel),
+			),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisModuleRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "keeper/genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		var (
+			listName  = fmt.Sprintf("%vList", opts.TypeName.UpperCamel)
+			countName = fmt.Sprintf("%vCount", opts.TypeName.UpperCamel)
+			seqName   = fmt.Sprintf("%vSeq", opts.TypeName.UpperCamel)
+		)
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"InitGenesis",
+			xast.RemoveFuncCode(listName, seqName),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(
+			content,
+			"ExportGenesis",
+			xast.RemoveFuncCode(listName, countName, seqName),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTestsRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "keeper/genesis_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		var (
+			listName  = fmt.Sprintf("%vList", opts.TypeName.UpperCamel)
+			countName = fmt.Sprintf("%vCount", opts.TypeName.UpperCamel)
+		)
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"TestGenesis",
+			xast.RemoveFuncStruct("GenesisState", listName),
+			xast.RemoveFuncStruct("GenesisState", countName),
+			xast.RemoveFuncCode(listName, countName),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTypesTestsRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "types/genesis_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"TestGenesisState_Validate",
+			xast.RemoveFuncStruct("GenesisState", fmt.Sprintf("%vList", opts.TypeName.UpperCamel)),
+			xast.RemoveFuncStruct("GenesisState", fmt.Sprintf("%vCount", opts.TypeName.UpperCamel)),
+			xast.RemoveFuncTestCase(fmt.Sprintf("duplicated %v", opts.TypeName.LowerCamel)),
+			xast.RemoveFuncTestCase(fmt.Sprintf("invalid %v count", opts.TypeName.LowerCamel)),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func moduleSimulationRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "module/simulation.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"GenerateGenesisState",
+			xast.RemoveFuncStruct("GenesisState", fmt.Sprintf("%vList", opts.TypeName.UpperCamel)),
+			xast.RemoveFuncStruct("GenesisState", fmt.Sprintf("%vCount", opts.TypeName.UpperCamel)),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = typed.RemoveModuleSimulationMsg(content, opts.TypeName, "Create", "Update", "Delete")
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,170 @@
+package maptype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+// ListByIndexRPC returns the name of the query RPC listing the values of a map type by a secondary index.
+func ListByIndexRPC(typeName, index multiformatname.Name) string {
+	return fmt.Sprintf("List%sBy%s", typeName.PascalCase, index.UpperCamel)
+}
+
+// GetByIndexRPC returns the name of the query RPC getting the value of a map type by a unique index.
+func GetByIndexRPC(typeName, index multiformatname.Name) string {
+	return fmt.Sprintf("Get%sBy%s", typeName.PascalCase, index.UpperCamel)
+}
+
+// ListByIndexRequest returns the name of the request message of the ListByIndexRPC query.
+func ListByIndexRequest(typeName, index multiformatname.Name) string {
+	return fmt.Sprintf("QueryAll%sBy%sRequest", typeName.PascalCase, index.UpperCamel)
+}
+
+// GetByIndexRequest returns the name of the request message of the GetByIndexRPC query.
+func GetByIndexRequest(typeName, index multiformatname.Name) string {
+	return fmt.Sprintf("QueryGet%sBy%sRequest", typeName.PascalCase, index.UpperCamel)
+}
+
+// indexesRPCs returns the query RPCs of the secondary and unique indexes of the map type.
+func indexesRPCs(opts *typed.Options) []proto.Visitee {
+	var (
+		appModulePath = gomodulepath.ExtractAppPath(opts.ModulePath)
+		rpcs          = make([]proto.Visitee, 0, len(opts.SecondaryIndexes)+len(opts.UniqueIndexes))
+		httpRule      = func(index multiformatname.Name) string {
+			return fmt.Sprintf(
+				"/%s/%s/%s/%s/by_%s/{%s}",
+				appModulePath, opts.ModuleName, opts.ProtoVer, opts.TypeName.Snake, index.Snake, index.Snake,
+			)
+		}
+	)
+	for _, index := range opts.SecondaryIndexes {
+		rpc := protoutil.NewRPC(
+			ListByIndexRPC(opts.TypeName, index.Name),
+			ListByIndexRequest(opts.TypeName, index.Name),
+			fmt.Sprintf("QueryAll%sBy%sResponse", opts.TypeName.PascalCase, index.Name.UpperCamel),
+			protoutil.WithRPCOptions(
+				protoutil.NewOption("google.api.http", httpRule(index.Name), protoutil.Custom(), protoutil.SetField("get")),
+			),
+		)
+		protoutil.AttachComment(rpc, fmt.Sprintf(
+			"%s Queries a list of %s items by %s.",
+			rpc.Name, opts.TypeName.PascalCase, index.Name.LowerCamel,
+		))
+		rpcs = append(rpcs, rpc)
+	}
+	for _, index := range opts.UniqueIndexes {
+		rpc := protoutil.NewRPC(
+			GetByIndexRPC(opts.TypeName, index.Name),
+			GetByIndexRequest(opts.TypeName, index.Name),
+			fmt.Sprintf("QueryGet%sBy%sResponse", opts.TypeName.PascalCase, index.Name.UpperCamel),
+			protoutil.WithRPCOptions(
+				protoutil.NewOption("google.api.http", httpRule(index.Name), protoutil.Custom(), protoutil.SetField("get")),
+			),
+		)
+		protoutil.AttachComment(rpc, fmt.Sprintf(
+			"%s queries a %s by %s.",
+			rpc.Name, opts.TypeName.PascalCase, index.Name.LowerCamel,
+		))
+		rpcs = append(rpcs, rpc)
+	}
+	return rpcs
+}
+
+// indexesMessages returns the query messages of the secondary and unique indexes of the map type.
+func indexesMessages(opts *typed.Options) []proto.Visitee {
+	var (
+		typenamePascal, typenameSnake  = opts.TypeName.PascalCase, opts.TypeName.Snake
+		paginationType, paginationName = "cosmos.base.query.v1beta1.Page", "pagination"
+		gogoOption                     = protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
+		messages                       = make([]proto.Visitee, 0, 2*(len(opts.SecondaryIndexes)+len(opts.UniqueIndexes)))
+	)
+	for _, index := range opts.SecondaryIndexes {
+		request := protoutil.NewMessage(
+			ListByIndexRequest(opts.TypeName, index.Name),
+			protoutil.WithFields(
+				index.ToProtoField(1),
+				protoutil.NewField(paginationName, paginationType+"Request", 2),
+			),
+		)
+		response := protoutil.NewMessage(
+			fmt.Sprintf("QueryAll%sBy%sResponse", typenamePascal, index.Name.UpperCamel),
+			protoutil.WithFields(
+				protoutil.NewField(
+					typenameSnake,
+					typenamePascal,
+					1,
+					protoutil.Repeated(),
+					protoutil.WithFieldOptions(gogoOption),
+				),
+				protoutil.NewField(paginationName, paginationType+"Response", 2),
+			),
+		)
+		messages = append(messages, request, response)
+	}
+	for _, index := range opts.UniqueIndexes {
+		request := protoutil.NewMessage(
+			GetByIndexRequest(opts.TypeName, index.Name),
+			protoutil.WithFields(index.ToProtoField(1)),
+		)
+		response := protoutil.NewMessage(
+			fmt.Sprintf("QueryGet%sBy%sResponse", typenamePascal, index.Name.UpperCamel),
+			protoutil.WithFields(protoutil.NewField(typenameSnake, typenamePascal, 1, protoutil.WithFieldOptions(gogoOption))),
+		)
+		messages = append(messages, request, response)
+	}
+	return messages
+}
+
+// indexesAutoCLIOptions returns the autocli query options of the secondary and unique indexes of the map type.
+func indexesAutoCLIOptions(opts *typed.Options) []string {
+	options := make([]string, 0, len(opts.SecondaryIndexes)+len(opts.UniqueIndexes))
+	for _, index := range opts.SecondaryIndexes {
+		options = append(options, fmt.Sprintf(
+			`{
+				RpcMethod: "%[1]v",
+				Use: "list-%[2]v-by-%[3]v [%[4]v]",
+				Short: "List all %[5]v by %[6]v",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "%[4]v"}},
+			}`,
+			ListByIndexRPC(opts.TypeName, index.Name),
+			opts.TypeName.Kebab,
+			index.Name.Kebab,
+			index.ProtoFieldName(),
+			opts.TypeName.Original,
+			index.Name.Original,
+		))
+	}
+	for _, index := range opts.UniqueIndexes {
+		options = append(options, fmt.Sprintf(
+			`{
+				RpcMethod: "%[1]v",
+				Use: "get-%[2]v-by-%[3]v [%[4]v]",
+				Short: "Gets a %[5]v by %[6]v",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "%[4]v"}},
+			}`,
+			GetByIndexRPC(opts.TypeName, index.Name),
+			opts.TypeName.Kebab,
+			index.Name.Kebab,
+			index.ProtoFieldName(),
+			opts.TypeName.Original,
+			index.Name.Original,
+		))
+	}
+	return options
+}
+
+// uniqueIndexesGenesisArgs returns the genesis arguments of the unique indexes of the map type,
+// so the sample values used in the genesis tests don't violate the uniqueness constraints.
+func uniqueIndexesGenesisArgs(opts *typed.Options, value int) string {
+	var args string
+	for _, index := range opts.UniqueIndexes {
+		args += index.GenesisArgs(value)
+	}
+	return args
+}
@@ -115,13 +115,33 @@ func keeperModify(opts *typed.Options) genny.RunFn {
 			return err
 		}
 
+		// types with secondary or unique indexes are stored in an indexed map.
+		var (
+			collectionType = fmt.Sprintf("collections.Map[%[1]v, types.%[2]v]", opts.Index.DataType(), opts.TypeName.PascalCase)
+			collectionInit = fmt.Sprintf(`collections.NewMap(sb, types.%[1]vKey, "%[2]v", %[3]v, codec.CollValue[types.%[1]v](cdc))`,
+				opts.TypeName.PascalCase,
+				opts.TypeName.LowerCamel,
+				opts.Index.CollectionsKeyValueType(),
+			)
+		)
+		if opts.HasIndexes() {
+			collectionType = fmt.Sprintf(
+				"*collections.IndexedMap[%[1]v, types.%[2]v, types.%[2]vIndexes]",
+				opts.Index.DataType(),
+				opts.TypeName.PascalCase,
+			)
+			collectionInit = fmt.Sprintf(
+				`collections.NewIndexedMap(sb, types.%[1]vKey, "%[2]v", %[3]v, codec.CollValue[types.%[1]v](cdc), types.New%[1]vIndexes(sb))`,
+				opts.TypeName.PascalCase,
+				opts.TypeName.LowerCamel,
+				opts.Index.CollectionsKeyValueType(),
+			)
+		}
+
 		content, err := xast.ModifyStruct(
 			f.String(),
 			"Keeper",
-			xast.AppendStructValue(
-				opts.TypeName.UpperCamel,
-				fmt.Sprintf("collections.Map[%[1]v, types.%[2]v]", opts.Index.DataType(), opts.TypeName.PascalCase),
-			),
+			xast.AppendStructValue(opts.TypeName.UpperCamel, collectionType),
 		)
 		if err != nil {
 			return err
@@ -131,15 +151,7 @@ func keeperModify(opts *typed.Options) genny.RunFn {
 		content, err = xast.ModifyFunction(
 			content,
 			"NewKeeper",
-			xast.AppendFuncStruct(
-				"Keeper",
-				opts.TypeName.UpperCamel,
-				fmt.Sprintf(`collections.NewMap(sb, types.%[1]vKey, "%[2]v", %[3]v, codec.CollValue[types.%[1]v](cdc))`,
-					opts.TypeName.PascalCase,
-					opts.TypeName.LowerCamel,
-					opts.Index.CollectionsKeyValueType(),
-				),
-			),
+			xast.AppendFuncStruct("Keeper", opts.TypeName.UpperCamel, collectionInit),
 		)
 		if err != nil {
 			return err
@@ -215,6 +227,7 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 		)
 		protoutil.AttachComment(rpcQueryGet, fmt.Sprintf("List%[1]v Queries a list of %[1]v items.", typenamePascal))
 		protoutil.Append(serviceQuery, rpcQueryGet, rpcQueryAll)
+		protoutil.Append(serviceQuery, indexesRPCs(opts)...)
 
 		//  Ensure custom types are imported
 		var protoImports []*proto.Import
@@ -260,6 +273,7 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 			),
 		)
 		protoutil.Append(protoFile, queryGetRequest, queryGetResponse, queryAllRequest, queryAllResponse)
+		protoutil.Append(protoFile, indexesMessages(opts)...)
 
 		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
 		return r.File(newFile)
@@ -297,7 +311,7 @@ func clientCliQueryModify(opts *typed.Options) genny.RunFn {
 			opts.TypeName.Original,
 			opts.Index.ProtoFieldName(),
 		)
-		content, err := typed.AppendAutoCLIQueryOptions(f.String(), listOption, getOption)
+		content, err := typed.AppendAutoCLIQueryOptions(f.String(), append([]string{listOption, getOption}, indexesAutoCLIOptions(opts)...)...)
 		if err != nil {
 			return err
 		}
@@ -386,11 +400,29 @@ for _, elem := range gs.%[2]vMap {
 			opts.TypeName.UpperCamel,
 			keyCall,
 		)
-		content, err = xast.ModifyFunction(
-			content,
-			"Validate",
-			xast.AppendFuncCode(replacementTypesValidate),
-		)
+		// check the uniqueness of the values of the unique indexes.
+		templateTypesValidateUnique := `// Check for duplicated %[4]v in %[1]v
+%[1]v%[5]vMap := make(map[string]struct{})
+
+for _, elem := range gs.%[2]vMap {
+	%[4]v := fmt.Sprint(elem.%[5]v)
+	if _, ok := %[1]v%[5]vMap[%[4]v]; ok {
+		return fmt.Errorf("duplicated %[4]v for %[1]v")
+	}
+	%[1]v%[5]vMap[%[4]v] = struct{}{}
+}`
+		validateOptions := []xast.FunctionOptions{xast.AppendFuncCode(replacementTypesValidate)}
+		for _, index := range opts.UniqueIndexes {
+			validateOptions = append(validateOptions, xast.AppendFuncCode(fmt.Sprintf(
+				templateTypesValidateUnique,
+				opts.TypeName.LowerCamel,
+				opts.TypeName.UpperCamel,
+				keyCall,
+				index.Name.LowerCamel,
+				index.Name.UpperCamel,
+			)))
+		}
+		content, err = xast.ModifyFunction(content, "Validate", validateOptions...)
 		if err != nil {
 			return err
 		}
@@ -466,7 +498,7 @@ func genesisTestsModify(opts *typed.Options) genny.RunFn {
 		// Create a list of two different indexes to use as sample
 		sampleIndexes := make([]string, 2)
 		for i := 0; i < 2; i++ {
-			sampleIndexes[i] = opts.Index.GenesisArgs(i)
+			sampleIndexes[i] = opts.Index.GenesisArgs(i) + uniqueIndexesGenesisArgs(opts, i)
 		}
 
 		// add parameter to the struct into the new method.
@@ -505,7 +537,7 @@ func genesisTypesTestsModify(opts *typed.Options) genny.RunFn {
 		// Create a list of two different indexes to use as sample
 		sampleIndexes := make([]string, 2)
 		for i := 0; i < 2; i++ {
-			sampleIndexes[i] = opts.Index.GenesisArgs(i)
+			sampleIndexes[i] = opts.Index.GenesisArgs(i) + uniqueIndexesGenesisArgs(opts, i)
 		}
 
 		templateDuplicated := `{
@@ -529,9 +561,7 @@ func genesisTypesTestsModify(opts *typed.Options) genny.RunFn {
 		)
 
 		// add parameter to the struct into the new method.
-		content, err := xast.ModifyFunction(
-			f.String(),
-			"TestGenesisState_Validate",
+		testOptions := []xast.FunctionOptions{
 			xast.AppendFuncStruct(
 				"GenesisState",
 				fmt.Sprintf("%[1]vMap", opts.TypeName.UpperCamel),
@@ -543,7 +573,33 @@ func genesisTypesTestsModify(opts *typed.Options) genny.RunFn {
 				),
 			),
 			xast.AppendFuncTestCase(replacementDuplicated),
-		)
+		}
+
+		// values with a different index sharing the same unique index value are invalid.
+		templateDuplicatedUnique := `{
+	desc:     "duplicated %[1]v",
+	genState: &types.GenesisState{
+		%[2]vMap: []types.%[3]v{
+			{
+				%[4]v%[6]v},
+			{
+				%[5]v%[6]v},
+		},
+	},
+	valid:    false,
+}`
+		for _, index := range opts.UniqueIndexes {
+			testOptions = append(testOptions, xast.AppendFuncTestCase(fmt.Sprintf(
+				templateDuplicatedUnique,
+				index.Name.LowerCamel,
+				opts.TypeName.UpperCamel,
+				opts.TypeName.PascalCase,
+				opts.Index.GenesisArgs(0),
+				opts.Index.GenesisArgs(1),
+				index.GenesisArgs(0),
+			)))
+		}
+		content, err := xast.ModifyFunction(f.String(), "TestGenesisState_Validate", testOptions...)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,238 @@
+package maptype
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+// NewRemoveGenerator returns the generator to remove a map type scaffolded in a module.
+func NewRemoveGenerator(opts *typed.Options) *genny.Generator {
+	var (
+		g              = genny.New()
+		typenamePascal = opts.TypeName.PascalCase
+		typeImport     = opts.ProtoTypeImport().Filename
+	)
+
+	queryRPCs := []string{
+		fmt.Sprintf("Get%s", typenamePascal),
+		fmt.Sprintf("List%s", typenamePascal),
+	}
+	queryMessages := []string{
+		fmt.Sprintf("QueryGet%sRequest", typenamePascal),
+		fmt.Sprintf("QueryGet%sResponse", typenamePascal),
+		fmt.Sprintf("QueryAll%sRequest", typenamePascal),
+		fmt.Sprintf("QueryAll%sResponse", typenamePascal),
+	}
+	for _, index := range opts.SecondaryIndexes {
+		queryRPCs = append(queryRPCs, ListByIndexRPC(opts.TypeName, index.Name))
+		queryMessages = append(queryMessages,
+			ListByIndexRequest(opts.TypeName, index.Name),
+			fmt.Sprintf("QueryAll%sBy%sResponse", typenamePascal, index.Name.UpperCamel),
+		)
+	}
+	for _, index := range opts.UniqueIndexes {
+		queryRPCs = append(queryRPCs, GetByIndexRPC(opts.TypeName, index.Name))
+		queryMessages = append(queryMessages,
+			GetByIndexRequest(opts.TypeName, index.Name),
+			fmt.Sprintf("QueryGet%sBy%sResponse", typenamePascal, index.Name.UpperCamel),
+		)
+	}
+
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("query.proto"), typed.ProtoRemoval{
+		Service:  "Query",
+		RPCs:     queryRPCs,
+		Messages: queryMessages,
+		Imports:  []string{typeImport},
+	}))
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("genesis.proto"), typed.ProtoRemoval{
+		Message: typed.ProtoGenesisStateMessage,
+		Fields:  []string{opts.TypeName.Snake + "_map"},
+		Imports: []string{typeImport},
+	}))
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("tx.proto"), typed.ProtoTxCRUDRemoval(opts.TypeName)))
+	g.RunFn(keeperRemove(opts))
+	g.RunFn(typed.ClientCliRemove(
+		opts.ModuleName,
+		queryRPCs,
+		typed.CRUDMessages(opts.TypeName),
+	))
+	g.RunFn(typed.TypesCodecRemove(opts.ModuleName, typed.CRUDMessages(opts.TypeName)...))
+	g.RunFn(genesisTypesRemove(opts))
+	g.RunFn(genesisModuleRemove(opts))
+	g.RunFn(genesisTestsRemove(opts))
+	g.RunFn(genesisTypesTestsRemove(opts))
+	g.RunFn(moduleSimulationRemove(opts))
+	g.RunFn(typed.RemoveFiles(
+		opts.ProtoFile(opts.TypeName.Snake+".proto"),
+		filepath.Join("x", opts.ModuleName, "types", opts.TypeName.Snake+".pb.go"),
+		filepath.Join("x", opts.ModuleName, "types", fmt.Sprintf("key_%s.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("query_%s.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("query_%s_test.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("msg_server_%s.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("msg_server_%s_test.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "simulation", opts.TypeName.Snake+".go"),
+	))
+
+	return g
+}
+
+// keeperRemove removes the collections map type from the keeper.
+func keeperRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.ModifyStruct(f.String(), "Keeper", xast.RemoveStructValue(opts.TypeName.UpperCamel))
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(content, "NewKeeper", xast.RemoveFuncStruct("Keeper", opts.TypeName.UpperCamel))
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTypesRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "types/genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		mapName := fmt.Sprintf("%vMap", opts.TypeName.UpperCamel)
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"DefaultGenesis",
+			xast.RemoveFuncStruct("GenesisState", mapName),
+		)
+		if err != nil {
+			return err
+		}
+
+		validateIdents := []string{mapName, fmt.Sprintf("%vIndexMap", opts.TypeName.LowerCamel)}
+		for _, index := range opts.UniqueIndexes {
+			validateIdents = append(validateIdents, fmt.Sprintf("%v%vMap", opts.TypeName.LowerCamel, index.Name.UpperCamel))
+		}
+		content, err = xast.ModifyFunction(content, "Validate", xast.RemoveFuncCode(validateIdents...))
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisModuleRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "keeper/genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		mapName := fmt.Sprintf("%vMap", opts.TypeName.UpperCamel)
+		content, err := xast.ModifyFunction(f.String(), "InitGenesis", xast.RemoveFuncCode(mapName))
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(content, "ExportGenesis", xast.RemoveFuncCode(mapName))
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTestsRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "keeper/genesis_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		mapName := fmt.Sprintf("%vMap", opts.TypeName.UpperCamel)
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"TestGenesis",
+			xast.RemoveFuncStruct("GenesisState", mapName),
+			xast.RemoveFuncCode(mapName),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func genesisTypesTestsRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "types/genesis_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		testOptions := []xast.FunctionOptions{
+			xast.RemoveFuncStruct("GenesisState", fmt.Sprintf("%vMap", opts.TypeName.UpperCamel)),
+			xast.RemoveFuncTestCase(fmt.Sprintf("duplicated %v", opts.TypeName.LowerCamel)),
+		}
+		for _, index := range opts.UniqueIndexes {
+			testOptions = append(testOptions, xast.RemoveFuncTestCase(fmt.Sprintf("duplicated %v", index.Name.LowerCamel)))
+		}
+		content, err := xast.ModifyFunction(f.String(), "TestGenesisState_Validate", testOptions...)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func moduleSimulationRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "module/simulation.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"GenerateGenesisState",
+			xast.RemoveFuncStruct("GenesisState", fmt.Sprintf("%vMap", opts.TypeName.UpperCamel)),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = typed.RemoveModuleSimulationMsg(content, opts.TypeName, "Create", "Update", "Delete")
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
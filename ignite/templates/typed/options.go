@@ -25,6 +25,19 @@ type Options struct {
 	NoMessage    bool
 	NoSimulation bool
 	IsIBC        bool
+
+	// Events makes the CRUD messages emit the created, updated and deleted events of the type.
+	Events bool
+
+	// SecondaryIndexes are the fields of a map type indexed with a multi index.
+	SecondaryIndexes field.Fields
+	// UniqueIndexes are the fields of a map type indexed with a unique index.
+	UniqueIndexes field.Fields
+}
+
+// HasIndexes returns true if the map type has secondary or unique indexes.
+func (opts *Options) HasIndexes() bool {
+	return len(opts.SecondaryIndexes) > 0 || len(opts.UniqueIndexes) > 0
 }
 
 // ProtoFile returns the path to the proto folder within the generated app.
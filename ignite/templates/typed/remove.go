@@ -0,0 +1,162 @@
+package typed
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+)
+
+// ProtoRemoval describes the elements to remove from a proto file.
+type ProtoRemoval struct {
+	// Service is the name of the service to remove the RPCs from.
+	Service string
+	// RPCs are the names of the RPCs to remove from the service.
+	RPCs []string
+	// Messages are the names of the messages to remove from the file.
+	Messages []string
+	// Message is the name of the message to remove the fields from.
+	Message string
+	// Fields are the names of the fields to remove from the message.
+	Fields []string
+	// Imports are the paths of the imports to remove from the file.
+	Imports []string
+}
+
+// ProtoRemoveModify removes the RPCs, messages, fields and imports described by the removal from a proto file.
+func ProtoRemoveModify(path string, removal ProtoRemoval) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		protoFile, err := protoutil.ParseProtoFile(f)
+		if err != nil {
+			return err
+		}
+
+		if len(removal.RPCs) > 0 {
+			service, err := protoutil.GetServiceByName(protoFile, removal.Service)
+			if err != nil {
+				return errors.Errorf("failed while looking up service '%s' in %s: %w", removal.Service, path, err)
+			}
+			protoutil.RemoveRPCs(service, removal.RPCs...)
+		}
+
+		if len(removal.Fields) > 0 {
+			message, err := protoutil.GetMessageByName(protoFile, removal.Message)
+			if err != nil {
+				return errors.Errorf("failed while looking up message '%s' in %s: %w", removal.Message, path, err)
+			}
+			protoutil.RemoveFields(message, removal.Fields...)
+		}
+
+		protoutil.RemoveMessages(protoFile, removal.Messages...)
+		protoutil.RemoveImports(protoFile, removal.Imports...)
+
+		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+		return r.File(newFile)
+	}
+}
+
+// RemoveFiles removes the files created by a scaffolded component.
+func RemoveFiles(paths ...string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		for _, path := range paths {
+			if err := r.Delete(path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// RemoveModuleSimulationMsg removes the simulation operations added by ModuleSimulationMsgModify.
+func RemoveModuleSimulationMsg(content string, typeName multiformatname.Name, msgs ...string) (string, error) {
+	if len(msgs) == 0 {
+		msgs = append(msgs, "")
+	}
+
+	idents := make([]string, 0, len(msgs)*4)
+	for _, msg := range msgs {
+		idents = append(idents,
+			fmt.Sprintf("opWeightMsg%[1]v%[2]v", msg, typeName.PascalCase),
+			fmt.Sprintf("defaultWeightMsg%[1]v%[2]v", msg, typeName.PascalCase),
+			fmt.Sprintf("weightMsg%[1]v%[2]v", msg, typeName.PascalCase),
+			fmt.Sprintf("SimulateMsg%[1]v%[2]v", msg, typeName.PascalCase),
+		)
+	}
+
+	return xast.ModifyFunction(content, "WeightedOperations", xast.RemoveFuncCode(idents...))
+}
+
+// CRUDMessages returns the names of the CRUD messages scaffolded for a type.
+func CRUDMessages(typeName multiformatname.Name) []string {
+	return []string{
+		fmt.Sprintf("Create%s", typeName.PascalCase),
+		fmt.Sprintf("Update%s", typeName.PascalCase),
+		fmt.Sprintf("Delete%s", typeName.PascalCase),
+	}
+}
+
+// ProtoTxCRUDRemoval returns the removal of the CRUD RPCs and messages of a type from the tx.proto file.
+func ProtoTxCRUDRemoval(typeName multiformatname.Name) ProtoRemoval {
+	removal := ProtoRemoval{Service: "Msg"}
+	for _, msg := range CRUDMessages(typeName) {
+		removal.RPCs = append(removal.RPCs, msg)
+		removal.Messages = append(removal.Messages, "Msg"+msg, "Msg"+msg+"Response")
+	}
+	return removal
+}
+
+// ClientCliRemove removes the autocli options of the given query and tx RPC methods from a module.
+func ClientCliRemove(moduleName string, queryMethods, txMethods []string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", moduleName, "module/autocli.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := RemoveAutoCLIQueryOptions(f.String(), queryMethods...)
+		if err != nil {
+			return err
+		}
+
+		content, err = RemoveAutoCLITxOptions(content, txMethods...)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// TypesCodecRemove removes the interface registration of the given messages from a module codec.
+func TypesCodecRemove(moduleName string, msgs ...string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", moduleName, "types/codec.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		idents := make([]string, 0, len(msgs))
+		for _, msg := range msgs {
+			idents = append(idents, "Msg"+msg)
+		}
+		content, err := xast.ModifyFunction(f.String(), "RegisterInterfaces", xast.RemoveFuncCode(idents...))
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
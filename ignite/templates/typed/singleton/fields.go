@@ -0,0 +1,27 @@
+package singleton
+
+import (
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+// NewFieldsGenerator returns the generator to add fields to a singleton type scaffolded in a module.
+func NewFieldsGenerator(opts *typed.Options) *genny.Generator {
+	g := genny.New()
+	g.RunFn(typed.ProtoFieldsModify(opts))
+	g.RunFn(typed.MsgServerFieldsModify(opts))
+	g.RunFn(typed.AutoCLIFieldsModify(opts))
+	g.RunFn(typed.GenesisTestsFieldsModify(opts))
+	return g
+}
+
+// NewRemoveFieldsGenerator returns the generator to remove fields from a singleton type scaffolded in a module.
+func NewRemoveFieldsGenerator(opts *typed.Options) *genny.Generator {
+	g := genny.New()
+	g.RunFn(typed.ProtoFieldsRemove(opts))
+	g.RunFn(typed.MsgServerFieldsRemove(opts))
+	g.RunFn(typed.AutoCLIFieldsRemove(opts))
+	g.RunFn(typed.GenesisTestsFieldsRemove(opts))
+	return g
+}
@@ -0,0 +1,173 @@
+package singleton
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+// NewRemoveGenerator returns the generator to remove a singleton type scaffolded in a module.
+func NewRemoveGenerator(opts *typed.Options) *genny.Generator {
+	var (
+		g              = genny.New()
+		typenamePascal = opts.TypeName.PascalCase
+		typeImport     = opts.ProtoTypeImport().Filename
+	)
+
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("query.proto"), typed.ProtoRemoval{
+		Service: "Query",
+		RPCs:    []string{fmt.Sprintf("Get%s", typenamePascal)},
+		Messages: []string{
+			fmt.Sprintf("QueryGet%sRequest", typenamePascal),
+			fmt.Sprintf("QueryGet%sResponse", typenamePascal),
+		},
+		Imports: []string{typeImport},
+	}))
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("genesis.proto"), typed.ProtoRemoval{
+		Message: typed.ProtoGenesisStateMessage,
+		Fields:  []string{opts.TypeName.Snake},
+		Imports: []string{typeImport},
+	}))
+	g.RunFn(typed.ProtoRemoveModify(opts.ProtoFile("tx.proto"), typed.ProtoTxCRUDRemoval(opts.TypeName)))
+	g.RunFn(typesKeyRemove(opts))
+	g.RunFn(keeperRemove(opts))
+	g.RunFn(typed.ClientCliRemove(
+		opts.ModuleName,
+		[]string{fmt.Sprintf("Get%s", typenamePascal)},
+		typed.CRUDMessages(opts.TypeName),
+	))
+	g.RunFn(typed.TypesCodecRemove(opts.ModuleName, typed.CRUDMessages(opts.TypeName)...))
+	g.RunFn(genesisRemove(opts))
+	g.RunFn(moduleSimulationRemove(opts))
+	g.RunFn(typed.RemoveFiles(
+		opts.ProtoFile(opts.TypeName.Snake+".proto"),
+		filepath.Join("x", opts.ModuleName, "types", opts.TypeName.Snake+".pb.go"),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("query_%s.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("query_%s_test.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("msg_server_%s.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "keeper", fmt.Sprintf("msg_server_%s_test.go", opts.TypeName.Snake)),
+		filepath.Join("x", opts.ModuleName, "simulation", opts.TypeName.Snake+".go"),
+	))
+
+	return g
+}
+
+// typesKeyRemove removes the collection prefix from the keys.go file.
+func typesKeyRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "types/keys.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.RemoveGlobal(f.String(), fmt.Sprintf("%vKey", opts.TypeName.PascalCase))
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperRemove removes the collections item type from the keeper.
+func keeperRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.ModifyStruct(f.String(), "Keeper", xast.RemoveStructValue(opts.TypeName.UpperCamel))
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(content, "NewKeeper", xast.RemoveFuncStruct("Keeper", opts.TypeName.UpperCamel))
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// genesisRemove removes the singleton from the genesis state, its validation and its tests.
+func genesisRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		name := opts.TypeName.UpperCamel
+		modifications := []struct {
+			path     string
+			funcName string
+			options  []xast.FunctionOptions
+		}{
+			{
+				path:     filepath.Join("x", opts.ModuleName, "types/genesis.go"),
+				funcName: "DefaultGenesis",
+				options:  []xast.FunctionOptions{xast.RemoveFuncStruct("GenesisState", name)},
+			},
+			{
+				path:     filepath.Join("x", opts.ModuleName, "keeper/genesis.go"),
+				funcName: "InitGenesis",
+				options:  []xast.FunctionOptions{xast.RemoveFuncCode(name)},
+			},
+			{
+				path:     filepath.Join("x", opts.ModuleName, "keeper/genesis.go"),
+				funcName: "ExportGenesis",
+				options:  []xast.FunctionOptions{xast.RemoveFuncCode(name, opts.TypeName.LowerCamel)},
+			},
+			{
+				path:     filepath.Join("x", opts.ModuleName, "keeper/genesis_test.go"),
+				funcName: "TestGenesis",
+				options:  []xast.FunctionOptions{xast.RemoveFuncStruct("GenesisState", name), xast.RemoveFuncCode(name)},
+			},
+			{
+				path:     filepath.Join("x", opts.ModuleName, "types/genesis_test.go"),
+				funcName: "TestGenesisState_Validate",
+				options:  []xast.FunctionOptions{xast.RemoveFuncStruct("GenesisState", name)},
+			},
+		}
+
+		for _, m := range modifications {
+			f, err := r.Disk.Find(m.path)
+			if err != nil {
+				return err
+			}
+
+			content, err := xast.ModifyFunction(f.String(), m.funcName, m.options...)
+			if err != nil {
+				return err
+			}
+
+			if err := r.File(genny.NewFileS(m.path, content)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func moduleSimulationRemove(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "module/simulation.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := typed.RemoveModuleSimulationMsg(f.String(), opts.TypeName, "Create", "Update", "Delete")
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
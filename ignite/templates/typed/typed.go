@@ -29,7 +29,11 @@ func Box(box fs.FS, opts *Options, g *genny.Generator) error {
 	ctx.Set("MsgSigner", opts.MsgSigner)
 	ctx.Set("Fields", opts.Fields)
 	ctx.Set("Index", opts.Index)
+	ctx.Set("SecondaryIndexes", opts.SecondaryIndexes)
+	ctx.Set("UniqueIndexes", opts.UniqueIndexes)
+	ctx.Set("HasIndexes", opts.HasIndexes())
 	ctx.Set("NoMessage", opts.NoMessage)
+	ctx.Set("Events", opts.Events)
 	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName, opts.ProtoVer))
 	ctx.Set("strconv", func() bool {
 		strconv := false
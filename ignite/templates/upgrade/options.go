@@ -0,0 +1,35 @@
+package upgrade
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Options ...
+type Options struct {
+	ModulePath    string
+	UpgradeName   string
+	PackageName   string
+	AddedStores   []string
+	DeletedStores []string
+	// NewRegistry is true when the app doesn't register any upgrade yet.
+	NewRegistry bool
+}
+
+// AddedStoresList returns the added stores as the elements of a Go string slice literal.
+func (opts *Options) AddedStoresList() string {
+	return stringList(opts.AddedStores)
+}
+
+// DeletedStoresList returns the deleted stores as the elements of a Go string slice literal.
+func (opts *Options) DeletedStoresList() string {
+	return stringList(opts.DeletedStores)
+}
+
+func stringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,153 @@
+package upgrade
+
+import (
+	"embed"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+)
+
+var (
+	//go:embed files/upgrade/* files/upgrade/**/*
+	fsUpgrade embed.FS
+
+	//go:embed files/registry/* files/registry/**/*
+	fsRegistry embed.FS
+)
+
+// NewGenerator returns the generator to scaffold a chain upgrade and register it in the app.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	if err := box(g, fsUpgrade, "files/upgrade"); err != nil {
+		return g, err
+	}
+	if opts.NewRegistry {
+		// the registry is created with the upgrade, the app must register the upgrade handlers.
+		if err := box(g, fsRegistry, "files/registry"); err != nil {
+			return g, err
+		}
+		g.RunFn(appModify())
+	} else {
+		g.RunFn(registryModify(opts))
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("UpgradeName", opts.UpgradeName)
+	ctx.Set("PackageName", opts.PackageName)
+	ctx.Set("AddedStores", opts.AddedStoresList())
+	ctx.Set("DeletedStores", opts.DeletedStoresList())
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{packageName}}", opts.PackageName))
+	return g, nil
+}
+
+func box(g *genny.Generator, files embed.FS, dir string) error {
+	subFs, err := fs.Sub(files, dir)
+	if err != nil {
+		return errors.Errorf("fail to generate sub: %w", err)
+	}
+	return g.OnlyFS(subFs, nil, nil)
+}
+
+// appModify registers the upgrade handlers before the app loads its stores.
+func appModify() genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("app", "app.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		line, err := loadStmtIndex(f.String())
+		if err != nil {
+			return errors.Errorf("%s: %w", path, err)
+		}
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"New",
+			xast.AppendFuncAtLine("app.registerUpgradeHandlers()", line),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// registryModify appends the upgrade to the upgrades handled by the app.
+func registryModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("app", "upgrades.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.AppendImports(
+			f.String(),
+			xast.WithImport(opts.ModulePath+"/app/upgrades/"+opts.PackageName),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyGlobalArrayVar(
+			content,
+			"Upgrades",
+			xast.AppendGlobalArrayValue(opts.PackageName+".Upgrade"),
+		)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// loadStmtIndex returns the index of the statement loading the app stores in the New function of the app.
+func loadStmtIndex(content string) (uint64, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, decl := range f.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv != nil || funcDecl.Name.Name != "New" || funcDecl.Body == nil {
+			continue
+		}
+		for i, stmt := range funcDecl.Body.List {
+			found := false
+			ast.Inspect(stmt, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if ok && sel.Sel.Name == "Load" {
+					if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == "app" {
+						found = true
+					}
+				}
+				return !found
+			})
+			if found {
+				return uint64(i), nil
+			}
+		}
+	}
+	return 0, errors.New("the app stores are not loaded in the New function")
+}
@@ -0,0 +1,98 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/stretchr/testify/require"
+)
+
+const appFile = `package app
+
+func New(loadLatest bool) *App {
+	app := &App{}
+
+	app.sm.RegisterStoreDecoders()
+
+	if err := app.Load(loadLatest); err != nil {
+		panic(err)
+	}
+
+	return app
+}
+`
+
+func TestNewGenerator(t *testing.T) {
+	r := genny.DryRunner(t.Context())
+	r.Disk.Add(genny.NewFileS("app/app.go", appFile))
+
+	g, err := NewGenerator(&Options{
+		ModulePath:    "github.com/test/blog",
+		UpgradeName:   "v2.0.0",
+		PackageName:   "v2_0_0",
+		AddedStores:   []string{"foo"},
+		DeletedStores: []string{"bar", "baz"},
+		NewRegistry:   true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, r.With(g))
+	require.NoError(t, r.Run())
+
+	app, err := r.Disk.Find("app/app.go")
+	require.NoError(t, err)
+	require.Contains(t, app.String(), "app.registerUpgradeHandlers()\n\n\tif err := app.Load(loadLatest); err != nil {")
+
+	registry, err := r.Disk.Find("app/upgrades.go")
+	require.NoError(t, err)
+	require.Contains(t, registry.String(), "var Upgrades = []upgrades.Upgrade{\n\tv2_0_0.Upgrade,\n}")
+
+	_, err = r.Disk.Find("app/upgrades/types.go")
+	require.NoError(t, err)
+
+	upgrade, err := r.Disk.Find("app/upgrades/v2_0_0/upgrades.go")
+	require.NoError(t, err)
+	require.Contains(t, upgrade.String(), "package v2_0_0")
+	require.Contains(t, upgrade.String(), `const UpgradeName = "v2.0.0"`)
+	require.Contains(t, upgrade.String(), `Added:   []string{"foo"},`)
+	require.Contains(t, upgrade.String(), `Deleted: []string{"bar", "baz"},`)
+
+	test, err := r.Disk.Find("app/upgrades/v2_0_0/upgrades_test.go")
+	require.NoError(t, err)
+	require.Contains(t, test.String(), "package v2_0_0_test")
+	require.Contains(t, test.String(), "require.True(t, bApp.UpgradeKeeper.HasHandler(v2_0_0.UpgradeName))")
+	require.NotContains(t, test.String(), "require.Empty(t, v2_0_0.Upgrade.StoreUpgrades.Added)")
+
+	// the next upgrade is appended to the registry.
+	g, err = NewGenerator(&Options{
+		ModulePath:  "github.com/test/blog",
+		UpgradeName: "v3",
+		PackageName: "v3",
+	})
+	require.NoError(t, err)
+	require.NoError(t, r.With(g))
+	require.NoError(t, r.Run())
+
+	registry, err = r.Disk.Find("app/upgrades.go")
+	require.NoError(t, err)
+	require.Contains(t, registry.String(), `"github.com/test/blog/app/upgrades/v3"`)
+	require.Contains(t, registry.String(), "var Upgrades = []upgrades.Upgrade{\n\tv2_0_0.Upgrade,\n\tv3.Upgrade,\n}")
+
+	upgrade, err = r.Disk.Find("app/upgrades/v3/upgrades.go")
+	require.NoError(t, err)
+	require.Contains(t, upgrade.String(), `Added:   []string{},`)
+
+	// the test of an upgrade without store changes checks the store upgrades are empty.
+	test, err = r.Disk.Find("app/upgrades/v3/upgrades_test.go")
+	require.NoError(t, err)
+	require.Contains(t, test.String(), "require.True(t, bApp.UpgradeKeeper.HasHandler(v3.UpgradeName))")
+	require.Contains(t, test.String(), "require.Empty(t, v3.Upgrade.StoreUpgrades.Added)")
+}
+
+func TestLoadStmtIndex(t *testing.T) {
+	line, err := loadStmtIndex(appFile)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, line)
+
+	_, err = loadStmtIndex("package app\n\nfunc New() {}\n")
+	require.EqualError(t, err, "the app stores are not loaded in the New function")
+}